@@ -52,6 +52,55 @@ func (Permission_Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8bbd6f3875b0e874, []int{2, 0}
 }
 
+type OperationPermission_Type int32
+
+const (
+	MEMBER_LIST   OperationPermission_Type = 0
+	MEMBER_ADD    OperationPermission_Type = 1
+	MEMBER_REMOVE OperationPermission_Type = 2
+	MEMBER_UPDATE OperationPermission_Type = 3
+	DEFRAGMENT    OperationPermission_Type = 4
+	SNAPSHOT      OperationPermission_Type = 5
+	ALARM_DISARM  OperationPermission_Type = 6
+	MOVE_LEADER   OperationPermission_Type = 7
+	COMPACTION    OperationPermission_Type = 8
+	LEASE         OperationPermission_Type = 9
+)
+
+var OperationPermission_Type_name = map[int32]string{
+	0: "MEMBER_LIST",
+	1: "MEMBER_ADD",
+	2: "MEMBER_REMOVE",
+	3: "MEMBER_UPDATE",
+	4: "DEFRAGMENT",
+	5: "SNAPSHOT",
+	6: "ALARM_DISARM",
+	7: "MOVE_LEADER",
+	8: "COMPACTION",
+	9: "LEASE",
+}
+
+var OperationPermission_Type_value = map[string]int32{
+	"MEMBER_LIST":   0,
+	"MEMBER_ADD":    1,
+	"MEMBER_REMOVE": 2,
+	"MEMBER_UPDATE": 3,
+	"DEFRAGMENT":    4,
+	"SNAPSHOT":      5,
+	"ALARM_DISARM":  6,
+	"MOVE_LEADER":   7,
+	"COMPACTION":    8,
+	"LEASE":         9,
+}
+
+func (x OperationPermission_Type) String() string {
+	return proto.EnumName(OperationPermission_Type_name, int32(x))
+}
+
+func (OperationPermission_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{4, 0}
+}
+
 type UserAddOptions struct {
 	NoPassword           bool     `protobuf:"varint,1,opt,name=no_password,json=noPassword,proto3" json:"no_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -94,13 +143,15 @@ var xxx_messageInfo_UserAddOptions proto.InternalMessageInfo
 
 // User is a single entry in the bucket authUsers
 type User struct {
-	Name                 []byte          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Password             []byte          `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Roles                []string        `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	Options              *UserAddOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Name     []byte          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password []byte          `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Roles    []string        `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Options  *UserAddOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// password_history is the hashes of the previous passwords of the user, newest first.
+	PasswordHistory      [][]byte `protobuf:"bytes,5,rep,name=password_history,json=passwordHistory,proto3" json:"password_history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
@@ -138,12 +189,18 @@ var xxx_messageInfo_User proto.InternalMessageInfo
 
 // Permission is a single entity
 type Permission struct {
-	PermType             Permission_Type `protobuf:"varint,1,opt,name=permType,proto3,enum=authpb.Permission_Type" json:"permType,omitempty"`
-	Key                  []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	RangeEnd             []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PermType Permission_Type `protobuf:"varint,1,opt,name=permType,proto3,enum=authpb.Permission_Type" json:"permType,omitempty"`
+	// key and range_end may contain the placeholder {user}, replaced by the
+	// name of the user whose permissions are checked.
+	Key      []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd []byte `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// deny denies permType on the range instead of granting it. Denials of
+	// all the roles of a user take precedence over their grants. Deny
+	// permissions require all the members to advertise the apply extensions.
+	Deny                 bool     `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Permission) Reset()         { *m = Permission{} }
@@ -181,11 +238,13 @@ var xxx_messageInfo_Permission proto.InternalMessageInfo
 
 // Role is a single entry in the bucket authRoles
 type Role struct {
-	Name                 []byte        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	KeyPermission        []*Permission `protobuf:"bytes,2,rep,name=keyPermission,proto3" json:"keyPermission,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Name          []byte        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KeyPermission []*Permission `protobuf:"bytes,2,rep,name=keyPermission,proto3" json:"keyPermission,omitempty"`
+	// operationPermission is the operations outside of the key space granted by the role.
+	OperationPermission  []*OperationPermission `protobuf:"bytes,3,rep,name=operationPermission,proto3" json:"operationPermission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *Role) Reset()         { *m = Role{} }
@@ -221,40 +280,157 @@ func (m *Role) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Role proto.InternalMessageInfo
 
+// OperationPermission grants an operation outside of the key space
+type OperationPermission struct {
+	Operation            OperationPermission_Type `protobuf:"varint,1,opt,name=operation,proto3,enum=authpb.OperationPermission_Type" json:"operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *OperationPermission) Reset()         { *m = OperationPermission{} }
+func (m *OperationPermission) String() string { return proto.CompactTextString(m) }
+func (*OperationPermission) ProtoMessage()    {}
+func (*OperationPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{4}
+}
+func (m *OperationPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperationPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationPermission.Merge(m, src)
+}
+func (m *OperationPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationPermission proto.InternalMessageInfo
+
+type Session struct {
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// issued_at is the unix time in seconds at which the session was authenticated.
+	IssuedAt int64 `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// source_address is the address of the client that authenticated.
+	SourceAddress string `protobuf:"bytes,4,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// member_id is the ID of the member that authenticated the client.
+	MemberId uint64 `protobuf:"varint,5,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// expires_at is the unix time in seconds at which the token of the session
+	// expires, or 0 if the token is refreshed while it is used.
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// revoked marks a revoked session whose token remains valid by itself until
+	// it expires.
+	Revoked              bool     `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{5}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return m.Size()
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+// User is a single entry in the bucket authUsers
 func init() {
 	proto.RegisterEnum("authpb.Permission_Type", Permission_Type_name, Permission_Type_value)
+	proto.RegisterEnum("authpb.OperationPermission_Type", OperationPermission_Type_name, OperationPermission_Type_value)
 	proto.RegisterType((*UserAddOptions)(nil), "authpb.UserAddOptions")
 	proto.RegisterType((*User)(nil), "authpb.User")
 	proto.RegisterType((*Permission)(nil), "authpb.Permission")
 	proto.RegisterType((*Role)(nil), "authpb.Role")
+	proto.RegisterType((*OperationPermission)(nil), "authpb.OperationPermission")
+	proto.RegisterType((*Session)(nil), "authpb.Session")
 }
 
 func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
 
 var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xea, 0x40,
-	0x14, 0xc6, 0x3b, 0xb4, 0x70, 0xdb, 0xc3, 0x85, 0x90, 0x13, 0x72, 0x6f, 0x83, 0x49, 0x6d, 0xba,
-	0x6a, 0x5c, 0x54, 0x85, 0x8d, 0x5b, 0x8c, 0x2c, 0x5c, 0x49, 0x26, 0x18, 0x97, 0xa4, 0xa4, 0x13,
-	0x24, 0xc0, 0x4c, 0x33, 0x83, 0x31, 0x6c, 0x7c, 0x0e, 0x17, 0x3e, 0x10, 0x4b, 0x1e, 0x41, 0xf0,
-	0x45, 0x4c, 0x67, 0xf8, 0x13, 0xa2, 0xbb, 0xef, 0x7c, 0xe7, 0xfb, 0x66, 0x7e, 0x99, 0x01, 0x48,
-	0x5f, 0x16, 0xcf, 0x49, 0x2e, 0xc5, 0x42, 0x60, 0xa5, 0xd0, 0xf9, 0xa8, 0xd5, 0x1c, 0x8b, 0xb1,
-	0xd0, 0xd6, 0x65, 0xa1, 0xcc, 0x36, 0xba, 0x86, 0xfa, 0xa3, 0x62, 0xb2, 0x9b, 0x65, 0x0f, 0xf9,
-	0x62, 0x22, 0xb8, 0xc2, 0x73, 0xa8, 0x72, 0x31, 0xcc, 0x53, 0xa5, 0x5e, 0x85, 0xcc, 0x7c, 0x12,
-	0x92, 0xd8, 0xa5, 0xc0, 0x45, 0x7f, 0xe7, 0x44, 0x6f, 0xe0, 0x14, 0x15, 0x44, 0x70, 0x78, 0x3a,
-	0x67, 0x3a, 0xf1, 0x97, 0x6a, 0x8d, 0x2d, 0x70, 0x0f, 0xcd, 0x92, 0xf6, 0x0f, 0x33, 0x36, 0xa1,
-	0x2c, 0xc5, 0x8c, 0x29, 0xdf, 0x0e, 0xed, 0xd8, 0xa3, 0x66, 0xc0, 0x2b, 0xf8, 0x23, 0xcc, 0xcd,
-	0xbe, 0x13, 0x92, 0xb8, 0xda, 0xfe, 0x97, 0x18, 0xe0, 0xe4, 0x94, 0x8b, 0xee, 0x63, 0xd1, 0x07,
-	0x01, 0xe8, 0x33, 0x39, 0x9f, 0x28, 0x35, 0x11, 0x1c, 0x3b, 0xe0, 0xe6, 0x4c, 0xce, 0x07, 0xcb,
-	0xdc, 0xa0, 0xd4, 0xdb, 0xff, 0xf7, 0x27, 0x1c, 0x53, 0x49, 0xb1, 0xa6, 0x87, 0x20, 0x36, 0xc0,
-	0x9e, 0xb2, 0xe5, 0x0e, 0xb1, 0x90, 0x78, 0x06, 0x9e, 0x4c, 0xf9, 0x98, 0x0d, 0x19, 0xcf, 0x7c,
-	0xdb, 0xa0, 0x6b, 0xa3, 0xc7, 0xb3, 0xe8, 0x02, 0x1c, 0x5d, 0x73, 0xc1, 0xa1, 0xbd, 0xee, 0x5d,
-	0xc3, 0x42, 0x0f, 0xca, 0x4f, 0xf4, 0x7e, 0xd0, 0x6b, 0x10, 0xac, 0x81, 0x57, 0x98, 0x66, 0x2c,
-	0x45, 0x03, 0x70, 0xa8, 0x98, 0xb1, 0x5f, 0x9f, 0xe7, 0x06, 0x6a, 0x53, 0xb6, 0x3c, 0x62, 0xf9,
-	0xa5, 0xd0, 0x8e, 0xab, 0x6d, 0xfc, 0x09, 0x4c, 0x4f, 0x83, 0xb7, 0xfe, 0x6a, 0x13, 0x58, 0xeb,
-	0x4d, 0x60, 0xad, 0xb6, 0x01, 0x59, 0x6f, 0x03, 0xf2, 0xb9, 0x0d, 0xc8, 0xfb, 0x57, 0x60, 0x8d,
-	0x2a, 0xfa, 0x23, 0x3b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x61, 0x66, 0xc6, 0x9d, 0xf4, 0x01,
-	0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xce, 0x66, 0x37, 0xc9, 0xee, 0xe4, 0xa7, 0xc6, 0xad, 0x60, 0xd5, 0x8a, 0x10, 0x45, 0x42,
+	0x0a, 0x1c, 0x02, 0xb4, 0x17, 0x4e, 0x48, 0x6e, 0xd7, 0xd0, 0x48, 0xd9, 0x26, 0x72, 0xd2, 0x72,
+	0x5c, 0xa5, 0xac, 0xd5, 0x46, 0x6d, 0xd6, 0x2b, 0x6f, 0x02, 0xe4, 0xc8, 0x5b, 0x70, 0xe7, 0xc4,
+	0x8d, 0xc7, 0xe8, 0x8d, 0x3e, 0x02, 0x2d, 0x2f, 0x82, 0x6c, 0x27, 0x69, 0xab, 0x56, 0xdc, 0x66,
+	0x3e, 0x7f, 0xdf, 0xfc, 0x79, 0x6c, 0x80, 0xd1, 0x6c, 0x7a, 0xda, 0x4e, 0xa5, 0x98, 0x0a, 0x5c,
+	0x54, 0x76, 0x7a, 0xbc, 0xb9, 0x71, 0x22, 0x4e, 0x84, 0x86, 0x5e, 0x29, 0xcb, 0x9c, 0x36, 0xdf,
+	0x40, 0xed, 0x30, 0xe3, 0x92, 0xc4, 0x71, 0x2f, 0x9d, 0x8e, 0x45, 0x92, 0xe1, 0x67, 0x50, 0x4e,
+	0x44, 0x94, 0x8e, 0xb2, 0xec, 0x8b, 0x90, 0xb1, 0x6f, 0x35, 0xac, 0x96, 0xcb, 0x20, 0x11, 0xfd,
+	0x05, 0xd2, 0xfc, 0x69, 0x81, 0xa3, 0x34, 0x18, 0x83, 0x93, 0x8c, 0x26, 0x5c, 0x53, 0x2a, 0x4c,
+	0xdb, 0x78, 0x13, 0xdc, 0x95, 0x34, 0xaf, 0xf1, 0x95, 0x8f, 0x37, 0xa0, 0x20, 0xc5, 0x39, 0xcf,
+	0x7c, 0xbb, 0x61, 0xb7, 0x3c, 0x66, 0x1c, 0xfc, 0x1a, 0x4a, 0xc2, 0xa4, 0xf6, 0x9d, 0x86, 0xd5,
+	0x2a, 0x6f, 0x3f, 0x6e, 0x9b, 0x8a, 0xdb, 0x77, 0x0b, 0x63, 0x4b, 0x1a, 0x7e, 0x01, 0x68, 0x19,
+	0x33, 0x3a, 0x1d, 0x67, 0x53, 0x21, 0xe7, 0x7e, 0xa1, 0x61, 0xb7, 0x2a, 0x6c, 0x6d, 0x89, 0xef,
+	0x1b, 0xb8, 0xf9, 0xcb, 0x02, 0xe8, 0x73, 0x39, 0x19, 0x67, 0xd9, 0x58, 0x24, 0x78, 0x07, 0xdc,
+	0x94, 0xcb, 0xc9, 0x70, 0x9e, 0x9a, 0xaa, 0x6b, 0xdb, 0x4f, 0x96, 0xc9, 0x6e, 0x58, 0x6d, 0x75,
+	0xcc, 0x56, 0x44, 0x8c, 0xc0, 0x3e, 0xe3, 0xf3, 0x45, 0x37, 0xca, 0xc4, 0x5b, 0xe0, 0xc9, 0x51,
+	0x72, 0xc2, 0x23, 0x9e, 0xc4, 0xbe, 0x6d, 0xba, 0xd4, 0x00, 0x4d, 0x62, 0x35, 0x95, 0x98, 0x27,
+	0x73, 0xdd, 0x8c, 0xcb, 0xb4, 0xdd, 0x7c, 0x09, 0x8e, 0x0e, 0xe5, 0x82, 0xc3, 0x28, 0x09, 0x50,
+	0x0e, 0x7b, 0x50, 0xf8, 0xc8, 0x3a, 0x43, 0x8a, 0x2c, 0x5c, 0x05, 0x4f, 0x81, 0xc6, 0xcd, 0x37,
+	0x7f, 0x58, 0xe0, 0x30, 0x71, 0xce, 0x1f, 0x1c, 0xef, 0x5b, 0xa8, 0x9e, 0xf1, 0xf9, 0x4d, 0xad,
+	0x7e, 0xbe, 0x61, 0xb7, 0xca, 0xdb, 0xf8, 0x7e, 0x17, 0xec, 0x2e, 0x11, 0x87, 0xb0, 0x2e, 0x52,
+	0x2e, 0x47, 0x6a, 0x84, 0xb7, 0xf4, 0xb6, 0xd6, 0x6f, 0x2d, 0xf5, 0xbd, 0xfb, 0x14, 0xf6, 0x90,
+	0xae, 0xf9, 0x2d, 0x0f, 0xeb, 0x0f, 0x90, 0xf1, 0x3b, 0xf0, 0x56, 0xf4, 0xc5, 0x88, 0x1b, 0xff,
+	0x09, 0x6e, 0x66, 0x7d, 0x23, 0xd1, 0xcb, 0xa5, 0x47, 0xb5, 0x06, 0xe5, 0x90, 0x86, 0xbb, 0x94,
+	0x45, 0xdd, 0xce, 0x60, 0x88, 0x72, 0xb8, 0x06, 0xb0, 0x00, 0x48, 0x10, 0x20, 0x0b, 0x3f, 0x82,
+	0xea, 0xc2, 0x67, 0x34, 0xec, 0x1d, 0x51, 0x94, 0xbf, 0x05, 0x1d, 0xf6, 0x03, 0x32, 0xa4, 0xc8,
+	0x56, 0xaa, 0x80, 0xbe, 0x67, 0xe4, 0x43, 0x48, 0x0f, 0x86, 0xc8, 0xc1, 0x15, 0x70, 0x07, 0x07,
+	0xa4, 0x3f, 0xd8, 0xef, 0x0d, 0x51, 0x01, 0x23, 0xa8, 0x90, 0x2e, 0x61, 0x61, 0x14, 0x74, 0x06,
+	0x84, 0x85, 0xa8, 0xa8, 0xd3, 0xf6, 0x8e, 0x68, 0xd4, 0xa5, 0x24, 0xa0, 0x0c, 0x95, 0x54, 0x80,
+	0xbd, 0x5e, 0xd8, 0x27, 0x7b, 0xc3, 0x4e, 0xef, 0x00, 0xb9, 0xea, 0xe2, 0xba, 0x94, 0x0c, 0x28,
+	0xf2, 0x9a, 0xbf, 0x2d, 0x28, 0x0d, 0xb8, 0xe9, 0xbb, 0x06, 0xf9, 0x4e, 0xa0, 0x1b, 0x76, 0x58,
+	0xbe, 0x13, 0xa8, 0x77, 0x30, 0xcb, 0xb8, 0xd4, 0x17, 0xa8, 0x36, 0xc7, 0x63, 0x2b, 0x5f, 0xad,
+	0xcf, 0x38, 0xcb, 0x66, 0x3c, 0x8e, 0x46, 0x53, 0xbd, 0x3e, 0x36, 0x73, 0x0d, 0x40, 0xa6, 0xf8,
+	0x39, 0xd4, 0x32, 0x31, 0x93, 0x9f, 0x78, 0x34, 0x8a, 0x63, 0xc9, 0x33, 0xf3, 0x2a, 0x3c, 0x56,
+	0x35, 0x28, 0x31, 0xa0, 0x8a, 0x31, 0xe1, 0x93, 0x63, 0x2e, 0xa3, 0x71, 0xec, 0x17, 0x74, 0x5a,
+	0xd7, 0x00, 0x9d, 0x18, 0x3f, 0x05, 0xe0, 0x5f, 0xd3, 0xb1, 0xe4, 0x99, 0xca, 0x50, 0xd4, 0x19,
+	0xbc, 0x05, 0x42, 0xa6, 0xd8, 0x87, 0x92, 0xe4, 0x9f, 0xc5, 0x19, 0x8f, 0xfd, 0x92, 0x5e, 0xd2,
+	0xa5, 0xbb, 0xeb, 0x5f, 0x5c, 0xd5, 0x73, 0x97, 0x57, 0xf5, 0xdc, 0xc5, 0x75, 0xdd, 0xba, 0xbc,
+	0xae, 0x5b, 0x7f, 0xae, 0xeb, 0xd6, 0xf7, 0xbf, 0xf5, 0xdc, 0x71, 0x51, 0x7f, 0x17, 0x3b, 0xff,
+	0x06, 0x00, 0xfa, 0x5f, 0x58, 0x55, 0x5a, 0x04, 0x00, 0x00,
 }
 
 func (m *UserAddOptions) Marshal() (dAtA []byte, err error) {
@@ -318,6 +494,15 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PasswordHistory) > 0 {
+		for iNdEx := len(m.PasswordHistory) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PasswordHistory[iNdEx])
+			copy(dAtA[i:], m.PasswordHistory[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.PasswordHistory[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Options != nil {
 		{
 			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
@@ -380,6 +565,16 @@ func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Deny {
+		i--
+		if m.Deny {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.RangeEnd) > 0 {
 		i -= len(m.RangeEnd)
 		copy(dAtA[i:], m.RangeEnd)
@@ -426,6 +621,20 @@ func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OperationPermission) > 0 {
+		for iNdEx := len(m.OperationPermission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationPermission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.KeyPermission) > 0 {
 		for iNdEx := len(m.KeyPermission) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -450,6 +659,109 @@ func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperationPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Operation != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MemberId != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MemberId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -500,6 +812,12 @@ func (m *User) Size() (n int) {
 		l = m.Options.Size()
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if len(m.PasswordHistory) > 0 {
+		for _, b := range m.PasswordHistory {
+			l = len(b)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -523,6 +841,9 @@ func (m *Permission) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if m.Deny {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -545,6 +866,62 @@ func (m *Role) Size() (n int) {
 			n += 1 + l + sovAuth(uint64(l))
 		}
 	}
+	if len(m.OperationPermission) > 0 {
+		for _, e := range m.OperationPermission {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperationPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovAuth(uint64(m.Operation))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAuth(uint64(m.ID))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovAuth(uint64(m.IssuedAt))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.MemberId != 0 {
+		n += 1 + sovAuth(uint64(m.MemberId))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAuth(uint64(m.ExpiresAt))
+	}
+	if m.Revoked {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -793,6 +1170,38 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordHistory", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordHistory = append(m.PasswordHistory, make([]byte, postIndex-iNdEx))
+			copy(m.PasswordHistory[len(m.PasswordHistory)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -931,6 +1340,26 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				m.RangeEnd = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deny = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -1050,6 +1479,321 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationPermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationPermission = append(m.OperationPermission, &OperationPermission{})
+			if err := m.OperationPermission[len(m.OperationPermission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= OperationPermission_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
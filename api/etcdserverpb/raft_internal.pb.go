@@ -30,7 +30,19 @@ type RequestHeader struct {
 	// username is a username that is associated with an auth token of gRPC connection
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// auth_revision is a revision number of auth.authStore. It is not related to mvcc
-	AuthRevision         uint64   `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	AuthRevision uint64 `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	// trace_context is the propagated trace context of the request, so that
+	// members record its commit and apply as part of the same trace.
+	TraceContext map[string]string `protobuf:"bytes,4,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// roles are the roles of the user granted by the auth token of gRPC
+	// connection in addition to the roles of the user stored in etcd
+	Roles []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	// authorized are the key ranges of the request allowed by the authorizer of
+	// the member proposing it, whose permissions are then not checked
+	Authorized []*AuthorizedRange `protobuf:"bytes,6,rep,name=authorized,proto3" json:"authorized,omitempty"`
+	// timestamp is the time in unix nanoseconds at which the member proposed
+	// the request, by the clock of the member
+	Timestamp            int64    `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -96,6 +108,8 @@ type InternalRaftRequest struct {
 	AuthUserRevokeRole       *AuthUserRevokeRoleRequest                `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole,proto3" json:"auth_user_revoke_role,omitempty"`
 	AuthUserList             *AuthUserListRequest                      `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList,proto3" json:"auth_user_list,omitempty"`
 	AuthRoleList             *AuthRoleListRequest                      `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList,proto3" json:"auth_role_list,omitempty"`
+	AuthUserUnlock           *AuthUserUnlockRequest                    `protobuf:"bytes,1108,opt,name=auth_user_unlock,json=authUserUnlock,proto3" json:"auth_user_unlock,omitempty"`
+	AuthSessionRevoke        *AuthSessionRevokeRequest                 `protobuf:"bytes,1109,opt,name=auth_session_revoke,json=authSessionRevoke,proto3" json:"auth_session_revoke,omitempty"`
 	AuthRoleAdd              *AuthRoleAddRequest                       `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd,proto3" json:"auth_role_add,omitempty"`
 	AuthRoleDelete           *AuthRoleDeleteRequest                    `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete,proto3" json:"auth_role_delete,omitempty"`
 	AuthRoleGet              *AuthRoleGetRequest                       `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet,proto3" json:"auth_role_get,omitempty"`
@@ -188,7 +202,14 @@ type InternalAuthenticateRequest struct {
 	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	// simple_token is generated in API layer (etcdserver/v3_server.go)
-	SimpleToken          string   `protobuf:"bytes,3,opt,name=simple_token,json=simpleToken,proto3" json:"simple_token,omitempty"`
+	SimpleToken string `protobuf:"bytes,3,opt,name=simple_token,json=simpleToken,proto3" json:"simple_token,omitempty"`
+	// issued_at, source_address and member_id describe the session of the
+	// authenticated user, they are filled in API layer (etcdserver/v3_server.go)
+	IssuedAt      int64  `protobuf:"varint,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	SourceAddress string `protobuf:"bytes,5,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	MemberId      uint64 `protobuf:"varint,6,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// session_ttl is the lifetime of the token in seconds, or zero if it is refreshed while it is used.
+	SessionTtl           int64    `protobuf:"varint,7,opt,name=session_ttl,json=sessionTtl,proto3" json:"session_ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -227,80 +248,147 @@ func (m *InternalAuthenticateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InternalAuthenticateRequest proto.InternalMessageInfo
 
+// AuthorizedRange is a key range of a request allowed by the authorizer.
+type AuthorizedRange struct {
+	// write is set for a write access to the range, unset for a read access
+	Write                bool     `protobuf:"varint,1,opt,name=write,proto3" json:"write,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd             []byte   `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthorizedRange) Reset()         { *m = AuthorizedRange{} }
+func (m *AuthorizedRange) String() string { return proto.CompactTextString(m) }
+func (*AuthorizedRange) ProtoMessage()    {}
+func (*AuthorizedRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4c9a9be0cfca103, []int{4}
+}
+func (m *AuthorizedRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizedRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizedRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizedRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizedRange.Merge(m, src)
+}
+func (m *AuthorizedRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizedRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizedRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizedRange proto.InternalMessageInfo
+
+// What is the difference between AuthenticateRequest (defined in rpc.proto) and InternalAuthenticateRequest?
+// InternalAuthenticateRequest has a member that is filled by etcdserver and shouldn't be user-facing.
+// For avoiding misusage the field, we have an internal version of AuthenticateRequest.
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "etcdserverpb.RequestHeader")
+	proto.RegisterMapType((map[string]string)(nil), "etcdserverpb.RequestHeader.TraceContextEntry")
 	proto.RegisterType((*InternalRaftRequest)(nil), "etcdserverpb.InternalRaftRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "etcdserverpb.EmptyResponse")
 	proto.RegisterType((*InternalAuthenticateRequest)(nil), "etcdserverpb.InternalAuthenticateRequest")
+	proto.RegisterType((*AuthorizedRange)(nil), "etcdserverpb.AuthorizedRange")
 }
 
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptor_b4c9a9be0cfca103) }
 
 var fileDescriptor_b4c9a9be0cfca103 = []byte{
-	// 1003 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xd9, 0x72, 0x1b, 0x45,
-	0x14, 0x86, 0x23, 0xc5, 0x71, 0xac, 0x96, 0xed, 0x38, 0x6d, 0x87, 0x34, 0x72, 0x95, 0x70, 0x1c,
-	0x12, 0xcc, 0x66, 0x53, 0xca, 0x03, 0x80, 0x90, 0x5c, 0x8e, 0xab, 0x42, 0x70, 0x4d, 0xcc, 0x52,
-	0xc5, 0xc5, 0xd0, 0x9a, 0x39, 0x96, 0x06, 0xcf, 0x46, 0x77, 0x4b, 0x31, 0xef, 0x11, 0x28, 0x1e,
-	0x83, 0xed, 0x21, 0x72, 0xc1, 0x62, 0xe0, 0x05, 0xc0, 0xdc, 0x70, 0x0f, 0xdc, 0x53, 0xbd, 0xcc,
-	0x26, 0xb5, 0x7c, 0xa7, 0xf9, 0xcf, 0x7f, 0xbe, 0x73, 0xba, 0xe7, 0xf4, 0xa8, 0xd1, 0x3a, 0xa3,
-	0x27, 0xc2, 0x0d, 0x62, 0x01, 0x2c, 0xa6, 0xe1, 0x6e, 0xca, 0x12, 0x91, 0xe0, 0x65, 0x10, 0x9e,
-	0xcf, 0x81, 0x4d, 0x80, 0xa5, 0x83, 0xd6, 0xc6, 0x30, 0x19, 0x26, 0x2a, 0xb0, 0x27, 0x7f, 0x69,
-	0x4f, 0x6b, 0xad, 0xf0, 0x18, 0xa5, 0xc1, 0x52, 0xcf, 0xfc, 0xbc, 0x2f, 0x83, 0x7b, 0x34, 0x0d,
-	0xf6, 0x22, 0x88, 0x06, 0xc0, 0xf8, 0x28, 0x48, 0xd3, 0x41, 0xe9, 0x41, 0xfb, 0xb6, 0x3f, 0x45,
-	0x2b, 0x0e, 0x7c, 0x3e, 0x06, 0x2e, 0x1e, 0x02, 0xf5, 0x81, 0xe1, 0x55, 0x54, 0x3f, 0xec, 0x93,
-	0xda, 0x56, 0x6d, 0x67, 0xc1, 0xa9, 0x1f, 0xf6, 0x71, 0x0b, 0x2d, 0x8d, 0xb9, 0x6c, 0x2d, 0x02,
-	0x52, 0xdf, 0xaa, 0xed, 0x34, 0x9c, 0xfc, 0x19, 0xdf, 0x45, 0x2b, 0x74, 0x2c, 0x46, 0x2e, 0x83,
-	0x49, 0xc0, 0x83, 0x24, 0x26, 0x57, 0x55, 0xda, 0xb2, 0x14, 0x1d, 0xa3, 0x6d, 0x3f, 0xc3, 0x68,
-	0xfd, 0xd0, 0xac, 0xce, 0xa1, 0x27, 0xc2, 0x94, 0xc3, 0x0f, 0xd0, 0xe2, 0x48, 0x95, 0x24, 0xfe,
-	0x56, 0x6d, 0xa7, 0xd9, 0xd9, 0xdc, 0x2d, 0xaf, 0x79, 0xb7, 0xd2, 0x95, 0x63, 0xac, 0x33, 0xdd,
-	0xdd, 0x43, 0xf5, 0x49, 0x47, 0xf5, 0xd5, 0xec, 0xdc, 0xb2, 0x02, 0x9c, 0xfa, 0xa4, 0x83, 0xdf,
-	0x42, 0xd7, 0x18, 0x8d, 0x87, 0xa0, 0x1a, 0x6c, 0x76, 0x5a, 0x53, 0x4e, 0x19, 0xca, 0xec, 0xda,
-	0x88, 0x5f, 0x43, 0x57, 0xd3, 0xb1, 0x20, 0x0b, 0xca, 0x4f, 0xaa, 0xfe, 0xa3, 0x71, 0xb6, 0x08,
-	0x47, 0x9a, 0x70, 0x0f, 0x2d, 0xfb, 0x10, 0x82, 0x00, 0x57, 0x17, 0xb9, 0xa6, 0x92, 0xb6, 0xaa,
-	0x49, 0x7d, 0xe5, 0xa8, 0x94, 0x6a, 0xfa, 0x85, 0x26, 0x0b, 0x8a, 0xb3, 0x98, 0x2c, 0xda, 0x0a,
-	0x1e, 0x9f, 0xc5, 0x79, 0x41, 0x71, 0x16, 0xe3, 0xb7, 0x11, 0xf2, 0x92, 0x28, 0xa5, 0x9e, 0x90,
-	0x9b, 0x7e, 0x5d, 0xa5, 0xbc, 0x54, 0x4d, 0xe9, 0xe5, 0xf1, 0x2c, 0xb3, 0x94, 0x82, 0xdf, 0x41,
-	0xcd, 0x10, 0x28, 0x07, 0x77, 0xc8, 0x68, 0x2c, 0xc8, 0x92, 0x8d, 0xf0, 0x48, 0x1a, 0x0e, 0x64,
-	0x3c, 0x27, 0x84, 0xb9, 0x24, 0xd7, 0xac, 0x09, 0x0c, 0x26, 0xc9, 0x29, 0x90, 0x86, 0x6d, 0xcd,
-	0x0a, 0xe1, 0x28, 0x43, 0xbe, 0xe6, 0xb0, 0xd0, 0xe4, 0x6b, 0xa1, 0x21, 0x65, 0x11, 0x41, 0xb6,
-	0xd7, 0xd2, 0x95, 0xa1, 0xfc, 0xb5, 0x28, 0x23, 0x7e, 0x1f, 0xad, 0xe9, 0xb2, 0xde, 0x08, 0xbc,
-	0xd3, 0x34, 0x09, 0x62, 0x41, 0x9a, 0x2a, 0xf9, 0x65, 0x4b, 0xe9, 0x5e, 0x6e, 0xca, 0x30, 0x37,
-	0xc2, 0xaa, 0x8e, 0xbb, 0xa8, 0xa9, 0x46, 0x18, 0x62, 0x3a, 0x08, 0x81, 0xfc, 0x6d, 0xdd, 0xcc,
-	0xee, 0x58, 0x8c, 0xf6, 0x95, 0x21, 0xdf, 0x0a, 0x9a, 0x4b, 0xb8, 0x8f, 0xd4, 0xc0, 0xbb, 0x7e,
-	0xc0, 0x15, 0xe3, 0x9f, 0xeb, 0xb6, 0xbd, 0x90, 0x8c, 0xbe, 0x76, 0xe4, 0x7b, 0x41, 0x0b, 0x2d,
-	0x6f, 0x84, 0x0b, 0x2a, 0xc6, 0x9c, 0xfc, 0x37, 0xb7, 0x91, 0x27, 0xca, 0x50, 0x69, 0x44, 0x4b,
-	0xf8, 0xb1, 0x6e, 0x04, 0x62, 0x11, 0x78, 0x54, 0x00, 0xf9, 0x57, 0x33, 0x5e, 0xad, 0x32, 0xb2,
-	0xb3, 0xd8, 0x2d, 0x59, 0x33, 0x5a, 0x25, 0x1f, 0xef, 0x9b, 0xe3, 0x2d, 0xcf, 0xbb, 0x4b, 0x7d,
-	0x9f, 0xfc, 0xb8, 0x34, 0x6f, 0x65, 0x1f, 0x70, 0x60, 0x5d, 0xdf, 0xaf, 0xac, 0xcc, 0x68, 0xf8,
-	0x31, 0x5a, 0x2b, 0x30, 0x7a, 0xe4, 0xc9, 0x4f, 0x9a, 0x74, 0xd7, 0x4e, 0x32, 0x67, 0xc5, 0xc0,
-	0x56, 0x69, 0x45, 0xae, 0xb6, 0x35, 0x04, 0x41, 0x7e, 0xbe, 0xb4, 0xad, 0x03, 0x10, 0x33, 0x6d,
-	0x1d, 0x80, 0xc0, 0x43, 0xf4, 0x62, 0x81, 0xf1, 0x46, 0xf2, 0x10, 0xba, 0x29, 0xe5, 0xfc, 0x69,
-	0xc2, 0x7c, 0xf2, 0x8b, 0x46, 0xbe, 0x6e, 0x47, 0xf6, 0x94, 0xfb, 0xc8, 0x98, 0x33, 0xfa, 0x0b,
-	0xd4, 0x1a, 0xc6, 0x1f, 0xa3, 0x8d, 0x52, 0xbf, 0xf2, 0xf4, 0xb8, 0x2c, 0x09, 0x81, 0x9c, 0xeb,
-	0x1a, 0xf7, 0xe7, 0xb4, 0xad, 0x4e, 0x5e, 0x52, 0x4c, 0xcb, 0x4d, 0x3a, 0x1d, 0xc1, 0x9f, 0xa0,
-	0x5b, 0x05, 0x59, 0x1f, 0x44, 0x8d, 0xfe, 0x55, 0xa3, 0x5f, 0xb1, 0xa3, 0xcd, 0x89, 0x2c, 0xb1,
-	0x31, 0x9d, 0x09, 0xe1, 0x87, 0x68, 0xb5, 0x80, 0x87, 0x01, 0x17, 0xe4, 0x37, 0x4d, 0xbd, 0x63,
-	0xa7, 0x3e, 0x0a, 0xb8, 0xa8, 0xcc, 0x51, 0x26, 0xe6, 0x24, 0xd9, 0x9a, 0x26, 0xfd, 0x3e, 0x97,
-	0x24, 0x4b, 0xcf, 0x90, 0x32, 0x31, 0x7f, 0xf5, 0x8a, 0x24, 0x27, 0xf2, 0x9b, 0xc6, 0xbc, 0x57,
-	0x2f, 0x73, 0xa6, 0x27, 0xd2, 0x68, 0xf9, 0x44, 0x2a, 0x8c, 0x99, 0xc8, 0x6f, 0x1b, 0xf3, 0x26,
-	0x52, 0x66, 0x59, 0x26, 0xb2, 0x90, 0xab, 0x6d, 0xc9, 0x89, 0xfc, 0xee, 0xd2, 0xb6, 0xa6, 0x27,
-	0xd2, 0x68, 0xf8, 0x33, 0xd4, 0x2a, 0x61, 0xd4, 0xa0, 0xa4, 0xc0, 0xa2, 0x80, 0xab, 0xff, 0xd6,
-	0xef, 0x35, 0xf3, 0x8d, 0x39, 0x4c, 0x69, 0x3f, 0xca, 0xdd, 0x19, 0xff, 0x36, 0xb5, 0xc7, 0x71,
-	0x84, 0x36, 0x8b, 0x5a, 0x66, 0x74, 0x4a, 0xc5, 0x7e, 0xd0, 0xc5, 0xde, 0xb4, 0x17, 0xd3, 0x53,
-	0x32, 0x5b, 0x8d, 0xd0, 0x39, 0x06, 0xfc, 0x11, 0x5a, 0xf7, 0xc2, 0x31, 0x17, 0xc0, 0xdc, 0x09,
-	0x30, 0x29, 0xb9, 0x1c, 0x04, 0x79, 0x86, 0xcc, 0x11, 0x28, 0x5f, 0x52, 0x76, 0x7b, 0xda, 0xf9,
-	0xa1, 0x36, 0x3e, 0x29, 0x76, 0xeb, 0xa6, 0x37, 0x1d, 0xc1, 0x14, 0xdd, 0xce, 0xc0, 0x9a, 0xe1,
-	0x52, 0x21, 0x98, 0x82, 0x7f, 0x89, 0xcc, 0xe7, 0xcf, 0x06, 0x7f, 0x4f, 0x69, 0x5d, 0x21, 0x58,
-	0x89, 0xbf, 0xe1, 0x59, 0x82, 0xf8, 0x18, 0x61, 0x3f, 0x79, 0x1a, 0x0f, 0x19, 0xf5, 0xc1, 0x0d,
-	0xe2, 0x93, 0x44, 0xd1, 0xbf, 0xd2, 0xf4, 0x7b, 0x55, 0x7a, 0x3f, 0x33, 0x1e, 0xc6, 0x27, 0x49,
-	0x89, 0xbc, 0xe6, 0x4f, 0x05, 0xb6, 0x6f, 0xa0, 0x95, 0xfd, 0x28, 0x15, 0x5f, 0x38, 0xc0, 0xd3,
-	0x24, 0xe6, 0xb0, 0x9d, 0xa2, 0xcd, 0x4b, 0x3e, 0xcd, 0x18, 0xa3, 0x05, 0x75, 0x07, 0xab, 0xa9,
-	0x3b, 0x98, 0xfa, 0x2d, 0xef, 0x66, 0xf9, 0x17, 0xcb, 0xdc, 0xcd, 0xb2, 0x67, 0x7c, 0x07, 0x2d,
-	0xf3, 0x20, 0x4a, 0x43, 0x70, 0x45, 0x72, 0x0a, 0xfa, 0x6a, 0xd6, 0x70, 0x9a, 0x5a, 0x3b, 0x96,
-	0xd2, 0xbb, 0x1b, 0xcf, 0xff, 0x6c, 0x5f, 0x79, 0x7e, 0xd1, 0xae, 0x9d, 0x5f, 0xb4, 0x6b, 0x7f,
-	0x5c, 0xb4, 0x6b, 0x5f, 0xff, 0xd5, 0xbe, 0x32, 0x58, 0x54, 0x17, 0xc3, 0x07, 0xff, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x94, 0x6f, 0x64, 0x0a, 0x98, 0x0a, 0x00, 0x00,
+	// 1283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x57, 0xcb, 0x73, 0x1b, 0xc5,
+	0x13, 0x8e, 0x24, 0xdb, 0xd1, 0x8e, 0x64, 0xc7, 0x1e, 0x3b, 0xbf, 0xcc, 0x4f, 0x06, 0x47, 0x71,
+	0x48, 0x10, 0x8f, 0x38, 0x94, 0x72, 0xa1, 0xa8, 0xa2, 0x82, 0x90, 0x5c, 0x89, 0xab, 0x42, 0x48,
+	0x6d, 0x9c, 0x40, 0x15, 0x87, 0xad, 0xf1, 0x6e, 0x5b, 0x5a, 0xbc, 0xda, 0x5d, 0x66, 0x66, 0x15,
+	0x87, 0xbf, 0x03, 0x28, 0xfe, 0x0c, 0x5e, 0x57, 0xee, 0x39, 0xf0, 0x08, 0xaf, 0x3b, 0x98, 0x4b,
+	0xce, 0x3c, 0xee, 0xd4, 0xcc, 0xec, 0x53, 0x5a, 0xf9, 0xb6, 0xf3, 0xf5, 0xd7, 0x5f, 0x77, 0xcf,
+	0xf4, 0x8c, 0x5a, 0x68, 0x9d, 0xd1, 0x43, 0x61, 0xb9, 0xbe, 0x00, 0xe6, 0x53, 0x6f, 0x27, 0x64,
+	0x81, 0x08, 0x70, 0x13, 0x84, 0xed, 0x70, 0x60, 0x13, 0x60, 0xe1, 0x41, 0x6b, 0x63, 0x18, 0x0c,
+	0x03, 0x65, 0xb8, 0x2e, 0xbf, 0x34, 0xa7, 0xb5, 0x9a, 0x71, 0x62, 0xc4, 0x60, 0xa1, 0x1d, 0x7f,
+	0x5e, 0x95, 0xc6, 0xeb, 0x34, 0x74, 0xaf, 0x8f, 0x61, 0x7c, 0x00, 0x8c, 0x8f, 0xdc, 0x30, 0x3c,
+	0xc8, 0x2d, 0x34, 0x6f, 0xfb, 0x59, 0x15, 0x2d, 0x9b, 0xf0, 0x51, 0x04, 0x5c, 0xdc, 0x06, 0xea,
+	0x00, 0xc3, 0x2b, 0xa8, 0xba, 0x37, 0x20, 0x95, 0x76, 0xa5, 0xb3, 0x60, 0x56, 0xf7, 0x06, 0xb8,
+	0x85, 0xea, 0x11, 0x97, 0xb9, 0x8d, 0x81, 0x54, 0xdb, 0x95, 0x8e, 0x61, 0xa6, 0x6b, 0x7c, 0x19,
+	0x2d, 0xd3, 0x48, 0x8c, 0x2c, 0x06, 0x13, 0x97, 0xbb, 0x81, 0x4f, 0x6a, 0xca, 0xad, 0x29, 0x41,
+	0x33, 0xc6, 0xb0, 0x89, 0x96, 0x05, 0xa3, 0x36, 0x58, 0x76, 0xe0, 0x0b, 0x38, 0x16, 0x64, 0xa1,
+	0x5d, 0xeb, 0x34, 0xba, 0xd7, 0x76, 0xf2, 0x35, 0xee, 0x14, 0x92, 0xd8, 0xd9, 0x97, 0x0e, 0x7d,
+	0xcd, 0xdf, 0xf5, 0x05, 0x7b, 0x6c, 0x36, 0x45, 0x0e, 0xc2, 0x1b, 0x68, 0x91, 0x05, 0x1e, 0x70,
+	0xb2, 0xd8, 0xae, 0x75, 0x0c, 0x53, 0x2f, 0xf0, 0x9b, 0x08, 0xc9, 0xc8, 0x01, 0x73, 0x3f, 0x06,
+	0x87, 0x2c, 0xa9, 0x30, 0xcf, 0x17, 0xc3, 0xf4, 0x52, 0xbb, 0x49, 0xfd, 0x21, 0x98, 0x39, 0x07,
+	0xfc, 0x1c, 0x32, 0x84, 0x3b, 0x06, 0x2e, 0xe8, 0x38, 0x24, 0x67, 0xdb, 0x95, 0x4e, 0xcd, 0xcc,
+	0x80, 0xd6, 0x4d, 0xb4, 0x36, 0x93, 0x15, 0x5e, 0x45, 0xb5, 0x23, 0x78, 0xac, 0x76, 0xcb, 0x30,
+	0xe5, 0xa7, 0xcc, 0x6c, 0x42, 0xbd, 0x28, 0xd9, 0x2b, 0xbd, 0x78, 0xa3, 0xfa, 0x7a, 0x65, 0xfb,
+	0xdb, 0x75, 0xb4, 0xbe, 0x17, 0x1f, 0xb3, 0x49, 0x0f, 0x45, 0x5c, 0x31, 0xbe, 0x81, 0x96, 0x46,
+	0xaa, 0x6a, 0xe2, 0xb4, 0x2b, 0x9d, 0x46, 0x77, 0xf3, 0x94, 0x8d, 0x31, 0x97, 0x46, 0xe5, 0xa7,
+	0x74, 0x05, 0x55, 0x27, 0x5d, 0x15, 0xb3, 0xd1, 0x3d, 0x5f, 0x2a, 0x60, 0x56, 0x27, 0x5d, 0xfc,
+	0x1a, 0x5a, 0x64, 0xb2, 0x6e, 0x75, 0x50, 0x8d, 0x6e, 0x6b, 0x8a, 0xa9, 0xb6, 0x24, 0xa6, 0x6b,
+	0x22, 0x7e, 0x19, 0xd5, 0xc2, 0x48, 0x9e, 0x99, 0xe4, 0x93, 0x22, 0xff, 0x5e, 0x94, 0x14, 0x61,
+	0x4a, 0x12, 0xee, 0xa3, 0xa6, 0x03, 0x1e, 0x08, 0xb0, 0x74, 0x90, 0x45, 0xe5, 0xd4, 0x2e, 0x3a,
+	0x0d, 0x14, 0xa3, 0x10, 0xaa, 0xe1, 0x64, 0x98, 0x0c, 0x28, 0x8e, 0x7d, 0xb2, 0x54, 0x16, 0x70,
+	0xff, 0xd8, 0x4f, 0x03, 0x8a, 0x63, 0x1f, 0xdf, 0x44, 0xc8, 0x0e, 0xc6, 0x21, 0xb5, 0x85, 0x6c,
+	0xbe, 0xb3, 0xca, 0xe5, 0x62, 0xd1, 0xa5, 0x9f, 0xda, 0x13, 0xcf, 0x9c, 0x0b, 0x7e, 0x0b, 0x35,
+	0x3c, 0xa0, 0x1c, 0xac, 0x21, 0xa3, 0xbe, 0x20, 0xf5, 0x32, 0x85, 0x3b, 0x92, 0x70, 0x4b, 0xda,
+	0x53, 0x05, 0x2f, 0x85, 0x64, 0xcd, 0x5a, 0x81, 0xc1, 0x24, 0x38, 0x02, 0x62, 0x94, 0xd5, 0xac,
+	0x24, 0x4c, 0x45, 0x48, 0x6b, 0xf6, 0x32, 0x4c, 0x1e, 0x0b, 0xf5, 0x28, 0x1b, 0x13, 0x54, 0x76,
+	0x2c, 0x3d, 0x69, 0x4a, 0x8f, 0x45, 0x11, 0xf1, 0xbb, 0x68, 0x55, 0x87, 0xb5, 0x47, 0x60, 0x1f,
+	0x85, 0x81, 0xeb, 0x0b, 0xd2, 0x50, 0xce, 0x2f, 0x94, 0x84, 0xee, 0xa7, 0xa4, 0x44, 0xe6, 0x9c,
+	0x57, 0xc4, 0x71, 0x0f, 0x35, 0xd4, 0x55, 0x06, 0x9f, 0x1e, 0x78, 0x40, 0x9e, 0x95, 0x6e, 0xa6,
+	0xbc, 0x3d, 0xbb, 0x8a, 0x90, 0x6e, 0x05, 0x4d, 0x21, 0x3c, 0x40, 0xea, 0xe2, 0x5b, 0x8e, 0xcb,
+	0x95, 0xc6, 0xdf, 0x67, 0xcb, 0xf6, 0x42, 0x6a, 0x0c, 0x5c, 0x9e, 0x17, 0x69, 0xd0, 0x0c, 0x4b,
+	0x13, 0xe1, 0x82, 0x8a, 0x88, 0x93, 0x7f, 0xe7, 0x26, 0x72, 0x5f, 0x11, 0x0a, 0x89, 0x68, 0x08,
+	0xdf, 0xd5, 0x89, 0x80, 0x2f, 0x5c, 0x9b, 0x0a, 0x20, 0xff, 0x68, 0x8d, 0x97, 0x8a, 0x1a, 0xc9,
+	0x5d, 0xec, 0xe5, 0xa8, 0x89, 0x5a, 0xc1, 0x1f, 0xef, 0xc6, 0xcf, 0x5c, 0xc4, 0x81, 0x59, 0xd4,
+	0x71, 0xc8, 0x77, 0xf5, 0x79, 0x95, 0x3d, 0xe0, 0xc0, 0x7a, 0x8e, 0x53, 0xa8, 0x2c, 0xc6, 0xf0,
+	0x5d, 0xb4, 0x9a, 0xc9, 0xe8, 0x96, 0x27, 0xdf, 0x6b, 0xa5, 0xcb, 0xe5, 0x4a, 0xf1, 0x5d, 0x89,
+	0xc5, 0x56, 0x68, 0x01, 0x2e, 0xa6, 0x35, 0x04, 0x41, 0x7e, 0x38, 0x35, 0xad, 0x5b, 0x20, 0x66,
+	0xd2, 0xba, 0x05, 0x02, 0x0f, 0xd1, 0xff, 0x33, 0x19, 0x7b, 0x24, 0x2f, 0xa1, 0x15, 0x52, 0xce,
+	0x1f, 0x05, 0xcc, 0x21, 0x3f, 0x6a, 0xc9, 0x57, 0xca, 0x25, 0xfb, 0x8a, 0x7d, 0x2f, 0x26, 0x27,
+	0xea, 0xff, 0xa3, 0xa5, 0x66, 0xfc, 0x3e, 0xda, 0xc8, 0xe5, 0x2b, 0x6f, 0x8f, 0x25, 0xdf, 0x6d,
+	0xf2, 0x54, 0xc7, 0xb8, 0x3a, 0x27, 0x6d, 0x75, 0xf3, 0x82, 0xac, 0x5b, 0xd6, 0xe8, 0xb4, 0x05,
+	0x7f, 0x80, 0xce, 0x67, 0xca, 0xfa, 0x22, 0x6a, 0xe9, 0x9f, 0xb4, 0xf4, 0x8b, 0xe5, 0xd2, 0xf1,
+	0x8d, 0xcc, 0x69, 0x63, 0x3a, 0x63, 0xc2, 0xb7, 0xd1, 0x4a, 0x26, 0xee, 0xb9, 0x5c, 0x90, 0x9f,
+	0xb5, 0xea, 0xa5, 0x72, 0xd5, 0x3b, 0x2e, 0x17, 0x85, 0x3e, 0x4a, 0xc0, 0x54, 0x49, 0xa6, 0xa6,
+	0x95, 0x7e, 0x99, 0xab, 0x24, 0x43, 0xcf, 0x28, 0x25, 0x60, 0x7a, 0xf4, 0x4a, 0x49, 0x76, 0xe4,
+	0x17, 0xc6, 0xbc, 0xa3, 0x97, 0x3e, 0xd3, 0x1d, 0x19, 0x63, 0x69, 0x47, 0x2a, 0x99, 0xb8, 0x23,
+	0xbf, 0x34, 0xe6, 0x75, 0xa4, 0xf4, 0x2a, 0xe9, 0xc8, 0x0c, 0x2e, 0xa6, 0x25, 0x3b, 0xf2, 0xab,
+	0x53, 0xd3, 0x9a, 0xee, 0xc8, 0x18, 0xc3, 0x1f, 0xa2, 0x56, 0x4e, 0x46, 0x35, 0x4a, 0x08, 0x6c,
+	0xec, 0x72, 0x35, 0x63, 0x7c, 0xad, 0x35, 0x5f, 0x9d, 0xa3, 0x29, 0xe9, 0xf7, 0x52, 0x76, 0xa2,
+	0x7f, 0x81, 0x96, 0xdb, 0xf1, 0x18, 0x6d, 0x66, 0xb1, 0xe2, 0xd6, 0xc9, 0x05, 0xfb, 0x46, 0x07,
+	0xbb, 0x56, 0x1e, 0x4c, 0x77, 0xc9, 0x6c, 0x34, 0x42, 0xe7, 0x10, 0xf0, 0x7b, 0x68, 0xdd, 0xf6,
+	0x22, 0x2e, 0x80, 0x59, 0x13, 0x60, 0x12, 0xb2, 0x38, 0x08, 0xf2, 0x09, 0x8a, 0xaf, 0x40, 0x7e,
+	0x5a, 0xdb, 0xe9, 0x6b, 0xe6, 0x43, 0x4d, 0xbc, 0x9f, 0xed, 0xd6, 0x9a, 0x3d, 0x6d, 0xc1, 0x14,
+	0x5d, 0x48, 0x84, 0xb5, 0x86, 0x45, 0x85, 0x60, 0x4a, 0xfc, 0x53, 0x14, 0x3f, 0x7f, 0x65, 0xe2,
+	0xef, 0x28, 0xac, 0x27, 0x04, 0xcb, 0xe9, 0x6f, 0xd8, 0x25, 0x46, 0xbc, 0x8f, 0xb0, 0x13, 0x3c,
+	0xf2, 0x87, 0x8c, 0x3a, 0x60, 0xb9, 0xfe, 0x61, 0xa0, 0xd4, 0x3f, 0xd3, 0xea, 0x57, 0x8a, 0xea,
+	0x83, 0x84, 0xb8, 0xe7, 0x1f, 0x06, 0x39, 0xe5, 0x55, 0x67, 0xca, 0x50, 0x7c, 0x15, 0x23, 0xdf,
+	0x0b, 0xec, 0x23, 0xf2, 0xeb, 0xa9, 0xaf, 0xe2, 0x03, 0x45, 0x9a, 0x79, 0x15, 0x35, 0x2c, 0x77,
+	0x58, 0xe9, 0x71, 0x50, 0x3b, 0x9e, 0xfc, 0x2e, 0xff, 0x36, 0xf7, 0x91, 0xb9, 0x0f, 0xf1, 0xd9,
+	0xe5, 0x7f, 0x9e, 0xd7, 0xe8, 0xb4, 0x65, 0xfb, 0x1c, 0x5a, 0xde, 0x1d, 0x87, 0xe2, 0xb1, 0x09,
+	0x3c, 0x0c, 0x7c, 0x0e, 0xdb, 0x7f, 0x55, 0xd0, 0xe6, 0x29, 0x3f, 0x22, 0x18, 0xa3, 0x05, 0x35,
+	0x35, 0xeb, 0xe9, 0x50, 0x7d, 0xcb, 0x69, 0x3a, 0x7d, 0x5b, 0xe3, 0x69, 0x3a, 0x59, 0xe3, 0x4b,
+	0xa8, 0xc9, 0xdd, 0x71, 0xe8, 0x81, 0x25, 0x82, 0x23, 0xd0, 0xc3, 0xb4, 0x61, 0x36, 0x34, 0xb6,
+	0x2f, 0x21, 0xbc, 0x89, 0x0c, 0x97, 0xf3, 0x08, 0x1c, 0x8b, 0xea, 0x99, 0xac, 0x66, 0xd6, 0x35,
+	0xd0, 0x13, 0xf8, 0x0a, 0x5a, 0xe1, 0x41, 0xc4, 0x6c, 0xf5, 0x22, 0x30, 0xe0, 0x5c, 0x0d, 0x60,
+	0x86, 0xb9, 0xac, 0xd1, 0x9e, 0x06, 0xa5, 0x46, 0xdc, 0x21, 0xae, 0xa3, 0xc6, 0xac, 0x05, 0xb3,
+	0xae, 0x81, 0x3d, 0x07, 0x5f, 0x44, 0x8d, 0x64, 0xe3, 0x84, 0xf0, 0xe2, 0x29, 0x18, 0xc5, 0xd0,
+	0xbe, 0xf0, 0xb6, 0x1f, 0xa2, 0x73, 0x53, 0x33, 0xb4, 0x1c, 0x79, 0x1f, 0x31, 0x57, 0xe8, 0x42,
+	0xeb, 0xa6, 0x5e, 0x24, 0xa3, 0xb1, 0x2c, 0xb2, 0xa9, 0x47, 0xe3, 0x4d, 0x64, 0xa8, 0xb9, 0xd0,
+	0x02, 0xdf, 0x51, 0xc5, 0x35, 0xcd, 0xba, 0x02, 0x76, 0x7d, 0xe7, 0xed, 0x8d, 0x27, 0x7f, 0x6c,
+	0x9d, 0x79, 0x72, 0xb2, 0x55, 0x79, 0x7a, 0xb2, 0x55, 0xf9, 0xfd, 0x64, 0xab, 0xf2, 0xf9, 0x9f,
+	0x5b, 0x67, 0x0e, 0x96, 0xd4, 0xbf, 0x94, 0x1b, 0xff, 0x0d, 0x00, 0x02, 0x88, 0x27, 0xd0, 0x25,
+	0x0d, 0x00, 0x00,
 }
 
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
@@ -327,6 +415,53 @@ func (m *RequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Authorized) > 0 {
+		for iNdEx := len(m.Authorized) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorized[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRaftInternal(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRaftInternal(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRaftInternal(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.AuthRevision != 0 {
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRevision))
 		i--
@@ -483,6 +618,34 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x82
 	}
+	if m.AuthSessionRevoke != nil {
+		{
+			size, err := m.AuthSessionRevoke.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x45
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.AuthUserUnlock != nil {
+		{
+			size, err := m.AuthUserUnlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x45
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.AuthRoleList != nil {
 		{
 			size, err := m.AuthRoleList.MarshalToSizedBuffer(dAtA[:i])
@@ -844,6 +1007,28 @@ func (m *InternalAuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SessionTtl != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.SessionTtl))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MemberId != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.MemberId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.SimpleToken) > 0 {
 		i -= len(m.SimpleToken)
 		copy(dAtA[i:], m.SimpleToken)
@@ -868,6 +1053,57 @@ func (m *InternalAuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthorizedRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizedRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizedRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RangeEnd) > 0 {
+		i -= len(m.RangeEnd)
+		copy(dAtA[i:], m.RangeEnd)
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.RangeEnd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Write {
+		i--
+		if m.Write {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRaftInternal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRaftInternal(v)
 	base := offset
@@ -895,6 +1131,29 @@ func (m *RequestHeader) Size() (n int) {
 	if m.AuthRevision != 0 {
 		n += 1 + sovRaftInternal(uint64(m.AuthRevision))
 	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRaftInternal(uint64(len(k))) + 1 + len(v) + sovRaftInternal(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRaftInternal(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovRaftInternal(uint64(l))
+		}
+	}
+	if len(m.Authorized) > 0 {
+		for _, e := range m.Authorized {
+			l = e.Size()
+			n += 1 + l + sovRaftInternal(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Timestamp))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1002,6 +1261,14 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.AuthRoleList.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthUserUnlock != nil {
+		l = m.AuthUserUnlock.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthSessionRevoke != nil {
+		l = m.AuthSessionRevoke.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
 	if m.AuthRoleAdd != nil {
 		l = m.AuthRoleAdd.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1070,6 +1337,42 @@ func (m *InternalAuthenticateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovRaftInternal(uint64(m.IssuedAt))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.MemberId != 0 {
+		n += 1 + sovRaftInternal(uint64(m.MemberId))
+	}
+	if m.SessionTtl != 0 {
+		n += 1 + sovRaftInternal(uint64(m.SessionTtl))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizedRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Write {
+		n += 2
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	l = len(m.RangeEnd)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1181,6 +1484,218 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRaftInternal
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRaftInternal
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRaftInternal
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRaftInternal
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftInternal(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftInternal
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorized = append(m.Authorized, &AuthorizedRange{})
+			if err := m.Authorized[len(m.Authorized)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftInternal(dAtA[iNdEx:])
@@ -2079,6 +2594,78 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 1108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthUserUnlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthUserUnlock == nil {
+				m.AuthUserUnlock = &AuthUserUnlockRequest{}
+			}
+			if err := m.AuthUserUnlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSessionRevoke", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthSessionRevoke == nil {
+				m.AuthSessionRevoke = &AuthSessionRevokeRequest{}
+			}
+			if err := m.AuthSessionRevoke.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthRoleAdd", wireType)
@@ -2565,6 +3152,234 @@ func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SimpleToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTtl", wireType)
+			}
+			m.SessionTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizedRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizedRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizedRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Write = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeEnd = append(m.RangeEnd[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangeEnd == nil {
+				m.RangeEnd = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftInternal(dAtA[iNdEx:])
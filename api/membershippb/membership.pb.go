@@ -70,8 +70,11 @@ var xxx_messageInfo_RaftAttributes proto.InternalMessageInfo
 
 // Attributes represents all the non-raft related attributes of an etcd member.
 type Attributes struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ClientUrls           []string `protobuf:"bytes,2,rep,name=client_urls,json=clientUrls,proto3" json:"client_urls,omitempty"`
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ClientUrls []string `protobuf:"bytes,2,rep,name=client_urls,json=clientUrls,proto3" json:"client_urls,omitempty"`
+	// capabilities are the capabilities the member advertises to the other
+	// members, which are unknown to the members not advertising any
+	Capabilities         []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -286,30 +289,31 @@ func init() {
 func init() { proto.RegisterFile("membership.proto", fileDescriptor_949fe0d019050ef5) }
 
 var fileDescriptor_949fe0d019050ef5 = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
-	0x14, 0x85, 0x99, 0x42, 0xf8, 0xdb, 0xcb, 0x1f, 0xc4, 0x09, 0x89, 0x8d, 0x68, 0x25, 0x5d, 0xb1,
-	0x30, 0x98, 0xe8, 0x13, 0xa0, 0xb0, 0x20, 0x81, 0xcd, 0x18, 0xdd, 0x92, 0x56, 0x2e, 0xd8, 0xa4,
-	0x74, 0xea, 0xcc, 0x54, 0xd7, 0xbe, 0x85, 0x4f, 0xe0, 0xb3, 0xb0, 0xf4, 0x11, 0x14, 0x5f, 0xc4,
-	0x74, 0x5a, 0x4a, 0x49, 0xdc, 0xb8, 0xbb, 0x3d, 0xbd, 0xf7, 0x9c, 0xf3, 0x35, 0x85, 0xd6, 0x0a,
-	0x57, 0x3e, 0x0a, 0xf9, 0x18, 0xc4, 0xfd, 0x58, 0x70, 0xc5, 0xe9, 0xff, 0x9d, 0x12, 0xfb, 0xc7,
-	0xed, 0x25, 0x5f, 0x72, 0xfd, 0xe2, 0x22, 0x9d, 0xb2, 0x1d, 0x77, 0x02, 0x4d, 0xe6, 0x2d, 0xd4,
-	0x40, 0x29, 0x11, 0xf8, 0x89, 0x42, 0x49, 0x3b, 0x60, 0xc5, 0x88, 0x62, 0x96, 0x88, 0x50, 0xda,
-	0xa4, 0x5b, 0xed, 0x59, 0xcc, 0x4c, 0x85, 0x3b, 0x11, 0x4a, 0x7a, 0x0a, 0x10, 0xc8, 0x59, 0x88,
-	0x9e, 0x88, 0x50, 0xd8, 0x46, 0x97, 0xf4, 0x4c, 0x66, 0x05, 0x72, 0x92, 0x09, 0xee, 0x00, 0xa0,
-	0xe4, 0x44, 0xa1, 0x16, 0x79, 0x2b, 0xb4, 0x49, 0x97, 0xf4, 0x2c, 0xa6, 0x67, 0x7a, 0x06, 0x8d,
-	0x87, 0x30, 0xc0, 0x48, 0x65, 0xfe, 0x86, 0xf6, 0x87, 0x4c, 0x4a, 0x13, 0xdc, 0x77, 0x02, 0xf5,
-	0xa9, 0xee, 0x4d, 0x9b, 0x60, 0x8c, 0x87, 0xfa, 0xba, 0xc6, 0x8c, 0xf1, 0x90, 0x8e, 0xe0, 0x40,
-	0x78, 0x0b, 0x35, 0xf3, 0x8a, 0x08, 0xdd, 0xa0, 0x71, 0x79, 0xd2, 0x2f, 0x93, 0xf6, 0xf7, 0x81,
-	0x58, 0x53, 0xec, 0x03, 0x8e, 0xe0, 0x30, 0x5b, 0x2f, 0x1b, 0x55, 0xb5, 0x91, 0xbd, 0x6f, 0x54,
-	0x32, 0xc9, 0xbf, 0xee, 0x4e, 0x71, 0xcf, 0xc1, 0xbe, 0x09, 0x13, 0xa9, 0x50, 0xdc, 0xa3, 0x90,
-	0x01, 0x8f, 0x6e, 0x51, 0x31, 0x7c, 0x4a, 0x50, 0x2a, 0xda, 0x82, 0xea, 0x33, 0x8a, 0x1c, 0x3c,
-	0x1d, 0xdd, 0x57, 0x02, 0x9d, 0x7c, 0x7d, 0x5a, 0x38, 0x95, 0x2e, 0x3a, 0x60, 0xe5, 0xa5, 0x0a,
-	0x64, 0x33, 0x13, 0x34, 0xf8, 0x2f, 0x8d, 0x8d, 0x3f, 0x37, 0x1e, 0xc1, 0xd1, 0x90, 0xbf, 0x44,
-	0x4b, 0xe1, 0xcd, 0x71, 0x1c, 0x2d, 0x78, 0x29, 0xde, 0x86, 0x7f, 0x18, 0x79, 0x7e, 0x88, 0x73,
-	0x1d, 0x6e, 0xb2, 0xed, 0xe3, 0x16, 0xc5, 0x28, 0x50, 0xae, 0xdb, 0xeb, 0x2f, 0xa7, 0xb2, 0xde,
-	0x38, 0xe4, 0x63, 0xe3, 0x90, 0xcf, 0x8d, 0x43, 0xde, 0xbe, 0x9d, 0x8a, 0x5f, 0xd7, 0xff, 0xd3,
-	0xd5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x93, 0x7d, 0x0b, 0x87, 0x02, 0x00, 0x00,
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8e, 0xda, 0x40,
+	0x10, 0x45, 0x69, 0x1b, 0x11, 0xbb, 0x40, 0x84, 0xb4, 0x90, 0x62, 0x85, 0xc4, 0x41, 0x5e, 0xb1,
+	0x88, 0x88, 0x94, 0x9c, 0x20, 0x89, 0x59, 0x58, 0x82, 0x4d, 0x47, 0xc9, 0x16, 0xb5, 0xa1, 0x20,
+	0x2d, 0x19, 0xdb, 0xe9, 0x6e, 0x27, 0xeb, 0xdc, 0x22, 0x27, 0x98, 0xb3, 0xb0, 0x9c, 0x23, 0xcc,
+	0x30, 0x17, 0x19, 0xd1, 0x36, 0xc6, 0x96, 0x66, 0x33, 0xbb, 0xf2, 0x77, 0xd5, 0xab, 0xff, 0xcb,
+	0x86, 0xd1, 0x01, 0x0f, 0x31, 0x4a, 0xf5, 0x4b, 0xe4, 0xf3, 0x5c, 0x66, 0x3a, 0xa3, 0x83, 0xab,
+	0x92, 0xc7, 0x6f, 0xc6, 0xfb, 0x6c, 0x9f, 0x99, 0x17, 0x1f, 0xcf, 0x55, 0xd9, 0x13, 0x2c, 0x61,
+	0xc8, 0xf8, 0x4e, 0x7f, 0xd1, 0x5a, 0x8a, 0xb8, 0xd0, 0xa8, 0xe8, 0x04, 0xdc, 0x1c, 0x51, 0xae,
+	0x0b, 0x99, 0x28, 0x8f, 0x4c, 0xed, 0x99, 0xcb, 0x9c, 0xb3, 0xf0, 0x43, 0x26, 0x8a, 0xbe, 0x03,
+	0x10, 0x6a, 0x9d, 0x20, 0x97, 0x29, 0x4a, 0xcf, 0x9a, 0x92, 0x99, 0xc3, 0x5c, 0xa1, 0x96, 0xa5,
+	0x10, 0x20, 0x40, 0x83, 0x44, 0xa1, 0x9b, 0xf2, 0x03, 0x7a, 0x64, 0x4a, 0x66, 0x2e, 0x33, 0x35,
+	0x7d, 0x0f, 0xfd, 0x4d, 0x22, 0x30, 0xd5, 0x25, 0xdf, 0x32, 0x7c, 0x28, 0x25, 0xb3, 0x21, 0x80,
+	0xc1, 0x86, 0xe7, 0x3c, 0x16, 0x89, 0xd0, 0x02, 0x95, 0x67, 0x9b, 0xe1, 0x96, 0x16, 0xdc, 0x10,
+	0xe8, 0xad, 0x4c, 0x36, 0x3a, 0x04, 0x2b, 0x0a, 0xcd, 0x86, 0x2e, 0xb3, 0xa2, 0x90, 0x2e, 0xe0,
+	0xa5, 0xe4, 0x3b, 0xbd, 0xe6, 0xb5, 0x0d, 0xe3, 0xb2, 0xff, 0xe9, 0xed, 0xbc, 0x79, 0x8d, 0x79,
+	0x3b, 0x34, 0x1b, 0xca, 0xf6, 0x11, 0x16, 0xf0, 0xaa, 0x6c, 0x6f, 0x82, 0x6c, 0x03, 0xf2, 0xda,
+	0xa0, 0x06, 0xa4, 0xfa, 0x02, 0x57, 0x25, 0xf8, 0x00, 0xde, 0xb7, 0xa4, 0x50, 0x1a, 0xe5, 0x4f,
+	0x94, 0x4a, 0x64, 0xe9, 0x77, 0xd4, 0x0c, 0x7f, 0x17, 0xa8, 0x34, 0x1d, 0x81, 0xfd, 0x07, 0x65,
+	0x75, 0x9c, 0x73, 0x19, 0xfc, 0x23, 0x30, 0xa9, 0xda, 0x57, 0x35, 0xa9, 0x31, 0x31, 0x01, 0xb7,
+	0x32, 0x55, 0x47, 0x76, 0x4a, 0x21, 0x0a, 0x9f, 0x76, 0x6c, 0x3d, 0xdb, 0xf1, 0x02, 0x5e, 0x87,
+	0xd9, 0xdf, 0x74, 0x2f, 0xf9, 0x16, 0xa3, 0x74, 0x97, 0x35, 0xd6, 0x7b, 0xf0, 0x02, 0x53, 0x1e,
+	0x27, 0xb8, 0x35, 0xcb, 0x1d, 0x76, 0x79, 0xbc, 0x44, 0xb1, 0xea, 0x28, 0x5f, 0xc7, 0xc7, 0x7b,
+	0xbf, 0x73, 0x3c, 0xf9, 0xe4, 0xf6, 0xe4, 0x93, 0xbb, 0x93, 0x4f, 0xfe, 0x3f, 0xf8, 0x9d, 0xb8,
+	0x67, 0xfe, 0xb9, 0xcf, 0x8f, 0x03, 0x00, 0xc0, 0x44, 0x8e, 0x4d, 0xab, 0x02, 0x00, 0x00,
 }
 
 func (m *RaftAttributes) Marshal() (dAtA []byte, err error) {
@@ -382,6 +386,15 @@ func (m *Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintMembership(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ClientUrls) > 0 {
 		for iNdEx := len(m.ClientUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ClientUrls[iNdEx])
@@ -627,6 +640,12 @@ func (m *Attributes) Size() (n int) {
 			n += 1 + l + sovMembership(uint64(l))
 		}
 	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovMembership(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -912,6 +931,38 @@ func (m *Attributes) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMembership(dAtA[iNdEx:])
@@ -21,6 +21,7 @@ import (
 
 	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"google.golang.org/grpc"
 )
 
@@ -35,6 +36,9 @@ type (
 	AuthUserGrantRoleResponse        pb.AuthUserGrantRoleResponse
 	AuthUserGetResponse              pb.AuthUserGetResponse
 	AuthUserRevokeRoleResponse       pb.AuthUserRevokeRoleResponse
+	AuthUserUnlockResponse           pb.AuthUserUnlockResponse
+	AuthSessionListResponse          pb.AuthSessionListResponse
+	AuthSessionRevokeResponse        pb.AuthSessionRevokeResponse
 	AuthRoleAddResponse              pb.AuthRoleAddResponse
 	AuthRoleGrantPermissionResponse  pb.AuthRoleGrantPermissionResponse
 	AuthRoleGetResponse              pb.AuthRoleGetResponse
@@ -45,6 +49,8 @@ type (
 
 	PermissionType authpb.Permission_Type
 	Permission     authpb.Permission
+
+	OperationPermissionType authpb.OperationPermission_Type
 )
 
 const (
@@ -92,12 +98,32 @@ type Auth interface {
 	// UserRevokeRole revokes a role of a user.
 	UserRevokeRole(ctx context.Context, name string, role string) (*AuthUserRevokeRoleResponse, error)
 
+	// UserUnlock clears the failed authentication attempts and the lockout of a user.
+	UserUnlock(ctx context.Context, name string) (*AuthUserUnlockResponse, error)
+
+	// SessionList gets the active sessions of a user.
+	SessionList(ctx context.Context, name string) (*AuthSessionListResponse, error)
+
+	// SessionRevoke revokes a session of a user, its token is rejected afterwards.
+	SessionRevoke(ctx context.Context, name string, id uint64) (*AuthSessionRevokeResponse, error)
+
+	// SessionRevokeAll revokes all the sessions of a user.
+	SessionRevokeAll(ctx context.Context, name string) (*AuthSessionRevokeResponse, error)
+
 	// RoleAdd adds a new role to an etcd cluster.
 	RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error)
 
 	// RoleGrantPermission grants a permission to a role.
 	RoleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error)
 
+	// RoleDenyPermission denies a permission to a role. Denials take
+	// precedence over the permissions granted by any role of a user.
+	RoleDenyPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error)
+
+	// RoleGrantOperationPermission grants the permission of an operation
+	// outside of the key space to a role.
+	RoleGrantOperationPermission(ctx context.Context, name string, op OperationPermissionType) (*AuthRoleGrantPermissionResponse, error)
+
 	// RoleGet gets a detailed information of a role.
 	RoleGet(ctx context.Context, role string) (*AuthRoleGetResponse, error)
 
@@ -107,6 +133,10 @@ type Auth interface {
 	// RoleRevokePermission revokes a permission from a role.
 	RoleRevokePermission(ctx context.Context, role string, key, rangeEnd string) (*AuthRoleRevokePermissionResponse, error)
 
+	// RoleRevokeOperationPermission revokes the permission of an operation
+	// outside of the key space from a role.
+	RoleRevokeOperationPermission(ctx context.Context, role string, op OperationPermissionType) (*AuthRoleRevokePermissionResponse, error)
+
 	// RoleDelete deletes a role.
 	RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error)
 }
@@ -192,6 +222,29 @@ func (auth *authClient) UserRevokeRole(ctx context.Context, name string, role st
 	return (*AuthUserRevokeRoleResponse)(resp), ContextError(ctx, err)
 }
 
+func (auth *authClient) UserUnlock(ctx context.Context, name string) (*AuthUserUnlockResponse, error) {
+	resp, err := auth.remote.UserUnlock(ctx, &pb.AuthUserUnlockRequest{Name: name}, auth.callOpts...)
+	return (*AuthUserUnlockResponse)(resp), ContextError(ctx, err)
+}
+
+func (auth *authClient) SessionList(ctx context.Context, name string) (*AuthSessionListResponse, error) {
+	resp, err := auth.remote.SessionList(ctx, &pb.AuthSessionListRequest{Name: name}, auth.callOpts...)
+	return (*AuthSessionListResponse)(resp), ContextError(ctx, err)
+}
+
+func (auth *authClient) SessionRevoke(ctx context.Context, name string, id uint64) (*AuthSessionRevokeResponse, error) {
+	if id == 0 {
+		return nil, rpctypes.ErrSessionNotFound
+	}
+	resp, err := auth.remote.SessionRevoke(ctx, &pb.AuthSessionRevokeRequest{Name: name, ID: id}, auth.callOpts...)
+	return (*AuthSessionRevokeResponse)(resp), ContextError(ctx, err)
+}
+
+func (auth *authClient) SessionRevokeAll(ctx context.Context, name string) (*AuthSessionRevokeResponse, error) {
+	resp, err := auth.remote.SessionRevoke(ctx, &pb.AuthSessionRevokeRequest{Name: name}, auth.callOpts...)
+	return (*AuthSessionRevokeResponse)(resp), ContextError(ctx, err)
+}
+
 func (auth *authClient) RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error) {
 	resp, err := auth.remote.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: name}, auth.callOpts...)
 	return (*AuthRoleAddResponse)(resp), ContextError(ctx, err)
@@ -207,6 +260,23 @@ func (auth *authClient) RoleGrantPermission(ctx context.Context, name string, ke
 	return (*AuthRoleGrantPermissionResponse)(resp), ContextError(ctx, err)
 }
 
+func (auth *authClient) RoleDenyPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error) {
+	perm := &authpb.Permission{
+		Key:      []byte(key),
+		RangeEnd: []byte(rangeEnd),
+		PermType: authpb.Permission_Type(permType),
+		Deny:     true,
+	}
+	resp, err := auth.remote.RoleGrantPermission(ctx, &pb.AuthRoleGrantPermissionRequest{Name: name, Perm: perm}, auth.callOpts...)
+	return (*AuthRoleGrantPermissionResponse)(resp), ContextError(ctx, err)
+}
+
+func (auth *authClient) RoleGrantOperationPermission(ctx context.Context, name string, op OperationPermissionType) (*AuthRoleGrantPermissionResponse, error) {
+	perm := &authpb.OperationPermission{Operation: authpb.OperationPermission_Type(op)}
+	resp, err := auth.remote.RoleGrantPermission(ctx, &pb.AuthRoleGrantPermissionRequest{Name: name, OperationPerm: perm}, auth.callOpts...)
+	return (*AuthRoleGrantPermissionResponse)(resp), ContextError(ctx, err)
+}
+
 func (auth *authClient) RoleGet(ctx context.Context, role string) (*AuthRoleGetResponse, error) {
 	resp, err := auth.remote.RoleGet(ctx, &pb.AuthRoleGetRequest{Role: role}, auth.callOpts...)
 	return (*AuthRoleGetResponse)(resp), ContextError(ctx, err)
@@ -222,6 +292,12 @@ func (auth *authClient) RoleRevokePermission(ctx context.Context, role string, k
 	return (*AuthRoleRevokePermissionResponse)(resp), ContextError(ctx, err)
 }
 
+func (auth *authClient) RoleRevokeOperationPermission(ctx context.Context, role string, op OperationPermissionType) (*AuthRoleRevokePermissionResponse, error) {
+	perm := &authpb.OperationPermission{Operation: authpb.OperationPermission_Type(op)}
+	resp, err := auth.remote.RoleRevokePermission(ctx, &pb.AuthRoleRevokePermissionRequest{Role: role, OperationPerm: perm}, auth.callOpts...)
+	return (*AuthRoleRevokePermissionResponse)(resp), ContextError(ctx, err)
+}
+
 func (auth *authClient) RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error) {
 	resp, err := auth.remote.RoleDelete(ctx, &pb.AuthRoleDeleteRequest{Role: role}, auth.callOpts...)
 	return (*AuthRoleDeleteResponse)(resp), ContextError(ctx, err)
@@ -234,3 +310,19 @@ func StrToPermissionType(s string) (PermissionType, error) {
 	}
 	return PermissionType(-1), fmt.Errorf("invalid permission type: %s", s)
 }
+
+// StrToOperationPermissionType parses an operation such as "member-list"
+// or "snapshot".
+func StrToOperationPermissionType(s string) (OperationPermissionType, error) {
+	val, ok := authpb.OperationPermission_Type_value[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]
+	if ok {
+		return OperationPermissionType(val), nil
+	}
+	return OperationPermissionType(-1), fmt.Errorf("invalid operation: %s", s)
+}
+
+// OperationPermissionTypeToStr returns the name of an operation as accepted
+// by StrToOperationPermissionType.
+func OperationPermissionTypeToStr(op OperationPermissionType) string {
+	return strings.ToLower(strings.ReplaceAll(authpb.OperationPermission_Type(op).String(), "_", "-"))
+}
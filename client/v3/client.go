@@ -399,6 +399,9 @@ func newClient(cfg *Config) (*Client, error) {
 		client.Username = cfg.Username
 		client.Password = cfg.Password
 		client.authTokenBundle = credentials.NewBundle(credentials.Config{})
+	} else if cfg.Token != "" {
+		client.authTokenBundle = credentials.NewBundle(credentials.Config{})
+		client.authTokenBundle.UpdateAuthToken(cfg.Token)
 	}
 	if cfg.MaxCallSendMsgSize > 0 || cfg.MaxCallRecvMsgSize > 0 {
 		if cfg.MaxCallRecvMsgSize > 0 && cfg.MaxCallSendMsgSize > cfg.MaxCallRecvMsgSize {
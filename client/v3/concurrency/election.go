@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -27,12 +29,17 @@ import (
 var (
 	ErrElectionNotLeader = errors.New("election: not leader")
 	ErrElectionNoLeader  = errors.New("election: no leader")
+	// ErrElectionNoCandidate is returned when a leadership transfer names a
+	// key that is not a waiting campaigner on the election.
+	ErrElectionNoCandidate = errors.New("election: no such candidate")
 )
 
 type Election struct {
 	session *Session
 
-	keyPrefix string
+	keyPrefix      string
+	priorityPrefix string
+	priority       int64
 
 	leaderKey     string
 	leaderRev     int64
@@ -42,17 +49,36 @@ type Election struct {
 
 // NewElection returns a new election on a given key prefix.
 func NewElection(s *Session, pfx string) *Election {
-	return &Election{session: s, keyPrefix: pfx + "/"}
+	return &Election{session: s, keyPrefix: pfx + "/", priorityPrefix: pfx + "#priority/"}
 }
 
 // ResumeElection initializes an election with a known leader.
 func ResumeElection(s *Session, pfx string, leaderKey string, leaderRev int64) *Election {
 	return &Election{
-		keyPrefix:     pfx,
-		session:       s,
-		leaderKey:     leaderKey,
-		leaderRev:     leaderRev,
-		leaderSession: s,
+		keyPrefix:      pfx + "/",
+		priorityPrefix: pfx + "#priority/",
+		session:        s,
+		leaderKey:      leaderKey,
+		leaderRev:      leaderRev,
+		leaderSession:  s,
+	}
+}
+
+type campaignOptions struct {
+	priority int64
+}
+
+// CampaignOption configures Campaign.
+type CampaignOption func(*campaignOptions)
+
+// WithPriority configures the campaigner's priority. A campaigner that is
+// elected hands leadership on to the waiting campaigner with the highest
+// priority above its own, so preferred campaigners jump the queue ordered
+// by creation revision. The default priority is 0. Priorities are only
+// honored by campaigners using a client that supports them.
+func WithPriority(priority int64) CampaignOption {
+	return func(co *campaignOptions) {
+		co.priority = priority
 	}
 }
 
@@ -66,31 +92,43 @@ func ResumeElection(s *Session, pfx string, leaderKey string, leaderRev int64) *
 // returns a non-recoverable error (e.g. ErrCompacted).
 // Otherwise, until the context is not cancelled or timed-out, Campaign will
 // continue to be blocked until it becomes the leader.
-func (e *Election) Campaign(ctx context.Context, val string) error {
+func (e *Election) Campaign(ctx context.Context, val string, opts ...CampaignOption) error {
+	co := &campaignOptions{}
+	for _, opt := range opts {
+		opt(co)
+	}
 	s := e.session
 	client := e.session.Client()
 
 	k := fmt.Sprintf("%s%x", e.keyPrefix, s.Lease())
+	pop := e.opPriority(s.Lease(), co.priority)
 	txn := client.Txn(ctx).If(v3.Compare(v3.CreateRevision(k), "=", 0))
-	txn = txn.Then(v3.OpPut(k, val, v3.WithLease(s.Lease())))
-	txn = txn.Else(v3.OpGet(k))
+	txn = txn.Then(v3.OpPut(k, val, v3.WithLease(s.Lease())), pop)
+	txn = txn.Else(v3.OpGet(k), pop)
 	resp, err := txn.Commit()
 	if err != nil {
 		return err
 	}
 	e.leaderKey, e.leaderRev, e.leaderSession = k, resp.Header.Revision, s
+	e.priority = co.priority
 	if !resp.Succeeded {
 		kv := resp.Responses[0].GetResponseRange().Kvs[0]
-		e.leaderRev = kv.CreateRevision
-		if string(kv.Value) != val {
-			if err = e.Proclaim(ctx, val); err != nil {
-				e.Resign(ctx)
-				return err
+		if v3.LeaseID(kv.Lease) != s.Lease() {
+			// the key was handed to another campaigner by a leadership transfer
+			err = e.rejoin(ctx, val, resp.Header.Revision)
+		} else {
+			e.leaderRev = kv.CreateRevision
+			if string(kv.Value) != val {
+				err = e.Proclaim(ctx, val)
 			}
 		}
+		if err != nil {
+			e.Resign(ctx)
+			return err
+		}
 	}
 
-	_, err = waitDeletes(ctx, client, e.keyPrefix, e.leaderRev-1)
+	err = e.waitLeadership(ctx, val, resp.Header.Revision+1)
 	if err != nil {
 		// clean up in case of context cancel
 		select {
@@ -106,15 +144,186 @@ func (e *Election) Campaign(ctx context.Context, val string) error {
 	return nil
 }
 
+// rejoin resumes the campaign on the key held by the session lease, or
+// creates a new key if the lease holds none, when the key named after the
+// lease belongs to another campaigner.
+func (e *Election) rejoin(ctx context.Context, val string, rev int64) error {
+	lease := e.leaderSession.Lease()
+	kv, err := e.leaseKey(ctx)
+	if err != nil {
+		return err
+	}
+	if kv != nil {
+		e.leaderKey, e.leaderRev = string(kv.Key), kv.CreateRevision
+		if string(kv.Value) != val {
+			return e.Proclaim(ctx, val)
+		}
+		return nil
+	}
+	k := fmt.Sprintf("%s%x_%x", e.keyPrefix, lease, rev)
+	txn := e.session.Client().Txn(ctx).If(v3.Compare(v3.CreateRevision(k), "=", 0))
+	resp, err := txn.Then(v3.OpPut(k, val, v3.WithLease(lease))).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return ErrElectionNotLeader
+	}
+	e.leaderKey, e.leaderRev = k, resp.Header.Revision
+	return nil
+}
+
+// leaseKey returns the key on the election prefix held by the session lease,
+// or nil if there is none.
+func (e *Election) leaseKey(ctx context.Context) (*mvccpb.KeyValue, error) {
+	resp, err := e.session.Client().Get(ctx, e.keyPrefix, v3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range resp.Kvs {
+		if v3.LeaseID(kv.Lease) == e.leaderSession.Lease() {
+			return kv, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitLeadership waits until the campaign key is the oldest key on the
+// election prefix. The current leader may hand its key to this campaigner
+// while it waits, so the wait follows the session lease from key to key
+// starting at the given revision. Once elected, leadership is handed on to
+// any waiting campaigner with a higher priority.
+func (e *Election) waitLeadership(ctx context.Context, val string, rev int64) error {
+	client := e.session.Client()
+	for {
+		cctx, cancel := context.WithCancel(ctx)
+		key, lease := e.leaderKey, e.leaderSession.Lease()
+		donec := make(chan struct{})
+		moved := false
+		go func() {
+			defer close(donec)
+			if waitMove(cctx, client, key, lease, rev) == nil {
+				moved = true
+				cancel()
+			}
+		}()
+		_, err := waitDeletes(cctx, client, e.keyPrefix, e.leaderRev-1)
+		cancel()
+		<-donec
+
+		if moved {
+			kv, kerr := e.leaseKey(ctx)
+			if kerr != nil {
+				return kerr
+			}
+			if kv == nil {
+				return ErrElectionNotLeader
+			}
+			e.leaderKey, e.leaderRev, rev = string(kv.Key), kv.CreateRevision, kv.ModRevision+1
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if rev, err = e.yield(ctx, val); err != nil || rev == 0 {
+			return err
+		}
+	}
+}
+
+// yield hands leadership to the waiting campaigner with the highest priority
+// if it outranks the leader. The leader takes over that campaigner's key and
+// its place in the queue. It returns the revision of the handoff, or zero if
+// leadership was kept.
+func (e *Election) yield(ctx context.Context, val string) (int64, error) {
+	client := e.session.Client()
+	lease := e.leaderSession.Lease()
+	for {
+		cand, err := e.preferredCandidate(ctx)
+		if err != nil || cand == nil {
+			return 0, err
+		}
+		cmps := []v3.Cmp{
+			v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev),
+			v3.Compare(v3.LeaseValue(e.leaderKey), "=", lease),
+			v3.Compare(v3.ModRevision(string(cand.Key)), "=", cand.ModRevision),
+		}
+		resp, err := client.Txn(ctx).If(cmps...).Then(
+			v3.OpPut(e.leaderKey, string(cand.Value), v3.WithLease(v3.LeaseID(cand.Lease))),
+			v3.OpPut(string(cand.Key), val, v3.WithLease(lease)),
+		).Else(v3.OpGet(e.leaderKey)).Commit()
+		if err != nil {
+			return 0, err
+		}
+		if resp.Succeeded {
+			e.leaderKey, e.leaderRev = string(cand.Key), cand.CreateRevision
+			return resp.Header.Revision + 1, nil
+		}
+		kvs := resp.Responses[0].GetResponseRange().Kvs
+		if len(kvs) == 0 || kvs[0].CreateRevision != e.leaderRev || v3.LeaseID(kvs[0].Lease) != lease {
+			return 0, ErrElectionNotLeader
+		}
+		// the candidate changed underneath; pick again
+	}
+}
+
+// preferredCandidate returns the waiting campaigner with the highest priority
+// above the leader's, the oldest one winning ties, or nil if there is none.
+func (e *Election) preferredCandidate(ctx context.Context) (*mvccpb.KeyValue, error) {
+	resp, err := e.session.Client().Txn(ctx).Then(
+		v3.OpGet(e.keyPrefix, v3.WithPrefix()),
+		v3.OpGet(e.priorityPrefix, v3.WithPrefix()),
+	).Commit()
+	if err != nil {
+		return nil, err
+	}
+	priorities := make(map[int64]int64)
+	for _, kv := range resp.Responses[1].GetResponseRange().Kvs {
+		p, perr := strconv.ParseInt(string(kv.Value), 10, 64)
+		if perr != nil {
+			continue
+		}
+		priorities[kv.Lease] = p
+	}
+	var cand *mvccpb.KeyValue
+	for _, kv := range resp.Responses[0].GetResponseRange().Kvs {
+		if string(kv.Key) == e.leaderKey || kv.Lease == 0 {
+			continue
+		}
+		p := priorities[kv.Lease]
+		if p <= e.priority {
+			continue
+		}
+		if cand == nil || p > priorities[cand.Lease] ||
+			(p == priorities[cand.Lease] && kv.CreateRevision < cand.CreateRevision) {
+			cand = kv
+		}
+	}
+	return cand, nil
+}
+
+func (e *Election) opPriority(lease v3.LeaseID, priority int64) v3.Op {
+	k := fmt.Sprintf("%s%x", e.priorityPrefix, lease)
+	if priority == 0 {
+		return v3.OpDelete(k)
+	}
+	return v3.OpPut(k, strconv.FormatInt(priority, 10), v3.WithLease(lease))
+}
+
 // Proclaim lets the leader announce a new value without another election.
 func (e *Election) Proclaim(ctx context.Context, val string) error {
 	if e.leaderSession == nil {
 		return ErrElectionNotLeader
 	}
 	client := e.session.Client()
-	cmp := v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev)
-	txn := client.Txn(ctx).If(cmp)
-	txn = txn.Then(v3.OpPut(e.leaderKey, val, v3.WithLease(e.leaderSession.Lease())))
+	lease := e.leaderSession.Lease()
+	cmps := []v3.Cmp{
+		v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev),
+		v3.Compare(v3.LeaseValue(e.leaderKey), "=", lease),
+	}
+	txn := client.Txn(ctx).If(cmps...)
+	txn = txn.Then(v3.OpPut(e.leaderKey, val, v3.WithLease(lease)))
 	tresp, terr := txn.Commit()
 	if terr != nil {
 		return terr
@@ -134,8 +343,13 @@ func (e *Election) Resign(ctx context.Context) (err error) {
 		return nil
 	}
 	client := e.session.Client()
-	cmp := v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev)
-	resp, err := client.Txn(ctx).If(cmp).Then(v3.OpDelete(e.leaderKey)).Commit()
+	lease := e.leaderSession.Lease()
+	cmps := []v3.Cmp{
+		v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev),
+		v3.Compare(v3.LeaseValue(e.leaderKey), "=", lease),
+	}
+	txn := client.Txn(ctx).If(cmps...)
+	resp, err := txn.Then(v3.OpDelete(e.leaderKey), e.opPriority(lease, 0)).Commit()
 	if err == nil {
 		e.hdr = resp.Header
 	}
@@ -144,6 +358,56 @@ func (e *Election) Resign(ctx context.Context) (err error) {
 	return err
 }
 
+// Transfer hands leadership to the waiting campaigner holding the candidate
+// key. The candidate atomically takes over the leader key, so leadership
+// passes directly to it regardless of the other campaigners' positions in
+// the queue, and the current leader leaves the election. The candidate must
+// be campaigning with a client that supports leadership transfer.
+func (e *Election) Transfer(ctx context.Context, candidate string) error {
+	if e.leaderSession == nil {
+		return ErrElectionNotLeader
+	}
+	if candidate == e.leaderKey || !strings.HasPrefix(candidate, e.keyPrefix) {
+		return ErrElectionNoCandidate
+	}
+	client := e.session.Client()
+	gresp, err := client.Get(ctx, candidate)
+	if err != nil {
+		return err
+	}
+	if len(gresp.Kvs) == 0 || gresp.Kvs[0].Lease == 0 {
+		return ErrElectionNoCandidate
+	}
+	cand := gresp.Kvs[0]
+
+	lease := e.leaderSession.Lease()
+	cmps := []v3.Cmp{
+		v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev),
+		v3.Compare(v3.LeaseValue(e.leaderKey), "=", lease),
+		v3.Compare(v3.ModRevision(candidate), "=", cand.ModRevision),
+	}
+	resp, err := client.Txn(ctx).If(cmps...).Then(
+		v3.OpPut(e.leaderKey, string(cand.Value), v3.WithLease(v3.LeaseID(cand.Lease))),
+		v3.OpDelete(candidate),
+		e.opPriority(lease, 0),
+	).Else(v3.OpGet(e.leaderKey)).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		kvs := resp.Responses[0].GetResponseRange().Kvs
+		if len(kvs) == 0 || kvs[0].CreateRevision != e.leaderRev || v3.LeaseID(kvs[0].Lease) != lease {
+			e.leaderKey = ""
+			return ErrElectionNotLeader
+		}
+		return ErrElectionNoCandidate
+	}
+	e.hdr = resp.Header
+	e.leaderKey = ""
+	e.leaderSession = nil
+	return nil
+}
+
 // Leader returns the leader value for the current election.
 func (e *Election) Leader(ctx context.Context) (*v3.GetResponse, error) {
 	client := e.session.Client()
@@ -45,6 +45,52 @@ func waitDelete(ctx context.Context, client *v3.Client, key string, rev int64) e
 	return fmt.Errorf("lost watcher waiting for delete")
 }
 
+// waitMove waits until the key is deleted or put with a lease other than
+// the given one, watching from the given revision.
+func waitMove(ctx context.Context, client *v3.Client, key string, lease v3.LeaseID, rev int64) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wr v3.WatchResponse
+	wch := client.Watch(cctx, key, v3.WithRev(rev))
+	for wr = range wch {
+		for _, ev := range wr.Events {
+			if ev.Type == mvccpb.DELETE || v3.LeaseID(ev.Kv.Lease) != lease {
+				return nil
+			}
+		}
+	}
+	if err := wr.Err(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("lost watcher waiting for move")
+}
+
+// waitPrefixDelete waits until a key matching the prefix is deleted at or
+// after the given revision.
+func waitPrefixDelete(ctx context.Context, client *v3.Client, pfx string, rev int64) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wr v3.WatchResponse
+	wch := client.Watch(cctx, pfx, v3.WithPrefix(), v3.WithRev(rev), v3.WithFilterPut())
+	for wr = range wch {
+		if len(wr.Events) > 0 {
+			return nil
+		}
+	}
+	if err := wr.Err(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("lost watcher waiting for delete")
+}
+
 // waitDeletes efficiently waits until all keys matching the prefix and no greater
 // than the create revision.
 func waitDeletes(ctx context.Context, client *v3.Client, pfx string, maxCreateRev int64) (*pb.ResponseHeader, error) {
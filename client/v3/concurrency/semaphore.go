@@ -0,0 +1,204 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3 "go.etcd.io/etcd/client/v3"
+)
+
+var (
+	// ErrSemaphoreFull is returned by TryAcquire when the requested weight
+	// cannot be acquired without waiting.
+	ErrSemaphoreFull = errors.New("semaphore: not enough capacity")
+	// ErrSemaphoreBadWeight is returned when the requested weight is not
+	// positive or exceeds the capacity of the semaphore.
+	ErrSemaphoreBadWeight = errors.New("semaphore: weight must be positive and no greater than capacity")
+)
+
+// Semaphore is a counting semaphore limiting the total weight held by
+// concurrent sessions to a fixed capacity. Holders and waiters are ordered
+// by the creation revision of their keys, so the semaphore is granted in
+// first-come, first-served order; a waiter never overtakes an older one even
+// if its own weight would fit. Weight held by a session is released when the
+// session lease expires.
+//
+// All users of a semaphore must agree on its capacity.
+type Semaphore struct {
+	s *Session
+
+	pfx      string
+	capacity int64
+	myKey    string
+	myRev    int64
+	hdr      *pb.ResponseHeader
+}
+
+// NewSemaphore creates a semaphore on the given key prefix that admits
+// holders up to a total weight of capacity.
+func NewSemaphore(s *Session, pfx string, capacity int64) *Semaphore {
+	return &Semaphore{s: s, pfx: pfx + "/", capacity: capacity, myKey: "\x00", myRev: -1}
+}
+
+// TryAcquire acquires the given weight if it is available without waiting.
+// Otherwise it removes its entry from the semaphore and returns
+// ErrSemaphoreFull.
+func (sm *Semaphore) TryAcquire(ctx context.Context, weight int64) error {
+	resp, err := sm.enqueue(ctx, weight)
+	if err != nil {
+		return err
+	}
+	if sm.fits(resp.Responses[1].GetResponseRange().Kvs) {
+		sm.hdr = resp.Header
+		return nil
+	}
+	if err = sm.Release(ctx); err != nil {
+		return err
+	}
+	return ErrSemaphoreFull
+}
+
+// Acquire acquires the given weight, blocking until older holders and
+// waiters have released enough of the semaphore. If the context is canceled
+// while waiting, the semaphore tries to clean up its entry. Acquiring with a
+// session that already holds the semaphore is treated as a single
+// acquisition of the weight originally requested.
+func (sm *Semaphore) Acquire(ctx context.Context, weight int64) error {
+	resp, err := sm.enqueue(ctx, weight)
+	if err != nil {
+		return err
+	}
+	if sm.fits(resp.Responses[1].GetResponseRange().Kvs) {
+		sm.hdr = resp.Header
+		return nil
+	}
+	client := sm.s.Client()
+	hdr, werr := sm.waitCapacity(ctx, resp.Header.Revision)
+	if werr != nil {
+		// release semaphore entry if wait failed
+		sm.Release(client.Ctx())
+		return werr
+	}
+	sm.hdr = hdr
+	return nil
+}
+
+// Release releases the weight held by the session.
+func (sm *Semaphore) Release(ctx context.Context) error {
+	client := sm.s.Client()
+	if _, err := client.Delete(ctx, sm.myKey); err != nil {
+		return err
+	}
+	sm.myKey = "\x00"
+	sm.myRev = -1
+	return nil
+}
+
+// IsOwner returns a comparison that holds while the session still has its
+// entry in the semaphore.
+func (sm *Semaphore) IsOwner() v3.Cmp {
+	return v3.Compare(v3.CreateRevision(sm.myKey), "=", sm.myRev)
+}
+
+// Key returns the key holding the session's entry in the semaphore.
+func (sm *Semaphore) Key() string { return sm.myKey }
+
+// Header is the response header received from etcd on acquiring the semaphore.
+func (sm *Semaphore) Header() *pb.ResponseHeader { return sm.hdr }
+
+// enqueue puts the session's entry in the semaphore and fetches all older
+// entries.
+func (sm *Semaphore) enqueue(ctx context.Context, weight int64) (*v3.TxnResponse, error) {
+	if weight <= 0 || weight > sm.capacity {
+		return nil, ErrSemaphoreBadWeight
+	}
+	s := sm.s
+	client := sm.s.Client()
+
+	sm.myKey = fmt.Sprintf("%s%x", sm.pfx, s.Lease())
+	cmp := v3.Compare(v3.CreateRevision(sm.myKey), "=", 0)
+	put := v3.OpPut(sm.myKey, strconv.FormatInt(weight, 10), v3.WithLease(s.Lease()))
+	get := v3.OpGet(sm.myKey)
+	getOlder := v3.OpGet(sm.pfx, sm.olderOpts(0)...)
+	resp, err := client.Txn(ctx).If(cmp).Then(put, getOlder).Else(get, getOlder).Commit()
+	if err != nil {
+		return nil, err
+	}
+	sm.myRev = resp.Header.Revision
+	if !resp.Succeeded {
+		sm.myRev = resp.Responses[0].GetResponseRange().Kvs[0].CreateRevision
+	}
+	return resp, nil
+}
+
+// waitCapacity waits until the entries older than the session's entry leave
+// enough capacity for it, watching for releases after the given revision.
+func (sm *Semaphore) waitCapacity(ctx context.Context, rev int64) (*pb.ResponseHeader, error) {
+	client := sm.s.Client()
+	for {
+		if err := waitPrefixDelete(ctx, client, sm.pfx, rev+1); err != nil {
+			return nil, err
+		}
+		resp, err := client.Get(ctx, sm.pfx, sm.olderOpts(sm.myRev)...)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Kvs) == 0 || resp.Kvs[len(resp.Kvs)-1].CreateRevision != sm.myRev {
+			// is the session key lost?
+			return nil, ErrSessionExpired
+		}
+		if sm.fits(resp.Kvs) {
+			return resp.Header, nil
+		}
+		rev = resp.Header.Revision
+	}
+}
+
+// olderOpts returns options to fetch the entries created no later than the
+// given revision, oldest first. A zero revision fetches every entry.
+func (sm *Semaphore) olderOpts(rev int64) []v3.OpOption {
+	opts := []v3.OpOption{v3.WithPrefix(), v3.WithSort(v3.SortByCreateRevision, v3.SortAscend)}
+	if rev > 0 {
+		opts = append(opts, v3.WithMaxCreateRev(rev))
+	}
+	return opts
+}
+
+// fits reports whether the weights of the entries up to and including the
+// session's entry fit within the capacity.
+func (sm *Semaphore) fits(kvs []*mvccpb.KeyValue) bool {
+	var total int64
+	for _, kv := range kvs {
+		if kv.CreateRevision > sm.myRev {
+			break
+		}
+		w, err := strconv.ParseInt(string(kv.Value), 10, 64)
+		if err != nil || w <= 0 {
+			// count malformed entries as taking the whole semaphore
+			w = sm.capacity
+		}
+		total += w
+		if total > sm.capacity {
+			return false
+		}
+	}
+	return true
+}
@@ -64,6 +64,11 @@ type Config struct {
 	// Password is a password for authentication.
 	Password string `json:"password"`
 
+	// Token is an auth token issued outside of etcd, for example by an OIDC
+	// provider, sent with every request instead of authenticating with
+	// Username and Password.
+	Token string `json:"token"`
+
 	// RejectOldCluster when set will refuse to create a client against an outdated cluster.
 	RejectOldCluster bool `json:"reject-old-cluster"`
 
@@ -0,0 +1,324 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// ErrItemNotClaimed is returned when acknowledging an item whose claim has
+// lapsed, so it may have been redelivered to another consumer.
+var ErrItemNotClaimed = errors.New("queue item is no longer claimed by this consumer")
+
+// ReliableQueue implements a multi-reader, multi-writer distributed queue
+// with at-least-once delivery. Dequeued items stay in the queue, claimed by
+// the consumer's session lease, until they are acknowledged. An item is
+// redelivered if its consumer's lease expires, if its visibility timeout
+// passes, or if it is negatively acknowledged. Items delivered the maximum
+// number of times without acknowledgement are moved to the dead-letter
+// prefix instead of being delivered again.
+//
+// The queue uses the following keys under its prefix:
+//
+//	<prefix>/items/<id>   the item value and delivery count
+//	<prefix>/claims/<id>  the claim of an in-flight item, bound to a lease
+//	<prefix>/dead/<id>    dead-lettered items
+//
+// Visibility deadlines are taken from the consumers' clocks, which should
+// be roughly synchronized.
+type ReliableQueue struct {
+	s *concurrency.Session
+
+	itemPrefix  string
+	claimPrefix string
+	deadPrefix  string
+	visibility  time.Duration
+	maxAttempts int
+}
+
+// QueueItem is an item delivered by a ReliableQueue.
+type QueueItem struct {
+	// Key is the key holding the item.
+	Key string
+	// Value is the enqueued value.
+	Value string
+	// Attempts is the number of times the item has been delivered,
+	// including this delivery.
+	Attempts int
+
+	claimKey string
+	claimRev int64
+}
+
+// queueRecord is the stored form of an item.
+type queueRecord struct {
+	Value    string `json:"value"`
+	Attempts int    `json:"attempts"`
+}
+
+// NewReliableQueue creates a reliable queue on the given key prefix whose
+// items are claimed by the session's lease. A positive visibility timeout
+// redelivers items that are not acknowledged in time even if the consumer's
+// lease is still alive. A positive maxAttempts dead-letters items after that
+// many deliveries.
+func NewReliableQueue(s *concurrency.Session, keyPrefix string, visibility time.Duration, maxAttempts int) *ReliableQueue {
+	return &ReliableQueue{
+		s:           s,
+		itemPrefix:  keyPrefix + "/items/",
+		claimPrefix: keyPrefix + "/claims/",
+		deadPrefix:  keyPrefix + "/dead/",
+		visibility:  visibility,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Enqueue adds a value to the end of the queue.
+func (q *ReliableQueue) Enqueue(ctx context.Context, val string) error {
+	rec, err := json.Marshal(queueRecord{Value: val})
+	if err != nil {
+		return err
+	}
+	client := q.s.Client()
+	for {
+		key := fmt.Sprintf("%s%v", q.itemPrefix, time.Now().UnixNano())
+		cmp := v3.Compare(v3.Version(key), "=", 0)
+		resp, err := client.Txn(ctx).If(cmp).Then(v3.OpPut(key, string(rec))).Commit()
+		if err != nil {
+			return err
+		}
+		if resp.Succeeded {
+			return nil
+		}
+	}
+}
+
+// Peek returns the item that would be delivered next without claiming it,
+// or nil if no item is ready. Items that would be dead-lettered instead of
+// delivered are skipped.
+func (q *ReliableQueue) Peek(ctx context.Context) (*QueueItem, error) {
+	items, _, _, err := q.ready(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ri := range items {
+		if !q.exhausted(ri.item) {
+			return ri.item, nil
+		}
+	}
+	return nil, nil
+}
+
+// exhausted reports whether the item has been delivered the maximum number
+// of times, so it is dead-lettered instead of delivered again.
+func (q *ReliableQueue) exhausted(it *QueueItem) bool {
+	return q.maxAttempts > 0 && it.Attempts >= q.maxAttempts
+}
+
+// Dequeue claims the oldest ready item in the queue. If no item is ready,
+// Dequeue blocks until one is enqueued or an in-flight item is released.
+func (q *ReliableQueue) Dequeue(ctx context.Context) (*QueueItem, error) {
+	client := q.s.Client()
+	for {
+		items, rev, next, err := q.ready(ctx)
+		if err != nil {
+			return nil, err
+		}
+	claim:
+		for _, ri := range items {
+			it := ri.item
+			cmps := []v3.Cmp{
+				v3.Compare(v3.ModRevision(it.Key), "=", ri.itemRev),
+				v3.Compare(v3.ModRevision(it.claimKey), "=", ri.claimRev),
+			}
+			if q.exhausted(it) {
+				deadKey := q.deadPrefix + strings.TrimPrefix(it.Key, q.itemPrefix)
+				resp, err := client.Txn(ctx).If(cmps...).Then(
+					v3.OpPut(deadKey, ri.rec),
+					v3.OpDelete(it.Key),
+					v3.OpDelete(it.claimKey),
+				).Commit()
+				if err != nil {
+					return nil, err
+				}
+				if !resp.Succeeded {
+					// the item changed since it was read, e.g. it was
+					// released and is ready again; look again so that the
+					// oldest ready item is delivered first
+					break claim
+				}
+				continue
+			}
+			it.Attempts++
+			rec, err := json.Marshal(queueRecord{Value: it.Value, Attempts: it.Attempts})
+			if err != nil {
+				return nil, err
+			}
+			var deadline int64
+			if q.visibility > 0 {
+				deadline = time.Now().Add(q.visibility).UnixNano()
+			}
+			resp, err := client.Txn(ctx).If(cmps...).Then(
+				v3.OpPut(it.Key, string(rec)),
+				v3.OpPut(it.claimKey, strconv.FormatInt(deadline, 10), v3.WithLease(q.s.Lease())),
+			).Commit()
+			if err != nil {
+				return nil, err
+			}
+			if resp.Succeeded {
+				it.claimRev = resp.Header.Revision
+				return it, nil
+			}
+		}
+		if len(items) != 0 {
+			// lost the race for every ready item; look again
+			continue
+		}
+		if err = q.wait(ctx, rev+1, next); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Ack acknowledges a dequeued item, removing it from the queue.
+func (q *ReliableQueue) Ack(ctx context.Context, it *QueueItem) error {
+	return q.release(ctx, it, v3.OpDelete(it.Key), v3.OpDelete(it.claimKey))
+}
+
+// Nack releases the claim on a dequeued item so it is redelivered
+// immediately.
+func (q *ReliableQueue) Nack(ctx context.Context, it *QueueItem) error {
+	return q.release(ctx, it, v3.OpDelete(it.claimKey))
+}
+
+func (q *ReliableQueue) release(ctx context.Context, it *QueueItem, ops ...v3.Op) error {
+	cmp := v3.Compare(v3.ModRevision(it.claimKey), "=", it.claimRev)
+	resp, err := q.s.Client().Txn(ctx).If(cmp).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return ErrItemNotClaimed
+	}
+	return nil
+}
+
+// readyItem is an item that may be claimed, along with the revisions
+// guarding the claim.
+type readyItem struct {
+	item     *QueueItem
+	rec      string
+	itemRev  int64
+	claimRev int64
+}
+
+// ready returns the items that may be claimed, oldest first, the revision
+// they were read at, and the earliest time an in-flight item's visibility
+// deadline passes, if any.
+func (q *ReliableQueue) ready(ctx context.Context) ([]readyItem, int64, time.Time, error) {
+	resp, err := q.s.Client().Txn(ctx).Then(
+		v3.OpGet(q.itemPrefix, v3.WithPrefix(), v3.WithSort(v3.SortByCreateRevision, v3.SortAscend)),
+		v3.OpGet(q.claimPrefix, v3.WithPrefix()),
+	).Commit()
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	claims := make(map[string]*mvccpb.KeyValue)
+	for _, kv := range resp.Responses[1].GetResponseRange().Kvs {
+		claims[strings.TrimPrefix(string(kv.Key), q.claimPrefix)] = kv
+	}
+
+	now := time.Now()
+	var items []readyItem
+	var next time.Time
+	for _, kv := range resp.Responses[0].GetResponseRange().Kvs {
+		id := strings.TrimPrefix(string(kv.Key), q.itemPrefix)
+		ri := readyItem{rec: string(kv.Value), itemRev: kv.ModRevision}
+		if c, ok := claims[id]; ok {
+			deadline, perr := strconv.ParseInt(string(c.Value), 10, 64)
+			if perr != nil || deadline == 0 {
+				// claimed until the consumer's lease expires
+				continue
+			}
+			if d := time.Unix(0, deadline); d.After(now) {
+				if next.IsZero() || d.Before(next) {
+					next = d
+				}
+				continue
+			}
+			ri.claimRev = c.ModRevision
+		}
+		var rec queueRecord
+		if err = json.Unmarshal(kv.Value, &rec); err != nil {
+			return nil, 0, time.Time{}, err
+		}
+		ri.item = &QueueItem{
+			Key:      string(kv.Key),
+			Value:    rec.Value,
+			Attempts: rec.Attempts,
+			claimKey: q.claimPrefix + id,
+		}
+		items = append(items, ri)
+	}
+	return items, resp.Header.Revision, next, nil
+}
+
+// wait blocks until an item is enqueued or a claim is released after the
+// given revision, or until the given deadline passes.
+func (q *ReliableQueue) wait(ctx context.Context, rev int64, deadline time.Time) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var timeoutc <-chan time.Time
+	if !deadline.IsZero() {
+		t := time.NewTimer(time.Until(deadline))
+		defer t.Stop()
+		timeoutc = t.C
+	}
+	pfx := strings.TrimSuffix(q.itemPrefix, "items/")
+	wch := q.s.Client().Watch(cctx, pfx, v3.WithPrefix(), v3.WithRev(rev))
+	for {
+		select {
+		case wr, ok := <-wch:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				return ErrNoWatcher
+			}
+			if err := wr.Err(); err != nil {
+				return err
+			}
+			for _, ev := range wr.Events {
+				key := string(ev.Kv.Key)
+				if (ev.IsCreate() && strings.HasPrefix(key, q.itemPrefix)) ||
+					(ev.Type == mvccpb.DELETE && strings.HasPrefix(key, q.claimPrefix)) {
+					return nil
+				}
+			}
+		case <-timeoutc:
+			return nil
+		}
+	}
+}
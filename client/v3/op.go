@@ -68,6 +68,8 @@ type Op struct {
 	// filters for watchers
 	filterPut    bool
 	filterDelete bool
+	// filterUnpermitted drops the events on the keys the user cannot read
+	filterUnpermitted bool
 
 	// for put
 	val     []byte
@@ -497,6 +499,14 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithFilterUnpermitted discards the events on the keys the user is not
+// permitted to read, instead of failing the watch when the user cannot read
+// the whole range. The permissions are evaluated again by the server when
+// the auth revision changes.
+func WithFilterUnpermitted() OpOption {
+	return func(op *Op) { op.filterUnpermitted = true }
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
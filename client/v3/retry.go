@@ -285,6 +285,18 @@ func (rac *retryAuthClient) UserRevokeRole(ctx context.Context, in *pb.AuthUserR
 	return rac.ac.UserRevokeRole(ctx, in, opts...)
 }
 
+func (rac *retryAuthClient) UserUnlock(ctx context.Context, in *pb.AuthUserUnlockRequest, opts ...grpc.CallOption) (resp *pb.AuthUserUnlockResponse, err error) {
+	return rac.ac.UserUnlock(ctx, in, opts...)
+}
+
+func (rac *retryAuthClient) SessionList(ctx context.Context, in *pb.AuthSessionListRequest, opts ...grpc.CallOption) (resp *pb.AuthSessionListResponse, err error) {
+	return rac.ac.SessionList(ctx, in, opts...)
+}
+
+func (rac *retryAuthClient) SessionRevoke(ctx context.Context, in *pb.AuthSessionRevokeRequest, opts ...grpc.CallOption) (resp *pb.AuthSessionRevokeResponse, err error) {
+	return rac.ac.SessionRevoke(ctx, in, opts...)
+}
+
 func (rac *retryAuthClient) RoleAdd(ctx context.Context, in *pb.AuthRoleAddRequest, opts ...grpc.CallOption) (resp *pb.AuthRoleAddResponse, err error) {
 	return rac.ac.RoleAdd(ctx, in, opts...)
 }
@@ -169,6 +169,11 @@ func (c *Client) refreshToken(ctx context.Context) error {
 		// clients just need to retry the operations (e.g. Put, Delete etc).
 		return nil
 	}
+	if c.Username == "" || c.Password == "" {
+		// an externally issued token given by Config.Token cannot be
+		// refreshed by the client, so it is kept.
+		return nil
+	}
 	// clear auth token before refreshing it.
 	c.authTokenBundle.UpdateAuthToken("")
 	return c.getToken(ctx)
@@ -214,6 +214,8 @@ type watchRequest struct {
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// filterUnpermitted drops the events on the keys the user cannot read
+	filterUnpermitted bool
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -306,16 +308,17 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	}
 
 	wr := &watchRequest{
-		ctx:            ctx,
-		createdNotify:  ow.createdNotify,
-		key:            string(ow.key),
-		end:            string(ow.end),
-		rev:            ow.rev,
-		progressNotify: ow.progressNotify,
-		fragment:       ow.fragment,
-		filters:        filters,
-		prevKV:         ow.prevKV,
-		retc:           make(chan chan WatchResponse, 1),
+		ctx:               ctx,
+		createdNotify:     ow.createdNotify,
+		key:               string(ow.key),
+		end:               string(ow.end),
+		rev:               ow.rev,
+		progressNotify:    ow.progressNotify,
+		fragment:          ow.fragment,
+		filters:           filters,
+		prevKV:            ow.prevKV,
+		filterUnpermitted: ow.filterUnpermitted,
+		retc:              make(chan chan WatchResponse, 1),
 	}
 
 	ok := false
@@ -1015,13 +1018,14 @@ func (w *watchGrpcStream) openWatchClient() (ws pb.Watch_WatchClient, err error)
 // toPB converts an internal watch request structure to its protobuf WatchRequest structure.
 func (wr *watchRequest) toPB() *pb.WatchRequest {
 	req := &pb.WatchCreateRequest{
-		StartRevision:  wr.rev,
-		Key:            []byte(wr.key),
-		RangeEnd:       []byte(wr.end),
-		ProgressNotify: wr.progressNotify,
-		Filters:        wr.filters,
-		PrevKv:         wr.prevKV,
-		Fragment:       wr.fragment,
+		StartRevision:     wr.rev,
+		Key:               []byte(wr.key),
+		RangeEnd:          []byte(wr.end),
+		ProgressNotify:    wr.progressNotify,
+		Filters:           wr.filters,
+		PrevKv:            wr.prevKV,
+		Fragment:          wr.fragment,
+		FilterUnpermitted: wr.filterUnpermitted,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
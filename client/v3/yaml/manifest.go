@@ -0,0 +1,184 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"go.etcd.io/etcd/client/v3"
+)
+
+// Manifest is the desired state of the keys under a managed prefix, and of
+// the users and roles of a cluster.
+type Manifest struct {
+	// ManagedPrefix is the prefix of the keys managed by the manifest.
+	// Required if Keys is not empty.
+	ManagedPrefix string         `json:"managed-prefix"`
+	Keys          []ManifestKey  `json:"keys"`
+	Roles         []ManifestRole `json:"roles"`
+	Users         []ManifestUser `json:"users"`
+}
+
+type ManifestKey struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type ManifestRole struct {
+	Name        string               `json:"name"`
+	Permissions []ManifestPermission `json:"permissions"`
+}
+
+// ManifestPermission grants access to a key, to a range of keys if
+// RangeEnd is set, to the keys with the prefix Key if Prefix is set, or to
+// the keys from Key on if FromKey is set. Deny denies the access instead
+// of granting it.
+type ManifestPermission struct {
+	// Type is one of read, write or readwrite.
+	Type     string `json:"type"`
+	Key      string `json:"key"`
+	RangeEnd string `json:"range-end"`
+	Prefix   bool   `json:"prefix"`
+	FromKey  bool   `json:"from-key"`
+	Deny     bool   `json:"deny"`
+}
+
+// ManifestUser is a user granted Roles. Password and NoPassword are only
+// used to create the user; the password of an existing user is kept.
+type ManifestUser struct {
+	Name       string   `json:"name"`
+	Password   string   `json:"password"`
+	NoPassword bool     `json:"no-password"`
+	Roles      []string `json:"roles"`
+}
+
+// NewManifest reads a manifest from a yaml file.
+func NewManifest(fpath string) (*Manifest, error) {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return ParseManifest(b)
+}
+
+// ParseManifest parses and validates a yaml manifest.
+func ParseManifest(b []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.UnmarshalStrict(b, m); err != nil {
+		return nil, err
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate checks that the manifest declares each key, role and user once,
+// and keys only under the managed prefix.
+func (m *Manifest) Validate() error {
+	if len(m.Keys) > 0 && m.ManagedPrefix == "" {
+		return fmt.Errorf("managed-prefix is required to declare keys")
+	}
+	keys := make(map[string]struct{})
+	for _, k := range m.Keys {
+		if !strings.HasPrefix(k.Key, m.ManagedPrefix) {
+			return fmt.Errorf("key %q is not under the managed prefix %q", k.Key, m.ManagedPrefix)
+		}
+		if _, ok := keys[k.Key]; ok {
+			return fmt.Errorf("key %q is declared more than once", k.Key)
+		}
+		keys[k.Key] = struct{}{}
+	}
+
+	roles := make(map[string]struct{})
+	for _, r := range m.Roles {
+		if r.Name == "" {
+			return fmt.Errorf("role name is empty")
+		}
+		if _, ok := roles[r.Name]; ok {
+			return fmt.Errorf("role %q is declared more than once", r.Name)
+		}
+		roles[r.Name] = struct{}{}
+		ranges := make(map[[2]string]struct{})
+		for _, p := range r.Permissions {
+			if _, err := p.PermissionType(); err != nil {
+				return fmt.Errorf("role %q: %v", r.Name, err)
+			}
+			if p.Key == "" {
+				return fmt.Errorf("role %q: permission key is empty", r.Name)
+			}
+			n := 0
+			for _, set := range []bool{p.RangeEnd != "", p.Prefix, p.FromKey} {
+				if set {
+					n++
+				}
+			}
+			if n > 1 {
+				return fmt.Errorf("role %q: only one of range-end, prefix and from-key can be set for key %q", r.Name, p.Key)
+			}
+			key, end := p.Range()
+			if _, ok := ranges[[2]string{key, end}]; ok {
+				return fmt.Errorf("role %q: permission for key %q is declared more than once", r.Name, p.Key)
+			}
+			ranges[[2]string{key, end}] = struct{}{}
+		}
+	}
+
+	users := make(map[string]struct{})
+	for _, u := range m.Users {
+		if u.Name == "" {
+			return fmt.Errorf("user name is empty")
+		}
+		if _, ok := users[u.Name]; ok {
+			return fmt.Errorf("user %q is declared more than once", u.Name)
+		}
+		users[u.Name] = struct{}{}
+		if u.Password != "" && u.NoPassword {
+			return fmt.Errorf("user %q: password and no-password cannot both be set", u.Name)
+		}
+	}
+	return nil
+}
+
+// PermissionType returns the type of the permission.
+func (p ManifestPermission) PermissionType() (clientv3.PermissionType, error) {
+	switch strings.ToLower(p.Type) {
+	case "read":
+		return clientv3.PermissionType(clientv3.PermRead), nil
+	case "write":
+		return clientv3.PermissionType(clientv3.PermWrite), nil
+	case "readwrite":
+		return clientv3.PermissionType(clientv3.PermReadWrite), nil
+	default:
+		return 0, fmt.Errorf("invalid permission type %q", p.Type)
+	}
+}
+
+// Range returns the range of keys of the permission, as stored by the
+// cluster.
+func (p ManifestPermission) Range() (key, end string) {
+	switch {
+	case p.Prefix:
+		return p.Key, clientv3.GetPrefixRangeEnd(p.Key)
+	case p.FromKey:
+		return p.Key, "\x00"
+	default:
+		return p.Key, p.RangeEnd
+	}
+}
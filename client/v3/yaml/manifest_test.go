@@ -0,0 +1,87 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest([]byte(`
+managed-prefix: /config/
+keys:
+- key: /config/a
+  value: "1"
+roles:
+- name: reader
+  permissions:
+  - type: read
+    key: /config/
+    prefix: true
+  - type: readwrite
+    key: /config/a
+users:
+- name: alice
+  password: secret
+  roles: [reader]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &Manifest{
+		ManagedPrefix: "/config/",
+		Keys:          []ManifestKey{{Key: "/config/a", Value: "1"}},
+		Roles: []ManifestRole{{Name: "reader", Permissions: []ManifestPermission{
+			{Type: "read", Key: "/config/", Prefix: true},
+			{Type: "readwrite", Key: "/config/a"},
+		}}},
+		Users: []ManifestUser{{Name: "alice", Password: "secret", Roles: []string{"reader"}}},
+	}
+	if !reflect.DeepEqual(m, w) {
+		t.Fatalf("manifest = %+v, want %+v", m, w)
+	}
+	if key, end := m.Roles[0].Permissions[0].Range(); key != "/config/" || end != "/config0" {
+		t.Errorf("range = [%q, %q), want [\"/config/\", \"/config0\")", key, end)
+	}
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	tests := []string{
+		// unknown field
+		"managed-prefix: /a/\nkey: []",
+		// keys without managed prefix
+		"keys:\n- key: a\n",
+		// key outside the managed prefix
+		"managed-prefix: /a/\nkeys:\n- key: /b/1\n",
+		// duplicated key
+		"managed-prefix: /a/\nkeys:\n- key: /a/1\n- key: /a/1\n",
+		// duplicated role
+		"roles:\n- name: r\n- name: r\n",
+		// invalid permission type
+		"roles:\n- name: r\n  permissions:\n  - type: all\n    key: a\n",
+		// prefix and range end
+		"roles:\n- name: r\n  permissions:\n  - type: read\n    key: a\n    prefix: true\n    range-end: b\n",
+		// duplicated permission range
+		"roles:\n- name: r\n  permissions:\n  - type: read\n    key: a\n  - type: write\n    key: a\n",
+		// password and no password
+		"users:\n- name: u\n  password: p\n  no-password: true\n",
+	}
+	for i, tt := range tests {
+		if _, err := ParseManifest([]byte(tt)); err == nil {
+			t.Errorf("#%d: expected error for %q", i, tt)
+		}
+	}
+}
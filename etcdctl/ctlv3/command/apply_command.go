@@ -0,0 +1,449 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/yaml"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/spf13/cobra"
+)
+
+// applyTxnOps is the number of key changes applied per transaction, the
+// default limit of operations per transaction of the server.
+const applyTxnOps = 128
+
+var (
+	applyFilename string
+	applyDryRun   bool
+	applyPrune    bool
+)
+
+// NewApplyCommand returns the cobra command for "apply".
+func NewApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f <filename> [options]",
+		Short: "Applies the desired state of keys, users and roles of a manifest",
+		Long: `Makes the keys under the managed prefix, and the users and roles of the cluster,
+match a yaml manifest, read from the standard input if the filename is "-".
+The changes are printed, and applied unless --dry-run is given. Keys under the
+managed prefix that are not in the manifest are deleted. Users and roles that
+are not in the manifest are deleted only with --prune; root is never deleted.
+
+Key changes are applied in transactions that fail if the keys changed since
+the changes were computed. Users and roles are changed one request at a time.
+`,
+		Run: applyCommandFunc,
+	}
+	cmd.Flags().StringVarP(&applyFilename, "filename", "f", "", "Manifest to apply")
+	cmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Print the changes without applying them")
+	cmd.Flags().BoolVar(&applyPrune, "prune", false, "Delete the users and roles that are not in the manifest")
+	cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+func applyCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("apply takes no arguments"))
+	}
+	var (
+		m   *yaml.Manifest
+		err error
+	)
+	if applyFilename == "-" {
+		var b []byte
+		if b, err = ioutil.ReadAll(os.Stdin); err == nil {
+			m, err = yaml.ParseManifest(b)
+		}
+	} else {
+		m, err = yaml.NewManifest(applyFilename)
+	}
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	c := mustClientFromCmd(cmd)
+	ctx := context.Background()
+	plan, err := planManifest(ctx, c, m, applyPrune)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	if !applyDryRun {
+		n, err := plan.apply(ctx, c)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("applied %d of %d changes: %v", n, len(plan.Changes), err))
+		}
+		plan.Applied = true
+	}
+	display.Apply(*plan)
+}
+
+const (
+	changeAdd    = "add"
+	changeUpdate = "update"
+	changeDelete = "delete"
+)
+
+// applyChange is a change of a key, role, role permission, user or role of
+// a user.
+type applyChange struct {
+	Action string `json:"action"`
+	Kind   string `json:"kind"`
+	// Name is the key, role or user changed.
+	Name string `json:"name"`
+	// Detail is the changed permission of a role, or role of a user.
+	Detail string `json:"detail,omitempty"`
+
+	// op and cmp apply a key change.
+	op  clientv3.Op
+	cmp clientv3.Cmp
+	// do applies any other change.
+	do func(ctx context.Context, c *clientv3.Client) error
+}
+
+type applyPlan struct {
+	Changes []applyChange `json:"changes"`
+	Applied bool          `json:"applied"`
+}
+
+// planManifest computes the changes making the cluster match m.
+func planManifest(ctx context.Context, c *clientv3.Client, m *yaml.Manifest, prune bool) (*applyPlan, error) {
+	p := &applyPlan{}
+	rresp, err := c.RoleList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles := make(map[string]bool)
+	for _, r := range rresp.Roles {
+		roles[r] = true
+	}
+	for _, r := range m.Roles {
+		if err = p.planRole(ctx, c, r, roles[r.Name]); err != nil {
+			return nil, err
+		}
+	}
+
+	uresp, err := c.UserList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]bool)
+	for _, u := range uresp.Users {
+		users[u] = true
+	}
+	for _, u := range m.Users {
+		if err = p.planUser(ctx, c, u, users[u.Name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if prune {
+		declared := make(map[string]bool)
+		for _, u := range m.Users {
+			declared[u.Name] = true
+		}
+		for _, name := range uresp.Users {
+			if name != "root" && !declared[name] {
+				name := name
+				p.add(changeDelete, "user", name, "", func(ctx context.Context, c *clientv3.Client) error {
+					_, err := c.UserDelete(ctx, name)
+					return err
+				})
+			}
+		}
+		declared = make(map[string]bool)
+		for _, r := range m.Roles {
+			declared[r.Name] = true
+		}
+		for _, name := range rresp.Roles {
+			if name != "root" && !declared[name] {
+				name := name
+				p.add(changeDelete, "role", name, "", func(ctx context.Context, c *clientv3.Client) error {
+					_, err := c.RoleDelete(ctx, name)
+					return err
+				})
+			}
+		}
+	}
+
+	if m.ManagedPrefix != "" {
+		if err = p.planKeys(ctx, c, m); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *applyPlan) add(action, kind, name, detail string, do func(ctx context.Context, c *clientv3.Client) error) {
+	p.Changes = append(p.Changes, applyChange{Action: action, Kind: kind, Name: name, Detail: detail, do: do})
+}
+
+func (p *applyPlan) planRole(ctx context.Context, c *clientv3.Client, r yaml.ManifestRole, exists bool) error {
+	name := r.Name
+	// current maps the ranges of the permissions of the role to their type
+	current := make(map[[2]string]applyPermission)
+	if exists {
+		resp, err := c.RoleGet(ctx, name)
+		if err != nil {
+			return err
+		}
+		for _, perm := range resp.Perm {
+			current[[2]string{string(perm.Key), string(perm.RangeEnd)}] = applyPermission{clientv3.PermissionType(perm.PermType), perm.Deny}
+		}
+	} else {
+		p.add(changeAdd, "role", name, "", func(ctx context.Context, c *clientv3.Client) error {
+			_, err := c.RoleAdd(ctx, name)
+			return err
+		})
+	}
+
+	declared := make(map[[2]string]bool)
+	for _, perm := range r.Permissions {
+		key, end := perm.Range()
+		typ, err := perm.PermissionType()
+		if err != nil {
+			return err
+		}
+		rng := [2]string{key, end}
+		declared[rng] = true
+		want := applyPermission{typ, perm.Deny}
+		action := changeAdd
+		if cur, ok := current[rng]; ok {
+			if cur == want {
+				continue
+			}
+			action = changeUpdate
+		}
+		// granting a permission on the range of another one replaces its type
+		p.add(action, "permission", name, want.String(key, end), func(ctx context.Context, c *clientv3.Client) error {
+			grant := c.RoleGrantPermission
+			if want.deny {
+				grant = c.RoleDenyPermission
+			}
+			_, err := grant(ctx, name, key, end, want.typ)
+			return err
+		})
+	}
+
+	// sorted for a stable output
+	var revoked [][2]string
+	for rng := range current {
+		if !declared[rng] {
+			revoked = append(revoked, rng)
+		}
+	}
+	sort.Slice(revoked, func(i, j int) bool {
+		return revoked[i][0] < revoked[j][0] || (revoked[i][0] == revoked[j][0] && revoked[i][1] < revoked[j][1])
+	})
+	for _, rng := range revoked {
+		key, end := rng[0], rng[1]
+		p.add(changeDelete, "permission", name, current[rng].String(key, end), func(ctx context.Context, c *clientv3.Client) error {
+			_, err := c.RoleRevokePermission(ctx, name, key, end)
+			return err
+		})
+	}
+	return nil
+}
+
+// applyPermission is the type of a permission on a range of keys, and
+// whether it is denied instead of granted.
+type applyPermission struct {
+	typ  clientv3.PermissionType
+	deny bool
+}
+
+func (ap applyPermission) String(key, end string) string {
+	typ := authpb.Permission_Type(ap.typ).String()
+	if ap.deny {
+		typ = "deny " + typ
+	}
+	switch {
+	case end == "":
+		return fmt.Sprintf("%s %q", typ, key)
+	case end == "\x00":
+		return fmt.Sprintf("%s [%q, <open ended>", typ, key)
+	default:
+		return fmt.Sprintf("%s [%q, %q)", typ, key, end)
+	}
+}
+
+func (p *applyPlan) planUser(ctx context.Context, c *clientv3.Client, u yaml.ManifestUser, exists bool) error {
+	name := u.Name
+	current := make(map[string]bool)
+	if exists {
+		resp, err := c.UserGet(ctx, name)
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.Roles {
+			current[r] = true
+		}
+	} else {
+		if u.Password == "" && !u.NoPassword {
+			return fmt.Errorf("user %q does not exist and has neither password nor no-password", name)
+		}
+		password, opts := u.Password, &clientv3.UserAddOptions{NoPassword: u.NoPassword}
+		p.add(changeAdd, "user", name, "", func(ctx context.Context, c *clientv3.Client) error {
+			_, err := c.UserAddWithOptions(ctx, name, password, opts)
+			return err
+		})
+	}
+
+	declared := make(map[string]bool)
+	for _, r := range u.Roles {
+		declared[r] = true
+		if current[r] {
+			continue
+		}
+		r := r
+		p.add(changeAdd, "user-role", name, r, func(ctx context.Context, c *clientv3.Client) error {
+			_, err := c.UserGrantRole(ctx, name, r)
+			return err
+		})
+	}
+	var revoked []string
+	for r := range current {
+		if !declared[r] {
+			revoked = append(revoked, r)
+		}
+	}
+	sort.Strings(revoked)
+	for _, r := range revoked {
+		r := r
+		p.add(changeDelete, "user-role", name, r, func(ctx context.Context, c *clientv3.Client) error {
+			_, err := c.UserRevokeRole(ctx, name, r)
+			return err
+		})
+	}
+	return nil
+}
+
+// planKeys adds the changes of the keys under the managed prefix, each
+// guarded by the revisions the key was read at.
+func (p *applyPlan) planKeys(ctx context.Context, c *clientv3.Client, m *yaml.Manifest) error {
+	declared := make(map[string]string)
+	for _, k := range m.Keys {
+		declared[k.Key] = k.Value
+	}
+	s := newClusterKVStream(c, m.ManagedPrefix, 0)
+	for {
+		kv, err := s.next(ctx)
+		if err != nil {
+			return err
+		}
+		if kv == nil {
+			break
+		}
+		key := string(kv.Key)
+		val, ok := declared[key]
+		delete(declared, key)
+		switch {
+		case !ok:
+			p.Changes = append(p.Changes, applyChange{
+				Action: changeDelete, Kind: "key", Name: key,
+				op:  clientv3.OpDelete(key),
+				cmp: clientv3.Compare(clientv3.ModRevision(key), "=", kv.ModRevision),
+			})
+		case val != string(kv.Value):
+			p.Changes = append(p.Changes, applyChange{
+				Action: changeUpdate, Kind: "key", Name: key,
+				op:  clientv3.OpPut(key, val),
+				cmp: clientv3.Compare(clientv3.ModRevision(key), "=", kv.ModRevision),
+			})
+		}
+	}
+	for _, k := range m.Keys {
+		if _, ok := declared[k.Key]; !ok {
+			continue
+		}
+		p.Changes = append(p.Changes, applyChange{
+			Action: changeAdd, Kind: "key", Name: k.Key,
+			op:  clientv3.OpPut(k.Key, k.Value),
+			cmp: clientv3.Compare(clientv3.CreateRevision(k.Key), "=", 0),
+		})
+	}
+	return nil
+}
+
+// apply applies the changes in order, and returns the number of changes
+// applied.
+func (p *applyPlan) apply(ctx context.Context, c *clientv3.Client) (int, error) {
+	var (
+		n    int
+		cmps []clientv3.Cmp
+		ops  []clientv3.Op
+	)
+	flush := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		resp, err := c.Txn(ctx).If(cmps...).Then(ops...).Commit()
+		if err != nil {
+			return err
+		}
+		if !resp.Succeeded {
+			return fmt.Errorf("keys under the managed prefix changed since the changes were computed")
+		}
+		n += len(ops)
+		cmps, ops = cmps[:0], ops[:0]
+		return nil
+	}
+	for _, ch := range p.Changes {
+		if ch.do != nil {
+			if err := flush(); err != nil {
+				return n, err
+			}
+			if err := ch.do(ctx, c); err != nil {
+				return n, fmt.Errorf("failed to %s %s %q: %v", ch.Action, ch.Kind, ch.Name, err)
+			}
+			n++
+			continue
+		}
+		cmps, ops = append(cmps, ch.cmp), append(ops, ch.op)
+		if len(ops) == applyTxnOps {
+			if err := flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, flush()
+}
+
+func printApplyPlan(p applyPlan) {
+	signs := map[string]string{changeAdd: "+", changeUpdate: "~", changeDelete: "-"}
+	for _, ch := range p.Changes {
+		if ch.Detail != "" {
+			fmt.Printf("%s %s %s %s\n", signs[ch.Action], ch.Kind, ch.Name, ch.Detail)
+		} else {
+			fmt.Printf("%s %s %s\n", signs[ch.Action], ch.Kind, ch.Name)
+		}
+	}
+	switch {
+	case len(p.Changes) == 0:
+		fmt.Println("No changes")
+	case p.Applied:
+		fmt.Printf("Applied %d changes\n", len(p.Changes))
+	default:
+		fmt.Printf("Dry run, %d changes not applied\n", len(p.Changes))
+	}
+}
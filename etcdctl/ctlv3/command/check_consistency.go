@@ -0,0 +1,285 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/spf13/cobra"
+)
+
+// checkConsistencyPageSize is the number of keys listed per request.
+const checkConsistencyPageSize = 1000
+
+var (
+	checkConsistencyRev    int64
+	checkConsistencyPrefix string
+)
+
+// NewCheckConsistencyCommand returns the cobra command for "check consistency".
+func NewCheckConsistencyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consistency [options]",
+		Short: "Locates the keys whose revisions diverge between members",
+		Long: `Compares the hashes of the key revisions of all members at a revision,
+bisecting the ranges of keys whose hashes differ, down to the keys whose
+revisions diverge. A range is reported when its hashes differ but none of its
+current keys does, i.e. only the revisions of deleted keys diverge.
+`,
+		Run: checkConsistencyCommandFunc,
+	}
+	cmd.Flags().Int64Var(&checkConsistencyRev, "rev", 0, "Revision to compare, the lowest current revision of the members if 0")
+	cmd.Flags().StringVar(&checkConsistencyPrefix, "prefix", "", "Key prefix to compare")
+	return cmd
+}
+
+// consistencyMember is the state of a range or key on a member.
+type consistencyMember struct {
+	Endpoint string `json:"endpoint"`
+	Hash     uint32 `json:"hash"`
+	// Kv is the key at the revision, nil for ranges and deleted keys.
+	Kv *mvccpb.KeyValue `json:"kv,omitempty"`
+}
+
+// consistencyMismatch is a key, or a range of keys if RangeEnd is set,
+// whose revisions differ between members.
+type consistencyMismatch struct {
+	Key      string              `json:"key"`
+	RangeEnd string              `json:"range_end,omitempty"`
+	Members  []consistencyMember `json:"members"`
+}
+
+type consistencyCheck struct {
+	Revision   int64                 `json:"revision"`
+	Mismatches []consistencyMismatch `json:"mismatches"`
+}
+
+func checkConsistencyCommandFunc(cmd *cobra.Command, args []string) {
+	c := mustClientFromCmd(cmd)
+	ctx, cancel := commandCtx(cmd)
+	mresp, err := c.MemberList(ctx)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	sec := secureCfgFromCmd(cmd)
+	dt := dialTimeoutFromCmd(cmd)
+	ka := keepAliveTimeFromCmd(cmd)
+	kat := keepAliveTimeoutFromCmd(cmd)
+	auth := authCfgFromCmd(cmd)
+	cc := &consistencyChecker{}
+	for _, m := range mresp.Members {
+		if len(m.ClientURLs) == 0 {
+			// not started yet
+			continue
+		}
+		cfg, err := newClientCfg(m.ClientURLs[:1], dt, ka, kat, sec, auth)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+		}
+		mc, err := v3.New(*cfg)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		defer mc.Close()
+		cc.endpoints = append(cc.endpoints, m.ClientURLs[0])
+		cc.clients = append(cc.clients, mc)
+	}
+
+	key, end := "\x00", "\x00"
+	if checkConsistencyPrefix != "" {
+		key, end = checkConsistencyPrefix, v3.GetPrefixRangeEnd(checkConsistencyPrefix)
+	}
+	res, err := cc.check(context.Background(), checkConsistencyRev, key, end)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.CheckConsistency(res)
+	if len(res.Mismatches) > 0 {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("found %d mismatches at revision %d", len(res.Mismatches), res.Revision))
+	}
+}
+
+// consistencyChecker bisects the ranges of keys whose hashes differ between
+// members.
+type consistencyChecker struct {
+	endpoints []string
+	// clients are the clients of each member.
+	clients []*v3.Client
+
+	rev        int64
+	mismatches []consistencyMismatch
+}
+
+func (cc *consistencyChecker) check(ctx context.Context, rev int64, key, end string) (consistencyCheck, error) {
+	if rev == 0 {
+		for i, c := range cc.clients {
+			resp, err := c.Status(ctx, cc.endpoints[i])
+			if err != nil {
+				return consistencyCheck{}, fmt.Errorf("failed to get the status of %s: %v", cc.endpoints[i], err)
+			}
+			if rev == 0 || resp.Header.Revision < rev {
+				rev = resp.Header.Revision
+			}
+		}
+	}
+	cc.rev = rev
+
+	keys, err := cc.keys(ctx, key, end)
+	if err != nil {
+		return consistencyCheck{}, err
+	}
+	if err = cc.bisect(ctx, key, end, keys); err != nil {
+		return consistencyCheck{}, err
+	}
+	return consistencyCheck{Revision: rev, Mismatches: cc.mismatches}, nil
+}
+
+// keys returns the keys in [key, end) of any member, sorted.
+func (cc *consistencyChecker) keys(ctx context.Context, key, end string) ([]string, error) {
+	set := make(map[string]struct{})
+	for i, c := range cc.clients {
+		from := key
+		for {
+			resp, err := c.Get(ctx, from, v3.WithRange(end), v3.WithRev(cc.rev), v3.WithSerializable(), v3.WithKeysOnly(), v3.WithLimit(checkConsistencyPageSize))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the keys of %s: %v", cc.endpoints[i], err)
+			}
+			for _, kv := range resp.Kvs {
+				set[string(kv.Key)] = struct{}{}
+			}
+			if !resp.More || len(resp.Kvs) == 0 {
+				break
+			}
+			from = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+		}
+	}
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// bisect records the mismatches of [key, end), which contains keys.
+func (cc *consistencyChecker) bisect(ctx context.Context, key, end string, keys []string) error {
+	ms, same, err := cc.hash(ctx, key, end)
+	if err != nil || same {
+		return err
+	}
+	switch len(keys) {
+	case 0:
+		cc.mismatches = append(cc.mismatches, consistencyMismatch{Key: key, RangeEnd: end, Members: ms})
+		return nil
+	case 1:
+		kms, same, err := cc.hash(ctx, keys[0], "")
+		if err != nil {
+			return err
+		}
+		if same {
+			cc.mismatches = append(cc.mismatches, consistencyMismatch{Key: key, RangeEnd: end, Members: ms})
+			return nil
+		}
+		for i, c := range cc.clients {
+			resp, err := c.Get(ctx, keys[0], v3.WithRev(cc.rev), v3.WithSerializable())
+			if err != nil {
+				return fmt.Errorf("failed to get key %q from %s: %v", keys[0], cc.endpoints[i], err)
+			}
+			if len(resp.Kvs) > 0 {
+				kms[i].Kv = resp.Kvs[0]
+			}
+		}
+		cc.mismatches = append(cc.mismatches, consistencyMismatch{Key: keys[0], Members: kms})
+		return nil
+	}
+
+	mid := len(keys) / 2
+	if err = cc.bisect(ctx, key, keys[mid], keys[:mid]); err != nil {
+		return err
+	}
+	return cc.bisect(ctx, keys[mid], end, keys[mid:])
+}
+
+// hash returns the hash of [key, end) on each member, and whether all
+// hashes are the same.
+func (cc *consistencyChecker) hash(ctx context.Context, key, end string) ([]consistencyMember, bool, error) {
+	ms := make([]consistencyMember, len(cc.clients))
+	errs := make([]error, len(cc.clients))
+	var wg sync.WaitGroup
+	for i, c := range cc.clients {
+		wg.Add(1)
+		go func(i int, c *v3.Client) {
+			defer wg.Done()
+			// each client only has the endpoint of its member
+			resp, err := pb.NewMaintenanceClient(c.ActiveConnection()).HashKV(ctx, &pb.HashKVRequest{Revision: cc.rev, Key: []byte(key), RangeEnd: []byte(end)})
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to hash the keys of %s: %v", cc.endpoints[i], err)
+				return
+			}
+			ms[i] = consistencyMember{Endpoint: cc.endpoints[i], Hash: resp.Hash}
+		}(i, c)
+	}
+	wg.Wait()
+
+	same := true
+	for i := range ms {
+		if errs[i] != nil {
+			return nil, false, errs[i]
+		}
+		same = same && ms[i].Hash == ms[0].Hash
+	}
+	return ms, same, nil
+}
+
+func printConsistencyCheck(r consistencyCheck) {
+	if len(r.Mismatches) == 0 {
+		fmt.Printf("revision %d: consistent\n", r.Revision)
+		return
+	}
+	fmt.Printf("revision %d: %d mismatches\n", r.Revision, len(r.Mismatches))
+	for _, m := range r.Mismatches {
+		if m.RangeEnd != "" {
+			fmt.Printf("range [%q, %q)\n", m.Key, m.RangeEnd)
+		} else {
+			fmt.Printf("key %q\n", m.Key)
+		}
+		for _, mm := range m.Members {
+			fields := []string{fmt.Sprintf("hash %d", mm.Hash)}
+			switch {
+			case m.RangeEnd != "":
+			case mm.Kv == nil:
+				fields = append(fields, "deleted")
+			default:
+				fields = append(fields,
+					fmt.Sprintf("create_revision %d", mm.Kv.CreateRevision),
+					fmt.Sprintf("mod_revision %d", mm.Kv.ModRevision),
+					fmt.Sprintf("version %d", mm.Kv.Version),
+				)
+			}
+			fmt.Printf("\t%s: %s\n", mm.Endpoint, strings.Join(fields, ", "))
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/spf13/cobra"
+)
+
+// diffPageSize is the number of keys fetched from a cluster per request.
+const diffPageSize = 1000
+
+var (
+	diffPrefix          string
+	diffRev             int64
+	diffDestRev         int64
+	diffSnapshot        string
+	diffIgnoreLease     bool
+	diffIgnoreRevisions bool
+
+	diffInsecureTr bool
+	diffCert       string
+	diffKey        string
+	diffCACert     string
+	diffUser       string
+	diffPassword   string
+)
+
+// NewDiffCommand returns the cobra command for "diff".
+func NewDiffCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "diff [options] (<destination> | --snapshot <filename>)",
+		Short: "Compares the keyspace with a destination etcd cluster or a snapshot file",
+		Long: `Compares the keys of the cluster, the source, with the keys of a destination
+cluster or snapshot file, and prints the keys only in the source as removed (-),
+the keys only in the destination as added (+) and the keys in both that differ
+as changed (~), with the fields that differ.
+`,
+		Run: diffCommandFunc,
+	}
+
+	c.Flags().StringVar(&diffPrefix, "prefix", "", "Key prefix to compare")
+	c.Flags().Int64Var(&diffRev, "rev", 0, "Revision of the source keyspace, latest if 0")
+	c.Flags().Int64Var(&diffDestRev, "dest-rev", 0, "Revision of the destination keyspace, latest if 0")
+	c.Flags().StringVar(&diffSnapshot, "snapshot", "", "Compare with the keyspace of a snapshot file instead of a destination cluster")
+	c.Flags().BoolVar(&diffIgnoreLease, "ignore-lease", false, "Ignore the leases of keys")
+	c.Flags().BoolVar(&diffIgnoreRevisions, "ignore-revisions", false, "Ignore the create and mod revisions and versions of keys")
+	c.Flags().StringVar(&diffCert, "dest-cert", "", "Identify secure client using this TLS certificate file for the destination cluster")
+	c.Flags().StringVar(&diffKey, "dest-key", "", "Identify secure client using this TLS key file")
+	c.Flags().StringVar(&diffCACert, "dest-cacert", "", "Verify certificates of TLS enabled secure servers using this CA bundle")
+	c.Flags().BoolVar(&diffInsecureTr, "dest-insecure-transport", true, "Disable transport security for client connections")
+	c.Flags().StringVar(&diffUser, "dest-user", "", "Destination username[:password] for authentication (prompt if password is not supplied)")
+	c.Flags().StringVar(&diffPassword, "dest-password", "", "Destination password for authentication (if this option is used, --user option shouldn't include password)")
+
+	return c
+}
+
+// keyDiff is a key that differs between the source and the destination.
+type keyDiff struct {
+	// Type is one of "added", "removed" or "changed".
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	// Fields are the fields of a changed key that differ.
+	Fields      []string         `json:"fields,omitempty"`
+	Source      *mvccpb.KeyValue `json:"source,omitempty"`
+	Destination *mvccpb.KeyValue `json:"destination,omitempty"`
+}
+
+func diffCommandFunc(cmd *cobra.Command, args []string) {
+	if (len(args) == 1) == (diffSnapshot != "") {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("diff takes either one destination argument or --snapshot"))
+	}
+
+	c := mustClientFromCmd(cmd)
+	src := newClusterKVStream(c, diffPrefix, diffRev)
+
+	var dst kvStream
+	if diffSnapshot != "" {
+		it, err := snapshot.OpenKeyValues(diffSnapshot, []byte(diffPrefix), diffDestRev)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		defer it.Close()
+		dst = &snapshotKVStream{it: it}
+	} else {
+		cc := &clientConfig{
+			endpoints:        []string{args[0]},
+			dialTimeout:      dialTimeoutFromCmd(cmd),
+			keepAliveTime:    keepAliveTimeFromCmd(cmd),
+			keepAliveTimeout: keepAliveTimeoutFromCmd(cmd),
+			scfg: &secureCfg{
+				cert:              diffCert,
+				key:               diffKey,
+				cacert:            diffCACert,
+				insecureTransport: diffInsecureTr,
+			},
+			acfg: authDestCfg(diffUser, diffPassword),
+		}
+		dst = newClusterKVStream(cc.mustClient(), diffPrefix, diffDestRev)
+	}
+
+	if err := diffKeyspaces(context.Background(), src, dst, display.KeyDiff); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+}
+
+// diffKeyspaces merges the key ordered streams, calling f for every key
+// that differs.
+func diffKeyspaces(ctx context.Context, src, dst kvStream, f func(keyDiff)) error {
+	skv, err := src.next(ctx)
+	if err != nil {
+		return err
+	}
+	dkv, err := dst.next(ctx)
+	if err != nil {
+		return err
+	}
+	for skv != nil || dkv != nil {
+		cmp := 0
+		switch {
+		case skv == nil:
+			cmp = 1
+		case dkv == nil:
+			cmp = -1
+		default:
+			cmp = bytes.Compare(skv.Key, dkv.Key)
+		}
+
+		switch {
+		case cmp < 0:
+			f(keyDiff{Type: "removed", Key: string(skv.Key), Source: skv})
+			skv, err = src.next(ctx)
+		case cmp > 0:
+			f(keyDiff{Type: "added", Key: string(dkv.Key), Destination: dkv})
+			dkv, err = dst.next(ctx)
+		default:
+			if fields := diffKeyValue(skv, dkv); len(fields) > 0 {
+				f(keyDiff{Type: "changed", Key: string(skv.Key), Fields: fields, Source: skv, Destination: dkv})
+			}
+			if skv, err = src.next(ctx); err == nil {
+				dkv, err = dst.next(ctx)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffKeyValue returns the fields that differ between two key-values of
+// the same key.
+func diffKeyValue(a, b *mvccpb.KeyValue) (fields []string) {
+	if !bytes.Equal(a.Value, b.Value) {
+		fields = append(fields, "value")
+	}
+	if !diffIgnoreLease && a.Lease != b.Lease {
+		fields = append(fields, "lease")
+	}
+	if !diffIgnoreRevisions {
+		if a.CreateRevision != b.CreateRevision {
+			fields = append(fields, "create_revision")
+		}
+		if a.ModRevision != b.ModRevision {
+			fields = append(fields, "mod_revision")
+		}
+		if a.Version != b.Version {
+			fields = append(fields, "version")
+		}
+	}
+	return fields
+}
+
+// kvStream iterates over key-values in key order.
+type kvStream interface {
+	// next returns the next key-value, or nil at the end of the stream.
+	next(ctx context.Context) (*mvccpb.KeyValue, error)
+}
+
+// clusterKVStream pages through the keys of a cluster at a single revision.
+type clusterKVStream struct {
+	c        *clientv3.Client
+	key, end string
+	rev      int64
+	kvs      []*mvccpb.KeyValue
+	more     bool
+}
+
+func newClusterKVStream(c *clientv3.Client, prefix string, rev int64) *clusterKVStream {
+	key, end := prefix, clientv3.GetPrefixRangeEnd(prefix)
+	if prefix == "" {
+		key, end = "\x00", "\x00"
+	}
+	return &clusterKVStream{c: c, key: key, end: end, rev: rev, more: true}
+}
+
+func (s *clusterKVStream) next(ctx context.Context) (*mvccpb.KeyValue, error) {
+	if len(s.kvs) == 0 && s.more {
+		resp, err := s.c.Get(ctx, s.key, clientv3.WithRange(s.end), clientv3.WithRev(s.rev), clientv3.WithLimit(diffPageSize))
+		if err != nil {
+			return nil, err
+		}
+		// later pages are read at the revision of the first one
+		if s.rev == 0 {
+			s.rev = resp.Header.Revision
+		}
+		s.kvs, s.more = resp.Kvs, resp.More
+		if len(s.kvs) > 0 {
+			s.key = string(s.kvs[len(s.kvs)-1].Key) + "\x00"
+		}
+	}
+	if len(s.kvs) == 0 {
+		return nil, nil
+	}
+	kv := s.kvs[0]
+	s.kvs = s.kvs[1:]
+	return kv, nil
+}
+
+// snapshotKVStream iterates over the keys of a snapshot file.
+type snapshotKVStream struct {
+	it *snapshot.KeyValueIterator
+}
+
+func (s *snapshotKVStream) next(context.Context) (*mvccpb.KeyValue, error) {
+	return s.it.Next()
+}
+
+func printKeyDiff(isHex bool, d keyDiff) {
+	k := d.Key
+	if isHex {
+		k = addHexPrefix(fmt.Sprintf("%x", d.Key))
+	}
+	switch d.Type {
+	case "added":
+		fmt.Println("+", k)
+	case "removed":
+		fmt.Println("-", k)
+	default:
+		fmt.Println("~", k, d.Fields)
+	}
+}
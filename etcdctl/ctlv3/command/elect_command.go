@@ -29,7 +29,8 @@ import (
 )
 
 var (
-	electListen bool
+	electListen   bool
+	electPriority int64
 )
 
 // NewElectCommand returns the cobra command for "elect".
@@ -40,6 +41,7 @@ func NewElectCommand() *cobra.Command {
 		Run:   electCommandFunc,
 	}
 	cmd.Flags().BoolVarP(&electListen, "listen", "l", false, "observation mode")
+	cmd.Flags().Int64Var(&electPriority, "priority", 0, "campaign priority; higher priority campaigners are elected ahead of older ones")
 	return cmd
 }
 
@@ -117,7 +119,7 @@ func campaign(c *clientv3.Client, election string, prop string) error {
 		close(donec)
 	}()
 
-	if err = e.Campaign(ctx, prop); err != nil {
+	if err = e.Campaign(ctx, prop, concurrency.WithPriority(electPriority)); err != nil {
 		return err
 	}
 
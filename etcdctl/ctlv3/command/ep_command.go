@@ -45,6 +45,7 @@ func NewEndpointCommand() *cobra.Command {
 	ec.AddCommand(newEpHealthCommand())
 	ec.AddCommand(newEpStatusCommand())
 	ec.AddCommand(newEpHashKVCommand())
+	ec.AddCommand(newEpTopCommand())
 
 	return ec
 }
@@ -87,6 +88,20 @@ type epHealth struct {
 	Error  string `json:"error,omitempty"`
 }
 
+// endpointAlarms returns the alarms that affect the health of the member
+// serving the alarm list. Latency alarms only degrade the member that
+// raised them.
+func endpointAlarms(resp *v3.AlarmResponse) []*etcdserverpb.AlarmMember {
+	var alarms []*etcdserverpb.AlarmMember
+	for _, v := range resp.Alarms {
+		if v.Alarm == etcdserverpb.AlarmType_LATENCY && v.MemberID != resp.Header.MemberId {
+			continue
+		}
+		alarms = append(alarms, v)
+	}
+	return alarms
+}
+
 // epHealthCommandFunc executes the "endpoint-health" command.
 func epHealthCommandFunc(cmd *cobra.Command, args []string) {
 	lg, err := logutil.CreateDefaultZapLogger(zap.InfoLevel)
@@ -137,16 +152,22 @@ func epHealthCommandFunc(cmd *cobra.Command, args []string) {
 			}
 
 			if eh.Health {
+				var alarms []*etcdserverpb.AlarmMember
 				resp, err := cli.AlarmList(ctx)
-				if err == nil && len(resp.Alarms) > 0 {
+				if err == nil {
+					alarms = endpointAlarms(resp)
+				}
+				if len(alarms) > 0 {
 					eh.Health = false
 					eh.Error = "Active Alarm(s): "
-					for _, v := range resp.Alarms {
+					for _, v := range alarms {
 						switch v.Alarm {
 						case etcdserverpb.AlarmType_NOSPACE:
 							eh.Error = eh.Error + "NOSPACE "
 						case etcdserverpb.AlarmType_CORRUPT:
 							eh.Error = eh.Error + "CORRUPT "
+						case etcdserverpb.AlarmType_LATENCY:
+							eh.Error = eh.Error + "LATENCY "
 						default:
 							eh.Error = eh.Error + "UNKNOWN "
 						}
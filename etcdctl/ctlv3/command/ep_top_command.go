@@ -0,0 +1,319 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	v3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/olekukonko/tablewriter"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+)
+
+var (
+	epTopInterval   time.Duration
+	epTopIterations int
+)
+
+// topMetrics are the member metrics shown by endpoint top.
+var topMetrics = []string{
+	"etcd_server_leader_changes_seen_total",
+	"etcd_debugging_mvcc_watch_stream_total",
+	"etcd_debugging_mvcc_watcher_total",
+	"etcd_debugging_lease_active",
+}
+
+func newEpTopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Shows a refreshing view of the status of the endpoints",
+		Long: `Refreshes the status of each endpoint at an interval: the commit index lag behind
+the most advanced endpoint, the applied index lag behind the commit index, the
+proposals committed per second, the db size and in use size and their growth
+since the previous refresh, the leader changes since the start, and the
+watches and leases read from the /metrics endpoint of the member. Active
+alarms are listed under the endpoints.
+`,
+		Run: epTopCommandFunc,
+	}
+	cmd.Flags().DurationVar(&epTopInterval, "interval", 2*time.Second, "Interval between refreshes")
+	cmd.Flags().IntVar(&epTopIterations, "iterations", 0, "Number of refreshes before exiting, unlimited if 0")
+	return cmd
+}
+
+// topMember is the state of an endpoint at a refresh.
+type topMember struct {
+	Endpoint string `json:"endpoint"`
+	ID       uint64 `json:"id"`
+	IsLeader bool   `json:"is_leader"`
+	Term     uint64 `json:"raft_term"`
+
+	CommitIndex  uint64 `json:"commit_index"`
+	AppliedIndex uint64 `json:"applied_index"`
+	// CommitLag is the number of entries committed by the most advanced
+	// endpoint but not by this one.
+	CommitLag uint64 `json:"commit_lag"`
+	// AppliedLag is the number of committed entries not yet applied.
+	AppliedLag      uint64  `json:"applied_lag"`
+	ProposalsPerSec float64 `json:"proposals_per_sec"`
+
+	DbSize      int64 `json:"db_size"`
+	DbSizeInUse int64 `json:"db_size_in_use"`
+	// DbSizeGrowth and DbSizeInUseGrowth are the growths since the
+	// previous refresh.
+	DbSizeGrowth      int64 `json:"db_size_growth"`
+	DbSizeInUseGrowth int64 `json:"db_size_in_use_growth"`
+
+	// The metrics are -1 if they could not be read.
+	LeaderChanges int64 `json:"leader_changes"`
+	WatchStreams  int64 `json:"watch_streams"`
+	Watchers      int64 `json:"watchers"`
+	Leases        int64 `json:"leases"`
+
+	Error string `json:"error,omitempty"`
+}
+
+type topFrame struct {
+	Time    time.Time                   `json:"time"`
+	Members []topMember                 `json:"members"`
+	Alarms  []*etcdserverpb.AlarmMember `json:"alarms,omitempty"`
+}
+
+// topSample is what is read from an endpoint at a refresh.
+type topSample struct {
+	time    time.Time
+	status  *v3.StatusResponse
+	metrics map[string]float64
+	err     error
+}
+
+func epTopCommandFunc(cmd *cobra.Command, args []string) {
+	if epTopInterval <= 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("--interval must be positive"))
+	}
+	c := mustClientFromCmd(cmd)
+	eps := endpointsFromCluster(cmd)
+	cfg, err := newClientCfg(eps, dialTimeoutFromCmd(cmd), keepAliveTimeFromCmd(cmd), keepAliveTimeoutFromCmd(cmd), secureCfgFromCmd(cmd), nil)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	t := &epTop{
+		c:     c,
+		eps:   eps,
+		hc:    &http.Client{Transport: &http.Transport{TLSClientConfig: cfg.TLS}},
+		https: cfg.TLS != nil,
+		first: make(map[string]topSample),
+		prev:  make(map[string]topSample),
+	}
+
+	// the screen is cleared between refreshes of the views on a terminal
+	outputType, _ := cmd.Flags().GetString("write-out")
+	st, _ := os.Stdout.Stat()
+	clearScreen := st != nil && st.Mode()&os.ModeCharDevice != 0 && (outputType == "simple" || outputType == "table")
+	ticker := time.NewTicker(epTopInterval)
+	defer ticker.Stop()
+	for i := 0; epTopIterations == 0 || i < epTopIterations; i++ {
+		if i > 0 {
+			<-ticker.C
+		}
+		ctx, cancel := commandCtx(cmd)
+		f := t.refresh(ctx)
+		cancel()
+		if clearScreen {
+			// move to the top left corner and clear the screen
+			fmt.Print("\033[H\033[2J")
+		}
+		display.EndpointTop(f)
+	}
+}
+
+// epTop derives the state of the endpoints from successive samples.
+type epTop struct {
+	c     *v3.Client
+	eps   []string
+	hc    *http.Client
+	https bool
+
+	// first and prev are the first and previous successful samples of
+	// each endpoint; first is the first one with metrics if any.
+	first, prev map[string]topSample
+}
+
+func (t *epTop) refresh(ctx context.Context) topFrame {
+	samples := make([]topSample, len(t.eps))
+	var wg sync.WaitGroup
+	for i, ep := range t.eps {
+		wg.Add(1)
+		go func(i int, ep string) {
+			defer wg.Done()
+			samples[i] = t.sample(ctx, ep)
+		}(i, ep)
+	}
+	f := topFrame{Time: time.Now()}
+	if resp, err := t.c.AlarmList(ctx); err == nil {
+		f.Alarms = resp.Alarms
+	}
+	wg.Wait()
+
+	var maxCommit uint64
+	for _, s := range samples {
+		if s.err == nil && s.status.RaftIndex > maxCommit {
+			maxCommit = s.status.RaftIndex
+		}
+	}
+	for i, ep := range t.eps {
+		s := samples[i]
+		if s.err != nil {
+			f.Members = append(f.Members, topMember{Endpoint: ep, Error: s.err.Error()})
+			continue
+		}
+		first, ok := t.first[ep]
+		if !ok || first.metrics == nil {
+			first = s
+			t.first[ep] = s
+		}
+		prev, ok := t.prev[ep]
+		if !ok {
+			prev = s
+		}
+		t.prev[ep] = s
+
+		st := s.status
+		m := topMember{
+			Endpoint:          ep,
+			ID:                st.Header.MemberId,
+			IsLeader:          st.Leader == st.Header.MemberId,
+			Term:              st.RaftTerm,
+			CommitIndex:       st.RaftIndex,
+			AppliedIndex:      st.RaftAppliedIndex,
+			CommitLag:         maxCommit - st.RaftIndex,
+			DbSize:            st.DbSize,
+			DbSizeInUse:       st.DbSizeInUse,
+			DbSizeGrowth:      st.DbSize - prev.status.DbSize,
+			DbSizeInUseGrowth: st.DbSizeInUse - prev.status.DbSizeInUse,
+			LeaderChanges:     -1,
+			WatchStreams:      topMetric(s, "etcd_debugging_mvcc_watch_stream_total"),
+			Watchers:          topMetric(s, "etcd_debugging_mvcc_watcher_total"),
+			Leases:            topMetric(s, "etcd_debugging_lease_active"),
+			Error:             strings.Join(st.Errors, ", "),
+		}
+		if st.RaftIndex > st.RaftAppliedIndex {
+			m.AppliedLag = st.RaftIndex - st.RaftAppliedIndex
+		}
+		if dt := s.time.Sub(prev.time).Seconds(); dt > 0 && st.RaftIndex >= prev.status.RaftIndex {
+			m.ProposalsPerSec = float64(st.RaftIndex-prev.status.RaftIndex) / dt
+		}
+		if n, fn := topMetric(s, "etcd_server_leader_changes_seen_total"), topMetric(first, "etcd_server_leader_changes_seen_total"); n >= 0 && fn >= 0 {
+			m.LeaderChanges = n - fn
+		}
+		f.Members = append(f.Members, m)
+	}
+	return f
+}
+
+// topMetric returns the value of a metric of a sample, -1 if unknown.
+func topMetric(s topSample, name string) int64 {
+	v, ok := s.metrics[name]
+	if !ok {
+		return -1
+	}
+	return int64(v)
+}
+
+func (t *epTop) sample(ctx context.Context, ep string) topSample {
+	s := topSample{time: time.Now()}
+	s.status, s.err = t.c.Status(ctx, ep)
+	if s.err != nil {
+		return s
+	}
+	// the metrics are optional, e.g. when served on --listen-metrics-urls only
+	s.metrics, _ = t.scrape(ctx, ep)
+	return s
+}
+
+// scrape reads the metrics shown by top from the /metrics endpoint of ep.
+func (t *epTop) scrape(ctx context.Context, ep string) (map[string]float64, error) {
+	u := ep
+	if !strings.Contains(u, "://") {
+		if t.https {
+			u = "https://" + u
+		} else {
+			u = "http://" + u
+		}
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(u, "/")+"/metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	ms := make(map[string]float64)
+	for _, name := range topMetrics {
+		mf, ok := families[name]
+		if !ok || len(mf.Metric) == 0 {
+			continue
+		}
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			ms[name] = mf.Metric[0].GetCounter().GetValue()
+		case dto.MetricType_GAUGE:
+			ms[name] = mf.Metric[0].GetGauge().GetValue()
+		}
+	}
+	return ms, nil
+}
+
+func printEndpointTop(f topFrame, border bool) {
+	fmt.Printf("%s, refreshed every %s\n", f.Time.Format(time.RFC3339), epTopInterval)
+	hdr, rows := makeEndpointTopTable(f)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(hdr)
+	table.SetAutoWrapText(false)
+	table.SetBorder(border)
+	if !border {
+		table.SetColumnSeparator("")
+		table.SetHeaderLine(false)
+	}
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.SetAlignment(tablewriter.ALIGN_RIGHT)
+	table.Render()
+	for _, a := range f.Alarms {
+		fmt.Printf("alarm %v on member %x\n", a.Alarm, a.MemberID)
+	}
+}
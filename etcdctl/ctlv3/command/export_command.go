@@ -0,0 +1,277 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/etcdctl/v3/ctlv3/exportpb"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/spf13/cobra"
+)
+
+// exportVersion is the version of the export format written by export.
+const exportVersion = 1
+
+var (
+	exportPrefix   string
+	exportRev      int64
+	exportFormat   string
+	exportWithAuth bool
+)
+
+// NewExportCommand returns the cobra command for "export".
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [options] [filename]",
+		Short: "Exports keys, their leases and optionally users and roles",
+		Long: `Exports the keys with a prefix at a revision, the leases bound to them with
+their remaining TTL and optionally the users and roles, to a file or to the
+standard output if no file or "-" is given. The export can be imported into
+another cluster with import.
+
+Users and roles are not versioned, so they are exported as they are when
+exporting, whatever the revision.
+`,
+		Run: exportCommandFunc,
+	}
+	cmd.Flags().StringVar(&exportPrefix, "prefix", "", "Key prefix to export")
+	cmd.Flags().Int64Var(&exportRev, "rev", 0, "Revision to export, latest if 0")
+	cmd.Flags().StringVar(&exportFormat, "format", "json", "Format of the export (json, protobuf)")
+	cmd.Flags().BoolVar(&exportWithAuth, "with-auth", false, "Export the current users, roles and their permissions")
+	return cmd
+}
+
+func exportCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) > 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("export takes at most one filename argument"))
+	}
+
+	var out io.Writer = os.Stdout
+	toFile := len(args) == 1 && args[0] != "-"
+	if toFile {
+		f, err := os.Create(args[0])
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		defer f.Close()
+		out = f
+	}
+	bw := bufio.NewWriter(out)
+	w, err := newRecordWriter(bw, exportFormat)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	c := mustClientFromCmd(cmd)
+	st, err := exportKeyspace(context.Background(), c, w, exportPrefix, exportRev, exportWithAuth)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	if toFile {
+		fmt.Printf("Exported %d keys, %d leases, %d roles and %d users at revision %d to %s\n", st.keys, st.leases, st.roles, st.users, st.revision, args[0])
+	}
+}
+
+type exportStats struct {
+	revision                   int64
+	keys, leases, roles, users int
+}
+
+// exportKeyspace writes the keys with the given prefix at the given
+// revision to w. A lease is written before the first key bound to it;
+// keys bound to a lease that expired since the revision are left out.
+func exportKeyspace(ctx context.Context, c *clientv3.Client, w recordWriter, prefix string, rev int64, withAuth bool) (st exportStats, err error) {
+	s := newClusterKVStream(c, prefix, rev)
+	kv, err := s.next(ctx)
+	if err != nil {
+		return st, err
+	}
+	st.revision = s.rev
+	h := &exportpb.Header{Version: exportVersion, Revision: s.rev, Prefix: []byte(prefix)}
+	if err = w.write(&exportpb.Record{Header: h}); err != nil {
+		return st, err
+	}
+
+	// alive records whether the leases met so far are still alive
+	alive := make(map[int64]bool)
+	for ; kv != nil; kv, err = s.next(ctx) {
+		if kv.Lease != 0 {
+			ok, seen := alive[kv.Lease]
+			if !seen {
+				resp, lerr := c.TimeToLive(ctx, clientv3.LeaseID(kv.Lease))
+				if lerr != nil {
+					return st, lerr
+				}
+				ok = resp.TTL > 0
+				alive[kv.Lease] = ok
+				if ok {
+					if err = w.write(&exportpb.Record{Lease: &exportpb.Lease{ID: kv.Lease, TTL: resp.TTL}}); err != nil {
+						return st, err
+					}
+					st.leases++
+				}
+			}
+			if !ok {
+				continue
+			}
+		}
+		if err = w.write(&exportpb.Record{Kv: kv}); err != nil {
+			return st, err
+		}
+		st.keys++
+	}
+	if err != nil || !withAuth {
+		return st, err
+	}
+
+	// roles go first, as users refer to them
+	rresp, err := c.RoleList(ctx)
+	if err != nil {
+		return st, err
+	}
+	for _, name := range rresp.Roles {
+		resp, err := c.RoleGet(ctx, name)
+		if err != nil {
+			return st, err
+		}
+		if err = w.write(&exportpb.Record{Role: &authpb.Role{Name: []byte(name), KeyPermission: resp.Perm, OperationPermission: resp.OperationPerm}}); err != nil {
+			return st, err
+		}
+		st.roles++
+	}
+	uresp, err := c.UserList(ctx)
+	if err != nil {
+		return st, err
+	}
+	for _, name := range uresp.Users {
+		resp, err := c.UserGet(ctx, name)
+		if err != nil {
+			return st, err
+		}
+		if err = w.write(&exportpb.Record{User: &authpb.User{Name: []byte(name), Roles: resp.Roles}}); err != nil {
+			return st, err
+		}
+		st.users++
+	}
+	return st, nil
+}
+
+// recordWriter writes the records of an export.
+type recordWriter interface {
+	write(r *exportpb.Record) error
+}
+
+// recordReader reads the records of an export, returning io.EOF after the
+// last one.
+type recordReader interface {
+	read() (*exportpb.Record, error)
+}
+
+func newRecordWriter(w io.Writer, format string) (recordWriter, error) {
+	switch format {
+	case "json":
+		return &jsonRecordWriter{enc: json.NewEncoder(w)}, nil
+	case "protobuf":
+		return &protoRecordWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+func newRecordReader(r io.Reader, format string) (recordReader, error) {
+	switch format {
+	case "json":
+		return &jsonRecordReader{dec: json.NewDecoder(r)}, nil
+	case "protobuf":
+		return &protoRecordReader{r: bufio.NewReader(r)}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// jsonRecordWriter writes a record per line of JSON.
+type jsonRecordWriter struct {
+	enc *json.Encoder
+}
+
+func (w *jsonRecordWriter) write(r *exportpb.Record) error { return w.enc.Encode(r) }
+
+type jsonRecordReader struct {
+	dec *json.Decoder
+}
+
+func (r *jsonRecordReader) read() (*exportpb.Record, error) {
+	rec := &exportpb.Record{}
+	if err := r.dec.Decode(rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// protoRecordWriter writes each record as its protobuf encoding prefixed
+// by its length as an uvarint.
+type protoRecordWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+func (w *protoRecordWriter) write(r *exportpb.Record) error {
+	n := r.Size()
+	if cap(w.buf) < binary.MaxVarintLen64+n {
+		w.buf = make([]byte, binary.MaxVarintLen64+n)
+	}
+	l := binary.PutUvarint(w.buf, uint64(n))
+	if _, err := r.MarshalTo(w.buf[l : l+n]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(w.buf[:l+n])
+	return err
+}
+
+type protoRecordReader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func (r *protoRecordReader) read() (*exportpb.Record, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(cap(r.buf)) < n {
+		r.buf = make([]byte, n)
+	}
+	if _, err = io.ReadFull(r.r, r.buf[:n]); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	rec := &exportpb.Record{}
+	if err = rec.Unmarshal(r.buf[:n]); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
@@ -0,0 +1,349 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/etcdctl/v3/ctlv3/exportpb"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+	conflictFail      = "fail"
+)
+
+var (
+	importFormat     string
+	importDestPrefix string
+	importConflict   string
+)
+
+// NewImportCommand returns the cobra command for "import".
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [options] [filename]",
+		Short: "Imports an export into the cluster",
+		Long: `Imports the keys, leases, roles and users of an export written by export,
+from a file or from the standard input if no file or "-" is given. Leases are
+granted with their remaining TTL at export. Imported users have no password.
+The root role and user of the cluster, if any, are kept whatever the conflict
+policy.
+`,
+		Run: importCommandFunc,
+	}
+	cmd.Flags().StringVar(&importFormat, "format", "json", "Format of the export (json, protobuf)")
+	cmd.Flags().StringVar(&importDestPrefix, "dest-prefix", "", "Replace the exported prefix of keys and permissions with this prefix")
+	cmd.Flags().StringVar(&importConflict, "on-conflict", conflictFail, "What to do with keys, roles and users that already exist (skip, overwrite, fail)")
+	return cmd
+}
+
+func importCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) > 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("import takes at most one filename argument"))
+	}
+	switch importConflict {
+	case conflictSkip, conflictOverwrite, conflictFail:
+	default:
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("unknown conflict policy %q", importConflict))
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		defer f.Close()
+		in = f
+	}
+	r, err := newRecordReader(in, importFormat)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	im := &importer{
+		c:          mustClientFromCmd(cmd),
+		conflict:   importConflict,
+		rewrite:    cmd.Flags().Changed("dest-prefix"),
+		destPrefix: []byte(importDestPrefix),
+		leaseIDs:   make(map[int64]clientv3.LeaseID),
+	}
+	err = im.run(context.Background(), r)
+	fmt.Printf("Imported %d keys, %d leases, %d roles and %d users, skipped %d\n", im.keys, im.leases, im.roles, im.users, im.skipped)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+}
+
+// importer writes the records of an export to a cluster.
+type importer struct {
+	c        *clientv3.Client
+	conflict string
+
+	// rewrite replaces the exported prefix with destPrefix.
+	rewrite    bool
+	srcPrefix  []byte
+	destPrefix []byte
+
+	// leaseIDs maps the exported lease IDs to the granted ones.
+	leaseIDs map[int64]clientv3.LeaseID
+
+	keys, leases, roles, users, skipped int
+}
+
+func (im *importer) run(ctx context.Context, r recordReader) error {
+	rec, err := r.read()
+	if err == io.EOF {
+		return fmt.Errorf("export is empty")
+	}
+	if err != nil {
+		return err
+	}
+	if rec.Header == nil {
+		return fmt.Errorf("export does not start with a header")
+	}
+	if rec.Header.Version > exportVersion {
+		return fmt.Errorf("export format version %d is not supported, latest supported is %d", rec.Header.Version, exportVersion)
+	}
+	im.srcPrefix = rec.Header.Prefix
+
+	for {
+		rec, err = r.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case rec.Lease != nil:
+			err = im.importLease(ctx, rec.Lease)
+		case rec.Kv != nil:
+			err = im.importKey(ctx, rec.Kv.Key, rec.Kv.Value, rec.Kv.Lease)
+		case rec.Role != nil:
+			err = im.importRole(ctx, rec.Role)
+		case rec.User != nil:
+			err = im.importUser(ctx, rec.User)
+		default:
+			err = fmt.Errorf("unexpected record %v", rec)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (im *importer) importLease(ctx context.Context, l *exportpb.Lease) error {
+	resp, err := im.c.Grant(ctx, l.TTL)
+	if err != nil {
+		return err
+	}
+	im.leaseIDs[l.ID] = resp.ID
+	im.leases++
+	return nil
+}
+
+func (im *importer) importKey(ctx context.Context, key, val []byte, lease int64) error {
+	nkey, _ := im.rewriteKey(key)
+	k := string(nkey)
+	var opts []clientv3.OpOption
+	if lease != 0 {
+		id, ok := im.leaseIDs[lease]
+		if !ok {
+			return fmt.Errorf("lease %016x of key %q is not in the export", lease, key)
+		}
+		opts = append(opts, clientv3.WithLease(id))
+	}
+	put := clientv3.OpPut(k, string(val), opts...)
+
+	if im.conflict == conflictOverwrite {
+		if _, err := im.c.Do(ctx, put); err != nil {
+			return err
+		}
+		im.keys++
+		return nil
+	}
+	resp, err := im.c.Txn(ctx).If(clientv3.Compare(clientv3.CreateRevision(k), "=", 0)).Then(put).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		if im.conflict == conflictFail {
+			return fmt.Errorf("key %q already exists", k)
+		}
+		im.skipped++
+		return nil
+	}
+	im.keys++
+	return nil
+}
+
+func (im *importer) importRole(ctx context.Context, role *authpb.Role) error {
+	name := string(role.Name)
+	// the permissions are rewritten before the role is changed, so that a
+	// rejected permission leaves the role as is
+	perms := make([]*authpb.Permission, len(role.KeyPermission))
+	for i, perm := range role.KeyPermission {
+		key, end, err := im.rewriteRange(perm.Key, perm.RangeEnd)
+		if err != nil {
+			return fmt.Errorf("permission of role %q: %v", name, err)
+		}
+		perms[i] = &authpb.Permission{PermType: perm.PermType, Key: key, RangeEnd: end, Deny: perm.Deny}
+	}
+
+	_, err := im.c.RoleAdd(ctx, name)
+	if err == rpctypes.ErrRoleAlreadyExist && name == "root" {
+		// the root role grants every permission whatever its own, so the
+		// one of the cluster is kept under any policy
+		im.skipped++
+		return nil
+	}
+	if err == rpctypes.ErrRoleAlreadyExist {
+		switch im.conflict {
+		case conflictSkip:
+			im.skipped++
+			return nil
+		case conflictOverwrite:
+			// the imported permissions replace the existing ones
+			resp, gerr := im.c.RoleGet(ctx, name)
+			if gerr != nil {
+				return gerr
+			}
+			for _, perm := range resp.Perm {
+				if _, err = im.c.RoleRevokePermission(ctx, name, string(perm.Key), string(perm.RangeEnd)); err != nil {
+					return err
+				}
+			}
+			for _, perm := range resp.OperationPerm {
+				if _, err = im.c.RoleRevokeOperationPermission(ctx, name, clientv3.OperationPermissionType(perm.Operation)); err != nil {
+					return err
+				}
+			}
+			err = nil
+		default:
+			return fmt.Errorf("role %q already exists", name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	for _, perm := range perms {
+		grant := im.c.RoleGrantPermission
+		if perm.Deny {
+			grant = im.c.RoleDenyPermission
+		}
+		if _, err = grant(ctx, name, string(perm.Key), string(perm.RangeEnd), clientv3.PermissionType(perm.PermType)); err != nil {
+			return err
+		}
+	}
+	for _, perm := range role.OperationPermission {
+		if _, err = im.c.RoleGrantOperationPermission(ctx, name, clientv3.OperationPermissionType(perm.Operation)); err != nil {
+			return err
+		}
+	}
+	im.roles++
+	return nil
+}
+
+func (im *importer) importUser(ctx context.Context, user *authpb.User) error {
+	name := string(user.Name)
+	_, err := im.c.UserAddWithOptions(ctx, name, "", &clientv3.UserAddOptions{NoPassword: true})
+	if err == rpctypes.ErrUserAlreadyExist && name == "root" {
+		// the root user of the cluster administers it and cannot lose the
+		// root role while auth is enabled, so it is kept under any policy
+		im.skipped++
+		return nil
+	}
+	if err == rpctypes.ErrUserAlreadyExist {
+		switch im.conflict {
+		case conflictSkip:
+			im.skipped++
+			return nil
+		case conflictOverwrite:
+			// the imported roles replace the existing ones
+			resp, gerr := im.c.UserGet(ctx, name)
+			if gerr != nil {
+				return gerr
+			}
+			for _, r := range resp.Roles {
+				if _, err = im.c.UserRevokeRole(ctx, name, r); err != nil {
+					return err
+				}
+			}
+			err = nil
+		default:
+			return fmt.Errorf("user %q already exists", name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	for _, r := range user.Roles {
+		if _, err = im.c.UserGrantRole(ctx, name, r); err != nil {
+			return err
+		}
+	}
+	im.users++
+	return nil
+}
+
+// rewriteKey replaces the exported prefix of key with the destination
+// prefix. It returns false if the key is not rewritten.
+func (im *importer) rewriteKey(key []byte) ([]byte, bool) {
+	if !im.rewrite || !bytes.HasPrefix(key, im.srcPrefix) {
+		return key, false
+	}
+	return append(append([]byte{}, im.destPrefix...), key[len(im.srcPrefix):]...), true
+}
+
+// rewriteRange rewrites a permission range within the exported prefix. A
+// range crossing the prefix is rejected, as it cannot be rewritten without
+// covering other keys.
+func (im *importer) rewriteRange(key, end []byte) ([]byte, []byte, error) {
+	nkey, ok := im.rewriteKey(key)
+	if !ok {
+		// the range starts before the prefix and ends within it
+		if im.rewrite && len(end) > 0 && bytes.HasPrefix(end, im.srcPrefix) && !bytes.Equal(end, im.srcPrefix) {
+			return nil, nil, fmt.Errorf("range [%q, %q) crosses the exported prefix %q", key, end, im.srcPrefix)
+		}
+		return key, end, nil
+	}
+	switch {
+	case len(end) == 0:
+		return nkey, end, nil
+	case string(end) == clientv3.GetPrefixRangeEnd(string(key)):
+		return nkey, []byte(clientv3.GetPrefixRangeEnd(string(nkey))), nil
+	case string(end) == clientv3.GetPrefixRangeEnd(string(im.srcPrefix)):
+		return nkey, []byte(clientv3.GetPrefixRangeEnd(string(im.destPrefix))), nil
+	}
+	nend, ok := im.rewriteKey(end)
+	if !ok {
+		// the range starts within the prefix and ends after it, or has no end
+		return nil, nil, fmt.Errorf("range [%q, %q) crosses the exported prefix %q", key, end, im.srcPrefix)
+	}
+	return nkey, nend, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"testing"
+)
+
+func TestImporterRewriteRange(t *testing.T) {
+	im := &importer{rewrite: true, srcPrefix: []byte("a/"), destPrefix: []byte("b/")}
+	tests := []struct {
+		name     string
+		key, end string
+
+		wkey, wend string
+		werr       bool
+	}{
+		{name: "outside", key: "c", end: "d", wkey: "c", wend: "d"},
+		{name: "ends at prefix", key: "0", end: "a/", wkey: "0", wend: "a/"},
+		{name: "single key", key: "a/x", wkey: "b/x"},
+		{name: "key prefix", key: "a/x", end: "a/y", wkey: "b/x", wend: "b/y"},
+		{name: "whole prefix", key: "a/", end: "a0", wkey: "b/", wend: "b0"},
+		{name: "within prefix", key: "a/x", end: "a/z", wkey: "b/x", wend: "b/z"},
+		{name: "ends after prefix", key: "a/x", end: "c", werr: true},
+		{name: "from key", key: "a/x", end: "\x00", werr: true},
+		{name: "starts before prefix", key: "0", end: "a/x", werr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, end, err := im.rewriteRange([]byte(tt.key), []byte(tt.end))
+			if tt.werr {
+				if err == nil {
+					t.Fatalf("expected an error, got [%q, %q)", key, end)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(key) != tt.wkey || string(end) != tt.wend {
+				t.Errorf("expected [%q, %q), got [%q, %q)", tt.wkey, tt.wend, key, end)
+			}
+		})
+	}
+}
@@ -18,18 +18,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/bgentry/speakeasy"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/mirror"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 )
 
@@ -43,6 +51,11 @@ var (
 	mmuser         string
 	mmpassword     string
 	mmnodestprefix bool
+
+	mmcheckpointkey  string
+	mmcheckpointfile string
+	mmleases         bool
+	mmmetricsaddr    string
 )
 
 // NewMakeMirrorCommand returns the cobra command for "makeMirror".
@@ -63,22 +76,26 @@ func NewMakeMirrorCommand() *cobra.Command {
 	c.Flags().BoolVar(&mminsecureTr, "dest-insecure-transport", true, "Disable transport security for client connections")
 	c.Flags().StringVar(&mmuser, "dest-user", "", "Destination username[:password] for authentication (prompt if password is not supplied)")
 	c.Flags().StringVar(&mmpassword, "dest-password", "", "Destination password for authentication (if this option is used, --user option shouldn't include password)")
+	c.Flags().StringVar(&mmcheckpointkey, "checkpoint-key", "", "Destination key to store the last mirrored revision in, to resume mirroring from it")
+	c.Flags().StringVar(&mmcheckpointfile, "checkpoint-file", "", "Local file to store the last mirrored revision in, to resume mirroring from it")
+	c.Flags().BoolVar(&mmleases, "mirror-leases", false, "Mirror the leases of keys, keeping them alive in the destination while they are alive in the source")
+	c.Flags().StringVar(&mmmetricsaddr, "metrics-addr", "", "Address to serve the mirror metrics on, at /metrics (e.g. 127.0.0.1:9479)")
 
 	return c
 }
 
-func authDestCfg() *authCfg {
-	if mmuser == "" {
+func authDestCfg(user, password string) *authCfg {
+	if user == "" {
 		return nil
 	}
 
 	var cfg authCfg
 
-	if mmpassword == "" {
-		splitted := strings.SplitN(mmuser, ":", 2)
+	if password == "" {
+		splitted := strings.SplitN(user, ":", 2)
 		if len(splitted) < 2 {
 			var err error
-			cfg.username = mmuser
+			cfg.username = user
 			cfg.password, err = speakeasy.Ask("Destination Password: ")
 			if err != nil {
 				cobrautl.ExitWithError(cobrautl.ExitError, err)
@@ -88,8 +105,8 @@ func authDestCfg() *authCfg {
 			cfg.password = splitted[1]
 		}
 	} else {
-		cfg.username = mmuser
-		cfg.password = mmpassword
+		cfg.username = user
+		cfg.password = password
 	}
 
 	return &cfg
@@ -110,7 +127,7 @@ func makeMirrorCommandFunc(cmd *cobra.Command, args []string) {
 		insecureTransport: mminsecureTr,
 	}
 
-	auth := authDestCfg()
+	auth := authDestCfg(mmuser, mmpassword)
 
 	cc := &clientConfig{
 		endpoints:        []string{args[0]},
@@ -123,11 +140,29 @@ func makeMirrorCommandFunc(cmd *cobra.Command, args []string) {
 	dc := cc.mustClient()
 	c := mustClientFromCmd(cmd)
 
-	err := makeMirror(context.TODO(), c, dc)
+	var cp mirrorCheckpoint
+	switch {
+	case mmcheckpointkey != "" && mmcheckpointfile != "":
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--checkpoint-key` and `--checkpoint-file` cannot be set at the same time, choose one"))
+	case mmcheckpointkey != "":
+		cp = &keyCheckpoint{c: dc, key: mmcheckpointkey}
+	case mmcheckpointfile != "":
+		cp = &fileCheckpoint{path: mmcheckpointfile}
+	}
+
+	if mmmetricsaddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		go func() {
+			cobrautl.ExitWithError(cobrautl.ExitError, http.ListenAndServe(mmmetricsaddr, mux))
+		}()
+	}
+
+	err := makeMirror(context.TODO(), c, dc, cp)
 	cobrautl.ExitWithError(cobrautl.ExitError, err)
 }
 
-func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) error {
+func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client, cp mirrorCheckpoint) error {
 	total := int64(0)
 
 	go func() {
@@ -137,10 +172,6 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 		}
 	}()
 
-	s := mirror.NewSyncer(c, mmprefix, 0)
-
-	rc, errc := s.SyncBase(ctx)
-
 	// if destination prefix is specified and remove destination prefix is true return error
 	if mmnodestprefix && len(mmdestprefix) > 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--dest-prefix` and `--no-dest-prefix` cannot be set at the same time, choose one"))
@@ -151,22 +182,76 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 		mmdestprefix = mmprefix
 	}
 
-	for r := range rc {
-		for _, kv := range r.Kvs {
-			_, err := dc.Put(ctx, modifyPrefix(string(kv.Key)), string(kv.Value))
-			if err != nil {
-				return err
-			}
-			atomic.AddInt64(&total, 1)
+	var (
+		rev    int64
+		leases []int64
+	)
+	if cp != nil {
+		var err error
+		if rev, leases, err = cp.load(ctx); err != nil {
+			return err
 		}
 	}
 
-	err := <-errc
-	if err != nil {
-		return err
+	var lm *leaseMirror
+	if mmleases {
+		lm = newLeaseMirror(ctx, c, dc, cp)
+		if err := lm.restore(ctx, rev, leases); err != nil {
+			return err
+		}
+	}
+	save := func(rev int64) error {
+		if lm != nil {
+			return lm.save(ctx, rev)
+		}
+		if cp != nil {
+			return cp.save(ctx, rev, nil)
+		}
+		return nil
+	}
+
+	// without a checkpoint, sync the base state first
+	if rev == 0 {
+		checkPath := "foo"
+		if len(mmprefix) != 0 {
+			checkPath = mmprefix
+		}
+		resp, err := c.Get(ctx, checkPath)
+		if err != nil {
+			return err
+		}
+		rev = resp.Header.Revision
+
+		s := mirror.NewSyncer(c, mmprefix, rev)
+		rc, errc := s.SyncBase(ctx)
+		for r := range rc {
+			for _, kv := range r.Kvs {
+				opts, err := lm.putOptions(ctx, kv.Lease)
+				if err != nil {
+					return err
+				}
+				_, err = dc.Put(ctx, modifyPrefix(string(kv.Key)), string(kv.Value), opts...)
+				if err != nil {
+					return err
+				}
+				atomic.AddInt64(&total, 1)
+				mirrorKeys.Inc()
+			}
+		}
+
+		err = <-errc
+		if err != nil {
+			return err
+		}
+		if err = save(rev); err != nil {
+			return err
+		}
 	}
+	srcRev := rev
+	observeMirrorRevisions(srcRev, rev)
 
-	wc := s.SyncUpdates(ctx)
+	// progress notifications advance the mirrored revision while the prefix is idle
+	wc := c.Watch(ctx, mmprefix, clientv3.WithPrefix(), clientv3.WithRev(rev+1), clientv3.WithProgressNotify())
 
 	for wr := range wc {
 		if wr.CompactRevision != 0 {
@@ -188,7 +273,11 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 			lastRev = nextRev
 			switch ev.Type {
 			case mvccpb.PUT:
-				ops = append(ops, clientv3.OpPut(modifyPrefix(string(ev.Kv.Key)), string(ev.Kv.Value)))
+				opts, err := lm.putOptions(ctx, ev.Kv.Lease)
+				if err != nil {
+					return err
+				}
+				ops = append(ops, clientv3.OpPut(modifyPrefix(string(ev.Kv.Key)), string(ev.Kv.Value), opts...))
 				atomic.AddInt64(&total, 1)
 			case mvccpb.DELETE:
 				ops = append(ops, clientv3.OpDelete(modifyPrefix(string(ev.Kv.Key))))
@@ -196,6 +285,7 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 			default:
 				panic("unexpected event type")
 			}
+			mirrorKeys.Inc()
 		}
 
 		if len(ops) != 0 {
@@ -204,6 +294,23 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 				return err
 			}
 		}
+
+		if wr.Header.Revision > srcRev {
+			srcRev = wr.Header.Revision
+		}
+		next := lastRev
+		if wr.IsProgressNotify() {
+			next = wr.Header.Revision
+		}
+		if next <= rev {
+			observeMirrorRevisions(srcRev, rev)
+			continue
+		}
+		rev = next
+		observeMirrorRevisions(srcRev, rev)
+		if err := save(rev); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -212,3 +319,281 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 func modifyPrefix(key string) string {
 	return strings.Replace(key, mmprefix, mmdestprefix, 1)
 }
+
+// mirrorCheckpoint stores the last source revision mirrored to the
+// destination, along with the leases the mirror granted there. Replaying
+// the updates after a checkpoint is harmless, so the checkpoint is saved
+// after the updates are mirrored.
+type mirrorCheckpoint interface {
+	// load returns the last mirrored revision, or 0 if none was saved, and
+	// the mirrored leases.
+	load(ctx context.Context) (int64, []int64, error)
+	save(ctx context.Context, rev int64, leases []int64) error
+}
+
+// formatCheckpoint formats the revision followed by the lease IDs,
+// separated by spaces.
+func formatCheckpoint(rev int64, leases []int64) string {
+	fields := []string{strconv.FormatInt(rev, 10)}
+	for _, id := range leases {
+		fields = append(fields, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(fields, " ")
+}
+
+// parseCheckpoint parses a checkpoint formatted by formatCheckpoint. A
+// checkpoint saved without leases holds the revision only.
+func parseCheckpoint(s string) (int64, []int64, bool) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, nil, false
+	}
+	rev, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, nil, false
+	}
+	leases := make([]int64, 0, len(fields)-1)
+	for _, f := range fields[1:] {
+		id, err := strconv.ParseInt(f, 10, 64)
+		if err != nil {
+			return 0, nil, false
+		}
+		leases = append(leases, id)
+	}
+	return rev, leases, true
+}
+
+// keyCheckpoint stores the checkpoint in a destination key.
+type keyCheckpoint struct {
+	c   *clientv3.Client
+	key string
+}
+
+func (cp *keyCheckpoint) load(ctx context.Context) (int64, []int64, error) {
+	resp, err := cp.c.Get(ctx, cp.key)
+	if err != nil || len(resp.Kvs) == 0 {
+		return 0, nil, err
+	}
+	rev, leases, ok := parseCheckpoint(string(resp.Kvs[0].Value))
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid checkpoint %q in key %q", resp.Kvs[0].Value, cp.key)
+	}
+	return rev, leases, nil
+}
+
+func (cp *keyCheckpoint) save(ctx context.Context, rev int64, leases []int64) error {
+	_, err := cp.c.Put(ctx, cp.key, formatCheckpoint(rev, leases))
+	return err
+}
+
+// fileCheckpoint stores the checkpoint in a local file.
+type fileCheckpoint struct {
+	path string
+}
+
+func (cp *fileCheckpoint) load(context.Context) (int64, []int64, error) {
+	b, err := ioutil.ReadFile(cp.path)
+	if os.IsNotExist(err) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	rev, leases, ok := parseCheckpoint(string(b))
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid checkpoint %q in file %q", b, cp.path)
+	}
+	return rev, leases, nil
+}
+
+func (cp *fileCheckpoint) save(_ context.Context, rev int64, leases []int64) error {
+	// write and rename so that a crash never leaves a partial checkpoint
+	tmp := cp.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(formatCheckpoint(rev, leases)+"\n"), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, cp.path)
+}
+
+// leaseMirror mirrors the leases of mirrored keys to the destination,
+// granting them with the same IDs and TTLs, and keeps them alive there as
+// long as they are alive in the source. The granted leases are recorded in
+// the checkpoint; a lease of the destination that the mirror did not record
+// is never adopted, its keys are mirrored without lease instead.
+type leaseMirror struct {
+	ctx   context.Context
+	c, dc *clientv3.Client
+	cp    mirrorCheckpoint
+
+	mu sync.Mutex
+	// rev is the revision of the last checkpoint.
+	rev    int64
+	leases map[int64]struct{}
+	// foreign holds the leases found in the destination but not granted by
+	// the mirror.
+	foreign map[int64]struct{}
+}
+
+func newLeaseMirror(ctx context.Context, c, dc *clientv3.Client, cp mirrorCheckpoint) *leaseMirror {
+	return &leaseMirror{
+		ctx:     ctx,
+		c:       c,
+		dc:      dc,
+		cp:      cp,
+		leases:  make(map[int64]struct{}),
+		foreign: make(map[int64]struct{}),
+	}
+}
+
+// restore keeps alive again the leases recorded in the checkpoint loaded at
+// rev, and revokes those whose source lease is gone.
+func (lm *leaseMirror) restore(ctx context.Context, rev int64, ids []int64) error {
+	lm.rev = rev
+	for _, id := range ids {
+		dresp, err := lm.dc.TimeToLive(ctx, clientv3.LeaseID(id))
+		if err != nil {
+			return err
+		}
+		if dresp.TTL <= 0 {
+			// expired meanwhile, granted again by the next put
+			continue
+		}
+		resp, err := lm.c.TimeToLive(ctx, clientv3.LeaseID(id))
+		if err != nil {
+			return err
+		}
+		if resp.TTL <= 0 {
+			if _, err = lm.dc.Revoke(ctx, clientv3.LeaseID(id)); err != nil && rpctypes.Error(err) != rpctypes.ErrLeaseNotFound {
+				return err
+			}
+			continue
+		}
+		lm.adopt(id, resp.GrantedTTL)
+	}
+	return lm.save(ctx, rev)
+}
+
+// save saves the checkpoint at rev with the mirrored leases.
+func (lm *leaseMirror) save(ctx context.Context, rev int64) error {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	lm.rev = rev
+	return lm.saveLocked(ctx)
+}
+
+func (lm *leaseMirror) saveLocked(ctx context.Context) error {
+	if lm.cp == nil {
+		return nil
+	}
+	ids := make([]int64, 0, len(lm.leases))
+	for id := range lm.leases {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return lm.cp.save(ctx, lm.rev, ids)
+}
+
+// putOptions returns the options binding a mirrored key to the mirror of
+// its lease. Keys of leases not mirrored are put without lease.
+func (lm *leaseMirror) putOptions(ctx context.Context, id int64) ([]clientv3.OpOption, error) {
+	if lm == nil || id == 0 {
+		return nil, nil
+	}
+	ok, err := lm.mirror(ctx, id)
+	if err != nil || !ok {
+		// an expired lease is followed by the deletion of its keys
+		return nil, err
+	}
+	return []clientv3.OpOption{clientv3.WithLease(clientv3.LeaseID(id))}, nil
+}
+
+// mirror grants the lease in the destination if it is alive in the source
+// and not mirrored yet, and returns whether it is mirrored.
+func (lm *leaseMirror) mirror(ctx context.Context, id int64) (bool, error) {
+	lm.mu.Lock()
+	_, ok := lm.leases[id]
+	_, foreign := lm.foreign[id]
+	lm.mu.Unlock()
+	if ok || foreign {
+		return ok, nil
+	}
+
+	resp, err := lm.c.TimeToLive(ctx, clientv3.LeaseID(id))
+	if err != nil {
+		return false, err
+	}
+	if resp.TTL <= 0 {
+		return false, nil
+	}
+	_, err = clientv3.RetryLeaseClient(lm.dc).LeaseGrant(ctx, &pb.LeaseGrantRequest{ID: id, TTL: resp.GrantedTTL})
+	if rpctypes.Error(err) == rpctypes.ErrLeaseExist {
+		// granted by someone else, or by a mirror that stopped before
+		// recording it
+		fmt.Fprintf(os.Stderr, "lease %016x already exists in the destination, mirroring its keys without lease\n", id)
+		lm.mu.Lock()
+		lm.foreign[id] = struct{}{}
+		lm.mu.Unlock()
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	lm.adopt(id, resp.GrantedTTL)
+	// record the lease before binding keys to it
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	return true, lm.saveLocked(ctx)
+}
+
+// adopt keeps alive the mirrored lease.
+func (lm *leaseMirror) adopt(id int64, ttl int64) {
+	lm.mu.Lock()
+	lm.leases[id] = struct{}{}
+	lm.mu.Unlock()
+	mirrorLeases.Inc()
+	go lm.keepAlive(clientv3.LeaseID(id), ttl)
+}
+
+// keepAlive refreshes the mirrored lease while the source lease is alive,
+// and revokes it once the source lease is gone.
+func (lm *leaseMirror) keepAlive(id clientv3.LeaseID, ttl int64) {
+	defer func() {
+		lm.mu.Lock()
+		delete(lm.leases, int64(id))
+		if lm.ctx.Err() == nil {
+			// best effort, a lease left in the checkpoint is not found
+			// or revoked by the next restore
+			lm.saveLocked(lm.ctx)
+		}
+		lm.mu.Unlock()
+		mirrorLeases.Dec()
+	}()
+
+	interval := time.Duration(ttl) * time.Second / 3
+	if interval < 500*time.Millisecond {
+		interval = 500 * time.Millisecond
+	}
+	for {
+		select {
+		case <-lm.ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		resp, err := lm.c.TimeToLive(lm.ctx, id)
+		if err != nil {
+			// the source is unreachable, retry; the mirrored lease expires
+			// as the source one would without keepalives
+			continue
+		}
+		if resp.TTL <= 0 {
+			lm.dc.Revoke(lm.ctx, id)
+			return
+		}
+		if _, err = lm.dc.KeepAliveOnce(lm.ctx, id); err == rpctypes.ErrLeaseNotFound {
+			// expired in the destination, granted again by the next put
+			return
+		}
+	}
+}
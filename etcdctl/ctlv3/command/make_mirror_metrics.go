@@ -0,0 +1,71 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	mirrorSourceRevision = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcdctl",
+		Subsystem: "mirror",
+		Name:      "source_revision",
+		Help:      "The latest revision of the source cluster seen by the mirror.",
+	})
+	mirrorRevision = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcdctl",
+		Subsystem: "mirror",
+		Name:      "mirrored_revision",
+		Help:      "The latest revision of the source cluster mirrored to the destination.",
+	})
+	mirrorLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcdctl",
+		Subsystem: "mirror",
+		Name:      "lag_revisions",
+		Help:      "The number of revisions of the source cluster not mirrored yet.",
+	})
+	mirrorKeys = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcdctl",
+		Subsystem: "mirror",
+		Name:      "keys_total",
+		Help:      "Total number of key puts and deletes mirrored.",
+	})
+	mirrorLeases = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcdctl",
+		Subsystem: "mirror",
+		Name:      "leases",
+		Help:      "The number of mirrored leases kept alive.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(mirrorSourceRevision)
+	prometheus.MustRegister(mirrorRevision)
+	prometheus.MustRegister(mirrorLag)
+	prometheus.MustRegister(mirrorKeys)
+	prometheus.MustRegister(mirrorLeases)
+}
+
+// observeMirrorRevisions records the latest source revision seen and the
+// latest revision mirrored.
+func observeMirrorRevisions(source, mirrored int64) {
+	if source < mirrored {
+		source = mirrored
+	}
+	mirrorSourceRevision.Set(float64(source))
+	mirrorRevision.Set(float64(mirrored))
+	mirrorLag.Set(float64(source - mirrored))
+}
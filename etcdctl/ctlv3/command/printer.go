@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	v3 "go.etcd.io/etcd/client/v3"
@@ -48,6 +49,10 @@ type printer interface {
 	EndpointHealth([]epHealth)
 	EndpointStatus([]epStatus)
 	EndpointHashKV([]epHashKV)
+	EndpointTop(topFrame)
+	KeyDiff(keyDiff)
+	CheckConsistency(consistencyCheck)
+	Apply(applyPlan)
 	MoveLeader(leader, target uint64, r v3.MoveLeaderResponse)
 
 	Alarm(v3.AlarmResponse)
@@ -58,6 +63,7 @@ type printer interface {
 	RoleList(v3.AuthRoleListResponse)
 	RoleGrantPermission(role string, r v3.AuthRoleGrantPermissionResponse)
 	RoleRevokePermission(role string, key string, end string, r v3.AuthRoleRevokePermissionResponse)
+	RoleRevokeOperation(role string, op v3.OperationPermissionType, r v3.AuthRoleRevokePermissionResponse)
 
 	UserAdd(user string, r v3.AuthUserAddResponse)
 	UserGet(user string, r v3.AuthUserGetResponse)
@@ -65,6 +71,9 @@ type printer interface {
 	UserChangePassword(v3.AuthUserChangePasswordResponse)
 	UserGrantRole(user string, role string, r v3.AuthUserGrantRoleResponse)
 	UserRevokeRole(user string, role string, r v3.AuthUserRevokeRoleResponse)
+	UserUnlock(user string, r v3.AuthUserUnlockResponse)
+	UserSessionList(user string, r v3.AuthSessionListResponse)
+	UserSessionRevoke(user string, id uint64, r v3.AuthSessionRevokeResponse)
 	UserDelete(user string, r v3.AuthUserDeleteResponse)
 
 	AuthStatus(r v3.AuthStatusResponse)
@@ -130,6 +139,9 @@ func (p *printerRPC) RoleGrantPermission(_ string, r v3.AuthRoleGrantPermissionR
 func (p *printerRPC) RoleRevokePermission(_ string, _ string, _ string, r v3.AuthRoleRevokePermissionResponse) {
 	p.p((*pb.AuthRoleRevokePermissionResponse)(&r))
 }
+func (p *printerRPC) RoleRevokeOperation(_ string, _ v3.OperationPermissionType, r v3.AuthRoleRevokePermissionResponse) {
+	p.p((*pb.AuthRoleRevokePermissionResponse)(&r))
+}
 func (p *printerRPC) UserAdd(_ string, r v3.AuthUserAddResponse) { p.p((*pb.AuthUserAddResponse)(&r)) }
 func (p *printerRPC) UserGet(_ string, r v3.AuthUserGetResponse) { p.p((*pb.AuthUserGetResponse)(&r)) }
 func (p *printerRPC) UserList(r v3.AuthUserListResponse)         { p.p((*pb.AuthUserListResponse)(&r)) }
@@ -142,6 +154,15 @@ func (p *printerRPC) UserGrantRole(_ string, _ string, r v3.AuthUserGrantRoleRes
 func (p *printerRPC) UserRevokeRole(_ string, _ string, r v3.AuthUserRevokeRoleResponse) {
 	p.p((*pb.AuthUserRevokeRoleResponse)(&r))
 }
+func (p *printerRPC) UserUnlock(_ string, r v3.AuthUserUnlockResponse) {
+	p.p((*pb.AuthUserUnlockResponse)(&r))
+}
+func (p *printerRPC) UserSessionList(_ string, r v3.AuthSessionListResponse) {
+	p.p((*pb.AuthSessionListResponse)(&r))
+}
+func (p *printerRPC) UserSessionRevoke(_ string, _ uint64, r v3.AuthSessionRevokeResponse) {
+	p.p((*pb.AuthSessionRevokeResponse)(&r))
+}
 func (p *printerRPC) UserDelete(_ string, r v3.AuthUserDeleteResponse) {
 	p.p((*pb.AuthUserDeleteResponse)(&r))
 }
@@ -158,9 +179,13 @@ func newPrinterUnsupported(n string) printer {
 	return &printerUnsupported{printerRPC{nil, f}}
 }
 
-func (p *printerUnsupported) EndpointHealth([]epHealth) { p.p(nil) }
-func (p *printerUnsupported) EndpointStatus([]epStatus) { p.p(nil) }
-func (p *printerUnsupported) EndpointHashKV([]epHashKV) { p.p(nil) }
+func (p *printerUnsupported) EndpointHealth([]epHealth)         { p.p(nil) }
+func (p *printerUnsupported) EndpointStatus([]epStatus)         { p.p(nil) }
+func (p *printerUnsupported) EndpointHashKV([]epHashKV)         { p.p(nil) }
+func (p *printerUnsupported) EndpointTop(topFrame)              { p.p(nil) }
+func (p *printerUnsupported) KeyDiff(keyDiff)                   { p.p(nil) }
+func (p *printerUnsupported) CheckConsistency(consistencyCheck) { p.p(nil) }
+func (p *printerUnsupported) Apply(applyPlan)                   { p.p(nil) }
 
 func (p *printerUnsupported) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) { p.p(nil) }
 
@@ -187,6 +212,24 @@ func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string
 	return hdr, rows
 }
 
+func makeSessionListTable(r v3.AuthSessionListResponse) (hdr []string, rows [][]string) {
+	hdr = []string{"ID", "Issued At", "Expires At", "Source Address", "Member ID"}
+	for _, s := range r.Sessions {
+		expiresAt := ""
+		if s.ExpiresAt > 0 {
+			expiresAt = time.Unix(s.ExpiresAt, 0).Format(time.RFC3339)
+		}
+		rows = append(rows, []string{
+			fmt.Sprint(s.ID),
+			time.Unix(s.IssuedAt, 0).Format(time.RFC3339),
+			expiresAt,
+			s.SourceAddress,
+			fmt.Sprintf("%x", s.MemberId),
+		})
+	}
+	return hdr, rows
+}
+
 func makeEndpointHealthTable(healthList []epHealth) (hdr []string, rows [][]string) {
 	hdr = []string{"endpoint", "health", "took", "error"}
 	for _, h := range healthList {
@@ -220,6 +263,53 @@ func makeEndpointStatusTable(statusList []epStatus) (hdr []string, rows [][]stri
 	return hdr, rows
 }
 
+func makeEndpointTopTable(f topFrame) (hdr []string, rows [][]string) {
+	hdr = []string{"endpoint", "ID", "leader", "term", "commit index", "commit lag", "applied lag",
+		"proposals/s", "db size", "db size in use", "leader changes", "watch streams", "watchers", "leases", "errors"}
+	for _, m := range f.Members {
+		if m.ID == 0 {
+			rows = append(rows, []string{m.Endpoint, "", "", "", "", "", "", "", "", "", "", "", "", "", m.Error})
+			continue
+		}
+		rows = append(rows, []string{
+			m.Endpoint,
+			fmt.Sprintf("%x", m.ID),
+			fmt.Sprint(m.IsLeader),
+			fmt.Sprint(m.Term),
+			fmt.Sprint(m.CommitIndex),
+			fmt.Sprint(m.CommitLag),
+			fmt.Sprint(m.AppliedLag),
+			fmt.Sprintf("%.1f", m.ProposalsPerSec),
+			humanize.Bytes(uint64(m.DbSize)) + topGrowth(m.DbSizeGrowth),
+			humanize.Bytes(uint64(m.DbSizeInUse)) + topGrowth(m.DbSizeInUseGrowth),
+			topMetricString(m.LeaderChanges),
+			topMetricString(m.WatchStreams),
+			topMetricString(m.Watchers),
+			topMetricString(m.Leases),
+			m.Error,
+		})
+	}
+	return hdr, rows
+}
+
+func topGrowth(n int64) string {
+	switch {
+	case n > 0:
+		return " (+" + humanize.Bytes(uint64(n)) + ")"
+	case n < 0:
+		return " (-" + humanize.Bytes(uint64(-n)) + ")"
+	default:
+		return ""
+	}
+}
+
+func topMetricString(v int64) string {
+	if v < 0 {
+		return "-"
+	}
+	return fmt.Sprint(v)
+}
+
 func makeEndpointHashKVTable(hashList []epHashKV) (hdr []string, rows [][]string) {
 	hdr = []string{"endpoint", "hash"}
 	for _, h := range hashList {
@@ -192,6 +192,10 @@ func (p *fieldsPrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		fmt.Println(`"PermType" : `, p.PermType.String())
 		fmt.Printf("\"Key\" : %q\n", string(p.Key))
 		fmt.Printf("\"RangeEnd\" : %q\n", string(p.RangeEnd))
+		fmt.Println(`"Deny" : `, p.Deny)
+	}
+	for _, p := range r.OperationPerm {
+		fmt.Println(`"Operation" : `, p.Operation.String())
 	}
 }
 func (p *fieldsPrinter) RoleDelete(role string, r v3.AuthRoleDeleteResponse) { p.hdr(r.Header) }
@@ -209,6 +213,9 @@ func (p *fieldsPrinter) RoleGrantPermission(role string, r v3.AuthRoleGrantPermi
 func (p *fieldsPrinter) RoleRevokePermission(role string, key string, end string, r v3.AuthRoleRevokePermissionResponse) {
 	p.hdr(r.Header)
 }
+func (p *fieldsPrinter) RoleRevokeOperation(role string, op v3.OperationPermissionType, r v3.AuthRoleRevokePermissionResponse) {
+	p.hdr(r.Header)
+}
 func (p *fieldsPrinter) UserAdd(user string, r v3.AuthUserAddResponse)          { p.hdr(r.Header) }
 func (p *fieldsPrinter) UserChangePassword(r v3.AuthUserChangePasswordResponse) { p.hdr(r.Header) }
 func (p *fieldsPrinter) UserGrantRole(user string, role string, r v3.AuthUserGrantRoleResponse) {
@@ -217,4 +224,19 @@ func (p *fieldsPrinter) UserGrantRole(user string, role string, r v3.AuthUserGra
 func (p *fieldsPrinter) UserRevokeRole(user string, role string, r v3.AuthUserRevokeRoleResponse) {
 	p.hdr(r.Header)
 }
+func (p *fieldsPrinter) UserUnlock(user string, r v3.AuthUserUnlockResponse) { p.hdr(r.Header) }
+func (p *fieldsPrinter) UserSessionList(user string, r v3.AuthSessionListResponse) {
+	p.hdr(r.Header)
+	for _, s := range r.Sessions {
+		fmt.Println(`"ID" :`, s.ID)
+		fmt.Println(`"IssuedAt" :`, s.IssuedAt)
+		fmt.Println(`"ExpiresAt" :`, s.ExpiresAt)
+		fmt.Printf("\"SourceAddress\" : %q\n", s.SourceAddress)
+		fmt.Println(`"MemberID" :`, s.MemberId)
+		fmt.Println()
+	}
+}
+func (p *fieldsPrinter) UserSessionRevoke(user string, id uint64, r v3.AuthSessionRevokeResponse) {
+	p.hdr(r.Header)
+}
 func (p *fieldsPrinter) UserDelete(user string, r v3.AuthUserDeleteResponse) { p.hdr(r.Header) }
@@ -36,9 +36,13 @@ func newJSONPrinter(isHex bool) printer {
 	}
 }
 
-func (p *jsonPrinter) EndpointHealth(r []epHealth) { printJSON(r) }
-func (p *jsonPrinter) EndpointStatus(r []epStatus) { printJSON(r) }
-func (p *jsonPrinter) EndpointHashKV(r []epHashKV) { printJSON(r) }
+func (p *jsonPrinter) EndpointHealth(r []epHealth)         { printJSON(r) }
+func (p *jsonPrinter) EndpointStatus(r []epStatus)         { printJSON(r) }
+func (p *jsonPrinter) EndpointHashKV(r []epHashKV)         { printJSON(r) }
+func (p *jsonPrinter) EndpointTop(f topFrame)              { printJSON(f) }
+func (p *jsonPrinter) KeyDiff(d keyDiff)                   { printJSON(d) }
+func (p *jsonPrinter) CheckConsistency(r consistencyCheck) { printJSON(r) }
+func (p *jsonPrinter) Apply(r applyPlan)                   { printJSON(r) }
 
 func (p *jsonPrinter) MemberList(r clientv3.MemberListResponse) {
 	if p.isHex {
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	v3 "go.etcd.io/etcd/client/v3"
@@ -170,6 +172,22 @@ func (s *simplePrinter) EndpointHashKV(hashList []epHashKV) {
 	}
 }
 
+func (s *simplePrinter) EndpointTop(f topFrame) {
+	printEndpointTop(f, false)
+}
+
+func (s *simplePrinter) KeyDiff(d keyDiff) {
+	printKeyDiff(s.isHex, d)
+}
+
+func (s *simplePrinter) CheckConsistency(r consistencyCheck) {
+	printConsistencyCheck(r)
+}
+
+func (s *simplePrinter) Apply(p applyPlan) {
+	printApplyPlan(p)
+}
+
 func (s *simplePrinter) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
 	fmt.Printf("Leadership transferred from %s to %s\n", types.ID(leader), types.ID(target))
 }
@@ -196,8 +214,11 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		fmt.Printf("\n")
 	}
 
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermRead || perm.PermType == v3.PermReadWrite {
+	printPerms := func(typ authpb.Permission_Type, deny bool) {
+		for _, perm := range r.Perm {
+			if perm.Deny != deny || (perm.PermType != typ && perm.PermType != v3.PermReadWrite) {
+				continue
+			}
 			if len(perm.RangeEnd) == 0 {
 				fmt.Printf("\t%s\n", string(perm.Key))
 			} else {
@@ -205,14 +226,25 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 			}
 		}
 	}
+
+	printPerms(v3.PermRead, false)
 	fmt.Println("KV Write:")
+	printPerms(v3.PermWrite, false)
+
 	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermWrite || perm.PermType == v3.PermReadWrite {
-			if len(perm.RangeEnd) == 0 {
-				fmt.Printf("\t%s\n", string(perm.Key))
-			} else {
-				printRange((*v3.Permission)(perm))
-			}
+		if perm.Deny {
+			fmt.Println("KV Read Denied:")
+			printPerms(v3.PermRead, true)
+			fmt.Println("KV Write Denied:")
+			printPerms(v3.PermWrite, true)
+			break
+		}
+	}
+
+	if len(r.OperationPerm) > 0 {
+		fmt.Println("Operations:")
+		for _, perm := range r.OperationPerm {
+			fmt.Printf("\t%s\n", v3.OperationPermissionTypeToStr(v3.OperationPermissionType(perm.Operation)))
 		}
 	}
 }
@@ -243,6 +275,10 @@ func (s *simplePrinter) RoleRevokePermission(role string, key string, end string
 	}
 }
 
+func (s *simplePrinter) RoleRevokeOperation(role string, op v3.OperationPermissionType, r v3.AuthRoleRevokePermissionResponse) {
+	fmt.Printf("Permission of operation %s is revoked from role %s\n", v3.OperationPermissionTypeToStr(op), role)
+}
+
 func (s *simplePrinter) UserAdd(name string, r v3.AuthUserAddResponse) {
 	fmt.Printf("User %s created\n", name)
 }
@@ -254,6 +290,12 @@ func (s *simplePrinter) UserGet(name string, r v3.AuthUserGetResponse) {
 		fmt.Printf(" %s", role)
 	}
 	fmt.Printf("\n")
+	if r.FailedAttempts > 0 {
+		fmt.Printf("Failed attempts: %d\n", r.FailedAttempts)
+	}
+	if r.LockedUntil > 0 {
+		fmt.Printf("Locked until: %s\n", time.Unix(r.LockedUntil, 0).Format(time.RFC3339))
+	}
 }
 
 func (s *simplePrinter) UserChangePassword(v3.AuthUserChangePasswordResponse) {
@@ -268,6 +310,25 @@ func (s *simplePrinter) UserRevokeRole(user string, role string, r v3.AuthUserRe
 	fmt.Printf("Role %s is revoked from user %s\n", role, user)
 }
 
+func (s *simplePrinter) UserUnlock(user string, r v3.AuthUserUnlockResponse) {
+	fmt.Printf("User %s unlocked\n", user)
+}
+
+func (s *simplePrinter) UserSessionList(user string, r v3.AuthSessionListResponse) {
+	_, rows := makeSessionListTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) UserSessionRevoke(user string, id uint64, r v3.AuthSessionRevokeResponse) {
+	if id == 0 {
+		fmt.Printf("All sessions of user %s revoked\n", user)
+		return
+	}
+	fmt.Printf("Session %d of user %s revoked\n", id, user)
+}
+
 func (s *simplePrinter) UserDelete(user string, r v3.AuthUserDeleteResponse) {
 	fmt.Printf("User %s deleted\n", user)
 }
@@ -26,6 +26,7 @@ import (
 var (
 	rolePermPrefix  bool
 	rolePermFromKey bool
+	rolePermDeny    bool
 )
 
 // NewRoleCommand returns the cobra command for "role".
@@ -41,6 +42,8 @@ func NewRoleCommand() *cobra.Command {
 	ac.AddCommand(newRoleListCommand())
 	ac.AddCommand(newRoleGrantPermissionCommand())
 	ac.AddCommand(newRoleRevokePermissionCommand())
+	ac.AddCommand(newRoleGrantOperationCommand())
+	ac.AddCommand(newRoleRevokeOperationCommand())
 
 	return ac
 }
@@ -86,6 +89,7 @@ func newRoleGrantPermissionCommand() *cobra.Command {
 
 	cmd.Flags().BoolVar(&rolePermPrefix, "prefix", false, "grant a prefix permission")
 	cmd.Flags().BoolVar(&rolePermFromKey, "from-key", false, "grant a permission of keys that are greater than or equal to the given key using byte compare")
+	cmd.Flags().BoolVar(&rolePermDeny, "deny", false, "deny the permission instead of granting it, taking precedence over grants")
 
 	return cmd
 }
@@ -103,6 +107,27 @@ func newRoleRevokePermissionCommand() *cobra.Command {
 	return cmd
 }
 
+func newRoleGrantOperationCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-operation <role name> <operation>",
+		Short: "Grants an operation outside of the key space to a role",
+		Long: `Grants an operation outside of the key space to a role.
+
+The operations are member-list, member-add, member-remove, member-update,
+defragment, snapshot, alarm-disarm, move-leader, compaction and lease.
+`,
+		Run: roleGrantOperationCommandFunc,
+	}
+}
+
+func newRoleRevokeOperationCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-operation <role name> <operation>",
+		Short: "Revokes an operation outside of the key space from a role",
+		Run:   roleRevokeOperationCommandFunc,
+	}
+}
+
 // roleAddCommandFunc executes the "role add" command.
 func roleAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
@@ -172,7 +197,13 @@ func roleGrantPermissionCommandFunc(cmd *cobra.Command, args []string) {
 	}
 
 	key, rangeEnd := permRange(args[2:])
-	resp, err := mustClientFromCmd(cmd).Auth.RoleGrantPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	auth := mustClientFromCmd(cmd).Auth
+	var resp *clientv3.AuthRoleGrantPermissionResponse
+	if rolePermDeny {
+		resp, err = auth.RoleDenyPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	} else {
+		resp, err = auth.RoleGrantPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	}
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
@@ -194,6 +225,43 @@ func roleRevokePermissionCommandFunc(cmd *cobra.Command, args []string) {
 	display.RoleRevokePermission(args[0], args[1], rangeEnd, *resp)
 }
 
+// roleGrantOperationCommandFunc executes the "role grant-operation" command.
+func roleGrantOperationCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("role grant-operation command requires role name and operation as its argument"))
+	}
+
+	op, err := clientv3.StrToOperationPermissionType(args[1])
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	resp, err := mustClientFromCmd(cmd).Auth.RoleGrantOperationPermission(context.TODO(), args[0], op)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	display.RoleGrantPermission(args[0], *resp)
+}
+
+// roleRevokeOperationCommandFunc executes the "role revoke-operation" command.
+func roleRevokeOperationCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("role revoke-operation command requires role name and operation as its argument"))
+	}
+
+	op, err := clientv3.StrToOperationPermissionType(args[1])
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	resp, err := mustClientFromCmd(cmd).Auth.RoleRevokeOperationPermission(context.TODO(), args[0], op)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.RoleRevokeOperation(args[0], op, *resp)
+}
+
 func permRange(args []string) (string, string) {
 	key := args[0]
 	var rangeEnd string
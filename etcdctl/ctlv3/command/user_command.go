@@ -17,6 +17,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/bgentry/speakeasy"
@@ -43,6 +44,9 @@ func NewUserCommand() *cobra.Command {
 	ac.AddCommand(newUserChangePasswordCommand())
 	ac.AddCommand(newUserGrantRoleCommand())
 	ac.AddCommand(newUserRevokeRoleCommand())
+	ac.AddCommand(newUserUnlockCommand())
+	ac.AddCommand(newUserSessionListCommand())
+	ac.AddCommand(newUserSessionRevokeCommand())
 
 	return ac
 }
@@ -51,6 +55,7 @@ var (
 	passwordInteractive bool
 	passwordFromFlag    string
 	noPassword          bool
+	revokeAllSessions   bool
 )
 
 func newUserAddCommand() *cobra.Command {
@@ -123,6 +128,34 @@ func newUserRevokeRoleCommand() *cobra.Command {
 	}
 }
 
+func newUserUnlockCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlock <user name>",
+		Short: "Clears the failed authentication attempts and the lockout of a user",
+		Run:   userUnlockCommandFunc,
+	}
+}
+
+func newUserSessionListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "session-list <user name>",
+		Short: "Lists the active sessions of a user",
+		Run:   userSessionListCommandFunc,
+	}
+}
+
+func newUserSessionRevokeCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "session-revoke <user name> [<session ID>]",
+		Short: "Revokes a session, or all the sessions, of a user",
+		Run:   userSessionRevokeCommandFunc,
+	}
+
+	cmd.Flags().BoolVar(&revokeAllSessions, "all", false, "Revoke all the sessions of the user")
+
+	return &cmd
+}
+
 // userAddCommandFunc executes the "user add" command.
 func userAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
@@ -275,6 +308,20 @@ func userRevokeRoleCommandFunc(cmd *cobra.Command, args []string) {
 	display.UserRevokeRole(args[0], args[1], *resp)
 }
 
+// userUnlockCommandFunc executes the "user unlock" command.
+func userUnlockCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("user unlock command requires user name as its argument"))
+	}
+
+	resp, err := mustClientFromCmd(cmd).Auth.UserUnlock(context.TODO(), args[0])
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	display.UserUnlock(args[0], *resp)
+}
+
 func readPasswordInteractive(name string) string {
 	prompt1 := fmt.Sprintf("Password of %s: ", name)
 	password1, err1 := speakeasy.Ask(prompt1)
@@ -298,3 +345,47 @@ func readPasswordInteractive(name string) string {
 
 	return password1
 }
+
+// userSessionListCommandFunc executes the "user session-list" command.
+func userSessionListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("user session-list command requires user name as its argument"))
+	}
+
+	resp, err := mustClientFromCmd(cmd).Auth.SessionList(context.TODO(), args[0])
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	display.UserSessionList(args[0], *resp)
+}
+
+// userSessionRevokeCommandFunc executes the "user session-revoke" command.
+func userSessionRevokeCommandFunc(cmd *cobra.Command, args []string) {
+	if revokeAllSessions {
+		if len(args) != 1 {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("user session-revoke --all command requires user name as its argument"))
+		}
+		resp, err := mustClientFromCmd(cmd).Auth.SessionRevokeAll(context.TODO(), args[0])
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.UserSessionRevoke(args[0], 0, *resp)
+		return
+	}
+
+	if len(args) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("user session-revoke command requires user name and session ID as its argument"))
+	}
+	id, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil || id == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid session ID %q", args[1]))
+	}
+
+	resp, err := mustClientFromCmd(cmd).Auth.SessionRevoke(context.TODO(), args[0], id)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	display.UserSessionRevoke(args[0], id, *resp)
+}
@@ -37,11 +37,12 @@ var (
 )
 
 var (
-	watchRev         int64
-	watchPrefix      bool
-	watchInteractive bool
-	watchPrevKey     bool
-	progressNotify   bool
+	watchRev               int64
+	watchPrefix            bool
+	watchInteractive       bool
+	watchPrevKey           bool
+	watchFilterUnpermitted bool
+	progressNotify         bool
 )
 
 // NewWatchCommand returns the cobra command for "watch".
@@ -57,6 +58,7 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&watchRev, "rev", 0, "Revision to start watching")
 	cmd.Flags().BoolVar(&watchPrevKey, "prev-kv", false, "get the previous key-value pair before the event happens")
 	cmd.Flags().BoolVar(&progressNotify, "progress-notify", false, "get periodic watch progress notification from server")
+	cmd.Flags().BoolVar(&watchFilterUnpermitted, "filter-unpermitted", false, "skip the events on the keys the user is not permitted to read instead of denying the watch")
 
 	return cmd
 }
@@ -160,6 +162,9 @@ func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error)
 	if progressNotify {
 		opts = append(opts, clientv3.WithProgressNotify())
 	}
+	if watchFilterUnpermitted {
+		opts = append(opts, clientv3.WithFilterUnpermitted())
+	}
 	return c.Watch(clientv3.WithRequireLeader(context.Background()), key, opts...), nil
 }
 
@@ -334,6 +339,10 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 		if err != nil {
 			return nil, nil, err
 		}
+		watchFilterUnpermitted, err = flagset.GetBool("filter-unpermitted")
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// "ETCDCTL_WATCH_KEY=foo watch -- echo hello"
@@ -87,6 +87,10 @@ func init() {
 		command.NewMemberCommand(),
 		command.NewSnapshotCommand(),
 		command.NewMakeMirrorCommand(),
+		command.NewDiffCommand(),
+		command.NewExportCommand(),
+		command.NewImportCommand(),
+		command.NewApplyCommand(),
 		command.NewLockCommand(),
 		command.NewElectCommand(),
 		command.NewAuthCommand(),
@@ -42,6 +42,9 @@ func init() {
 		etcdutl.NewSnapshotCommand(),
 		etcdutl.NewVersionCommand(),
 		etcdutl.NewCheckCommand(),
+		etcdutl.NewAnalyzeCommand(),
+		etcdutl.NewRecoverCommand(),
+		etcdutl.NewWALCommand(),
 	)
 }
 
@@ -0,0 +1,73 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/datadir"
+)
+
+var (
+	analyzeDataDir         string
+	analyzePrefixDepth     int
+	analyzePrefixDelimiter string
+	analyzeTop             int
+)
+
+// NewAnalyzeCommand returns the cobra command for "analyze".
+func NewAnalyzeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze [filename]",
+		Short: "Analyzes the keyspace of a snapshot file or data directory",
+		Long: `Reports the usage of the keyspace stored in a snapshot file or in the
+backend of a data directory not in use by etcd: bytes and keys by prefix,
+largest keys and values, keys with most revisions not yet compacted, keys
+by lease and page usage of the database file.`,
+		Run: analyzeCommandFunc,
+	}
+	cmd.Flags().StringVar(&analyzeDataDir, "data-dir", "", "Analyzes a data directory not in use by etcd instead of a snapshot file.")
+	cmd.Flags().IntVar(&analyzePrefixDepth, "prefix-depth", 2, "Number of key segments aggregated into a prefix.")
+	cmd.Flags().StringVar(&analyzePrefixDelimiter, "prefix-delimiter", "/", "Delimiter of key segments.")
+	cmd.Flags().IntVar(&analyzeTop, "top", 10, "Number of entries in each ranking, 0 for all.")
+	return cmd
+}
+
+func analyzeCommandFunc(cmd *cobra.Command, args []string) {
+	var dbPath string
+	switch {
+	case len(args) == 1 && analyzeDataDir == "":
+		dbPath = args[0]
+	case len(args) == 0 && analyzeDataDir != "":
+		dbPath = datadir.ToBackendFileName(analyzeDataDir)
+	default:
+		err := fmt.Errorf("analyze requires either a snapshot file or --data-dir")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	printer := initPrinterFromCmd(cmd)
+	a, err := snapshot.Analyze(dbPath, snapshot.AnalyzeConfig{
+		PrefixDepth:     analyzePrefixDepth,
+		PrefixDelimiter: analyzePrefixDelimiter,
+		Top:             analyzeTop,
+	})
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.Analysis(a)
+}
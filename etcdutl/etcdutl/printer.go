@@ -17,8 +17,10 @@ package etcdutl
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 
@@ -31,6 +33,9 @@ var (
 
 type printer interface {
 	DBStatus(snapshot.Status)
+	Analysis(snapshot.Analysis)
+	Recovery(RecoveryReport)
+	Replay(ReplayReport)
 }
 
 func NewPrinter(printerType string) printer {
@@ -63,7 +68,10 @@ func newPrinterUnsupported(n string) printer {
 	return &printerUnsupported{printerRPC{nil, f}}
 }
 
-func (p *printerUnsupported) DBStatus(snapshot.Status) { p.p(nil) }
+func (p *printerUnsupported) DBStatus(snapshot.Status)   { p.p(nil) }
+func (p *printerUnsupported) Analysis(snapshot.Analysis) { p.p(nil) }
+func (p *printerUnsupported) Recovery(RecoveryReport)    { p.p(nil) }
+func (p *printerUnsupported) Replay(ReplayReport)        { p.p(nil) }
 
 func makeDBStatusTable(ds snapshot.Status) (hdr []string, rows [][]string) {
 	hdr = []string{"hash", "revision", "total keys", "total size"}
@@ -76,6 +84,172 @@ func makeDBStatusTable(ds snapshot.Status) (hdr []string, rows [][]string) {
 	return hdr, rows
 }
 
+// titledTable is a section of output printed as a table with a title.
+type titledTable struct {
+	title string
+	hdr   []string
+	rows  [][]string
+}
+
+func makeAnalysisTables(a snapshot.Analysis) []titledTable {
+	summary := titledTable{
+		title: "summary",
+		hdr:   []string{"revision", "compact revision", "keys", "revisions"},
+		rows: [][]string{{
+			fmt.Sprint(a.Revision),
+			fmt.Sprint(a.CompactRevision),
+			fmt.Sprint(a.Keys),
+			fmt.Sprint(a.Revisions),
+		}},
+	}
+
+	prefixes := titledTable{
+		title: "prefixes",
+		hdr:   []string{"prefix", "keys", "revisions", "live size", "total size"},
+	}
+	for _, p := range a.Prefixes {
+		prefixes.rows = append(prefixes.rows, []string{
+			p.Prefix,
+			fmt.Sprint(p.Keys),
+			fmt.Sprint(p.Revisions),
+			humanize.Bytes(uint64(p.LiveBytes)),
+			humanize.Bytes(uint64(p.TotalBytes)),
+		})
+	}
+
+	keyTable := func(title string, us []snapshot.KeyUsage) titledTable {
+		t := titledTable{
+			title: title,
+			hdr:   []string{"key", "mod revision", "key size", "value size", "revisions"},
+		}
+		for _, u := range us {
+			t.rows = append(t.rows, []string{
+				u.Key,
+				fmt.Sprint(u.ModRevision),
+				humanize.Bytes(uint64(u.KeySize)),
+				humanize.Bytes(uint64(u.ValueSize)),
+				fmt.Sprint(u.Revisions),
+			})
+		}
+		return t
+	}
+
+	leases := titledTable{
+		title: "leases",
+		hdr:   []string{"lease", "ttl", "keys", "size"},
+	}
+	for _, l := range a.Leases {
+		id := fmt.Sprintf("%016x", l.ID)
+		if l.ID == 0 {
+			id = "none"
+		}
+		leases.rows = append(leases.rows, []string{
+			id,
+			fmt.Sprint(l.TTL),
+			fmt.Sprint(l.Keys),
+			humanize.Bytes(uint64(l.Bytes)),
+		})
+	}
+
+	pages := titledTable{
+		title: "pages",
+		hdr:   []string{"page size", "total pages", "free pages", "pending pages", "in use pages"},
+		rows: [][]string{{
+			humanize.Bytes(uint64(a.Pages.PageSize)),
+			fmt.Sprint(a.Pages.TotalPages),
+			fmt.Sprint(a.Pages.FreePages),
+			fmt.Sprint(a.Pages.PendingPages),
+			fmt.Sprint(a.Pages.TotalPages - int64(a.Pages.FreePages+a.Pages.PendingPages)),
+		}},
+	}
+	bkts := titledTable{
+		title: "buckets",
+		hdr:   []string{"bucket", "keys", "pages", "in use size"},
+	}
+	for _, b := range a.Pages.Buckets {
+		bkts.rows = append(bkts.rows, []string{
+			b.Name,
+			fmt.Sprint(b.Keys),
+			fmt.Sprint(b.Pages),
+			humanize.Bytes(uint64(b.InuseBytes)),
+		})
+	}
+
+	return []titledTable{
+		summary,
+		prefixes,
+		keyTable("largest keys", a.LargestKeys),
+		keyTable("largest values", a.LargestValues),
+		keyTable("most revisions", a.MostRevisions),
+		leases,
+		pages,
+		bkts,
+	}
+}
+
+func makeRecoveryTables(r RecoveryReport) []titledTable {
+	st := r.State
+	state := titledTable{
+		title: "member",
+		hdr:   []string{"cluster id", "member id", "term", "commit index", "applied index", "snapshot index", "uncommitted entries"},
+		rows: [][]string{{
+			st.ClusterID.String(),
+			st.MemberID.String(),
+			fmt.Sprint(st.Term),
+			fmt.Sprint(st.CommitIndex),
+			fmt.Sprint(st.AppliedIndex),
+			fmt.Sprint(st.SnapshotIndex),
+			fmt.Sprint(st.UncommittedEntries),
+		}},
+	}
+
+	members := titledTable{
+		title: "members",
+		hdr:   []string{"id", "name", "peer addrs", "is learner"},
+	}
+	for _, m := range st.Members {
+		members.rows = append(members.rows, []string{
+			m.ID.String(),
+			m.Name,
+			strings.Join(m.PeerURLs, ","),
+			fmt.Sprint(m.IsLearner),
+		})
+	}
+	removed := titledTable{
+		title: "removed members",
+		hdr:   []string{"id"},
+	}
+	for _, id := range st.RemovedMembers {
+		removed.rows = append(removed.rows, []string{id.String()})
+	}
+	ts := []titledTable{state, members, removed}
+
+	if p := r.Plan; p != nil {
+		ids := func(ids []types.ID) string {
+			ss := make([]string, 0, len(ids))
+			for _, id := range ids {
+				ss = append(ss, id.String())
+			}
+			return strings.Join(ss, ",")
+		}
+		plan := titledTable{
+			title: "recovery",
+			hdr:   []string{"survivors", "removals", "index", "term", "dry run", "wal backup dir", "backend backup path"},
+			rows: [][]string{{
+				ids(p.Survivors),
+				ids(p.Removals),
+				fmt.Sprint(p.Index),
+				fmt.Sprint(p.Term),
+				fmt.Sprint(p.DryRun),
+				p.WALBackupDir,
+				p.BackendBackupPath,
+			}},
+		}
+		ts = append(ts, plan)
+	}
+	return ts
+}
+
 func initPrinterFromCmd(cmd *cobra.Command) (p printer) {
 	outputType, err := cmd.Flags().GetString("write-out")
 	if err != nil {
@@ -32,7 +32,10 @@ func newJSONPrinter() printer {
 	}
 }
 
-func (p *jsonPrinter) DBStatus(r snapshot.Status) { printJSON(r) }
+func (p *jsonPrinter) DBStatus(r snapshot.Status)   { printJSON(r) }
+func (p *jsonPrinter) Analysis(a snapshot.Analysis) { printJSON(a) }
+func (p *jsonPrinter) Recovery(r RecoveryReport)    { printJSON(r) }
+func (p *jsonPrinter) Replay(r ReplayReport)        { printJSON(r) }
 
 // !!! Share ??
 func printJSON(v interface{}) {
@@ -30,3 +30,27 @@ func (s *simplePrinter) DBStatus(ds snapshot.Status) {
 		fmt.Println(strings.Join(row, ", "))
 	}
 }
+
+func (s *simplePrinter) Analysis(a snapshot.Analysis) {
+	printSimpleTables(makeAnalysisTables(a))
+}
+
+func (s *simplePrinter) Recovery(r RecoveryReport) {
+	printSimpleTables(makeRecoveryTables(r))
+}
+
+func (s *simplePrinter) Replay(r ReplayReport) {
+	fmt.Printf("Replayed %d entries from index %d to %d, revision %d, into %s\n", r.Entries, r.SnapshotIndex, r.Index, r.Revision, r.Output)
+}
+
+func printSimpleTables(ts []titledTable) {
+	for i, t := range ts {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s: %s\n", t.title, strings.Join(t.hdr, ", "))
+		for _, row := range t.rows {
+			fmt.Println(strings.Join(row, ", "))
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package etcdutl
 
 import (
+	"fmt"
 	"os"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
@@ -34,3 +35,24 @@ func (tp *tablePrinter) DBStatus(r snapshot.Status) {
 	table.SetAlignment(tablewriter.ALIGN_RIGHT)
 	table.Render()
 }
+
+func (tp *tablePrinter) Analysis(a snapshot.Analysis) {
+	printTitledTables(makeAnalysisTables(a))
+}
+
+func (tp *tablePrinter) Recovery(r RecoveryReport) {
+	printTitledTables(makeRecoveryTables(r))
+}
+
+func printTitledTables(ts []titledTable) {
+	for _, t := range ts {
+		fmt.Println(t.title)
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader(t.hdr)
+		for _, row := range t.rows {
+			table.Append(row)
+		}
+		table.SetAlignment(tablewriter.ALIGN_RIGHT)
+		table.Render()
+	}
+}
@@ -0,0 +1,484 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/datadir"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+	"go.etcd.io/etcd/server/v3/mvcc/buckets"
+	"go.etcd.io/etcd/server/v3/wal"
+	"go.etcd.io/etcd/server/v3/wal/walpb"
+	"go.uber.org/zap"
+)
+
+var (
+	recoverDataDir   string
+	recoverWALDir    string
+	recoverSurvivors []string
+	recoverDryRun    bool
+)
+
+// NewRecoverCommand returns the cobra command for "recover".
+func NewRecoverCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover",
+		Short: "Recovers a cluster that permanently lost quorum from the data directory of a stopped member",
+		Long: `Shows the membership and the raft state of a stopped member. Given the
+surviving members, it rewrites the membership of the member to the survivors,
+writing the removal of the other members as conf changes, a snapshot and the
+backend membership, so that the survivors form a cluster of their own.
+
+Every survivor must be recovered with the same survivors and must report the
+same commit index. The WAL directory and the backend in use before recovery
+are kept aside.`,
+		Run: recoverCommandFunc,
+	}
+	cmd.Flags().StringVar(&recoverDataDir, "data-dir", "", "Required. Path to the data directory of a stopped member.")
+	cmd.Flags().StringVar(&recoverWALDir, "wal-dir", "", "Path to the WAL directory, if not in the data directory.")
+	cmd.Flags().StringSliceVar(&recoverSurvivors, "survivors", nil, "Names or hexadecimal IDs of the members to keep. Shows the state of the member only if not set.")
+	cmd.Flags().BoolVar(&recoverDryRun, "dry-run", false, "Shows the recovery to be done without modifying the data directory.")
+	cmd.MarkFlagRequired("data-dir")
+	return cmd
+}
+
+func recoverCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+	r, err := RecoverMember(GetLogger(), recoverDataDir, recoverWALDir, recoverSurvivors, recoverDryRun)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.Recovery(r)
+}
+
+// RecoveryState is the raft state and membership known by a stopped member.
+type RecoveryState struct {
+	ClusterID types.ID `json:"clusterID"`
+	MemberID  types.ID `json:"memberID"`
+	Term      uint64   `json:"term"`
+	// CommitIndex is the last index the member knows to be committed.
+	CommitIndex uint64 `json:"commitIndex"`
+	// AppliedIndex is the last index applied to the backend.
+	AppliedIndex  uint64 `json:"appliedIndex"`
+	SnapshotIndex uint64 `json:"snapshotIndex"`
+	// UncommittedEntries is the number of entries beyond the commit index,
+	// which are discarded by recovery.
+	UncommittedEntries int                  `json:"uncommittedEntries"`
+	Members            []*membership.Member `json:"members"`
+	RemovedMembers     []types.ID           `json:"removedMembers"`
+}
+
+// RecoveryPlan is the membership rewrite done by recovery.
+type RecoveryPlan struct {
+	Survivors []types.ID `json:"survivors"`
+	Removals  []types.ID `json:"removals"`
+	// Index and Term are those of the snapshot written by recovery, after
+	// the removal of the other members.
+	Index  uint64 `json:"index"`
+	Term   uint64 `json:"term"`
+	DryRun bool   `json:"dryRun"`
+	// WALBackupDir keeps the WAL directory in use before recovery.
+	WALBackupDir string `json:"walBackupDir,omitempty"`
+	// BackendBackupPath keeps the backend in use before recovery.
+	BackendBackupPath string `json:"backendBackupPath,omitempty"`
+}
+
+// RecoveryReport is the result of the recover command.
+type RecoveryReport struct {
+	State RecoveryState `json:"state"`
+	Plan  *RecoveryPlan `json:"plan,omitempty"`
+}
+
+// memberData is the persisted state of a stopped member.
+type memberData struct {
+	state          RecoveryState
+	snapshot       *raftpb.Snapshot
+	metadata       []byte
+	hardState      raftpb.HardState
+	clusterVersion string
+}
+
+// RecoverMember reads the state of the stopped member in dataDir and, if
+// survivors are given, rewrites its membership to the survivors.
+func RecoverMember(lg *zap.Logger, dataDir, walDir string, survivors []string, dryRun bool) (RecoveryReport, error) {
+	if walDir == "" {
+		walDir = datadir.ToWalDir(dataDir)
+	}
+	md, err := readMemberData(lg, dataDir, walDir)
+	if err != nil {
+		return RecoveryReport{}, err
+	}
+	r := RecoveryReport{State: md.state}
+	if len(survivors) == 0 {
+		return r, nil
+	}
+
+	plan, err := planRecovery(md.state, survivors)
+	if err != nil {
+		return r, err
+	}
+	plan.DryRun = dryRun
+	r.Plan = plan
+	if dryRun {
+		return r, nil
+	}
+	return r, applyRecovery(lg, dataDir, walDir, md, plan)
+}
+
+func readMemberData(lg *zap.Logger, dataDir, walDir string) (*memberData, error) {
+	walSnaps, err := wal.ValidSnapshotEntries(lg, walDir)
+	if err != nil {
+		if errors.Is(err, wal.ErrFileNotFound) {
+			return nil, fmt.Errorf("no WAL found in %q", walDir)
+		}
+		return nil, err
+	}
+	md := &memberData{}
+	md.snapshot, err = snap.New(lg, datadir.ToSnapDir(dataDir)).LoadNewestAvailable(walSnaps)
+	if err != nil && !errors.Is(err, snap.ErrNoSnapshot) {
+		return nil, err
+	}
+
+	var walsnap walpb.Snapshot
+	if md.snapshot != nil {
+		walsnap.Index, walsnap.Term = md.snapshot.Metadata.Index, md.snapshot.Metadata.Term
+		md.state.SnapshotIndex = md.snapshot.Metadata.Index
+	}
+	w, err := wal.OpenForRead(lg, walDir, walsnap)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+	var ents []raftpb.Entry
+	md.metadata, md.hardState, ents, err = w.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var metadata etcdserverpb.Metadata
+	pbutil.MustUnmarshal(&metadata, md.metadata)
+	md.state.ClusterID, md.state.MemberID = types.ID(metadata.ClusterID), types.ID(metadata.NodeID)
+	md.state.Term, md.state.CommitIndex = md.hardState.Term, md.hardState.Commit
+	for _, e := range ents {
+		if e.Index > md.hardState.Commit {
+			md.state.UncommittedEntries++
+		}
+	}
+
+	if err = readBackendMembership(datadir.ToBackendFileName(dataDir), md); err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+// readBackendMembership reads the membership applied to the backend without
+// modifying the backend.
+func readBackendMembership(dbPath string, md *memberData) error {
+	if _, err := os.Stat(dbPath); err != nil {
+		return err
+	}
+	db, err := bolt.Open(dbPath, 0400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return fmt.Errorf("cannot open backend %q, is the member stopped? (%v)", dbPath, err)
+	}
+	defer db.Close()
+
+	return db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(buckets.Meta.Name()); b != nil {
+			if v := b.Get(buckets.MetaConsistentIndexKeyName); len(v) == 8 {
+				md.state.AppliedIndex = binary.BigEndian.Uint64(v)
+			}
+		}
+		if b := tx.Bucket(buckets.Cluster.Name()); b != nil {
+			md.clusterVersion = string(b.Get([]byte("clusterVersion")))
+		}
+		if b := tx.Bucket(buckets.Members.Name()); b != nil {
+			if err := b.ForEach(func(k, v []byte) error {
+				m := &membership.Member{}
+				if err := json.Unmarshal(v, m); err != nil {
+					return fmt.Errorf("cannot unmarshal member %s: %v", k, err)
+				}
+				md.state.Members = append(md.state.Members, m)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		if b := tx.Bucket(buckets.MembersRemoved.Name()); b != nil {
+			if err := b.ForEach(func(k, _ []byte) error {
+				id, err := types.IDFromString(string(k))
+				if err != nil {
+					return fmt.Errorf("cannot parse removed member %s: %v", k, err)
+				}
+				md.state.RemovedMembers = append(md.state.RemovedMembers, id)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func planRecovery(st RecoveryState, survivors []string) (*RecoveryPlan, error) {
+	if st.AppliedIndex < st.CommitIndex {
+		return nil, fmt.Errorf("backend applied index %d is behind commit index %d; restart the member once to apply the committed entries", st.AppliedIndex, st.CommitIndex)
+	}
+
+	keep := make(map[types.ID]bool)
+	for _, s := range survivors {
+		m := findMember(st.Members, s)
+		if m == nil {
+			return nil, fmt.Errorf("survivor %q is not a member of cluster %s", s, st.ClusterID)
+		}
+		keep[m.ID] = true
+	}
+	if !keep[st.MemberID] {
+		return nil, fmt.Errorf("the recovered member %s must be one of the survivors", st.MemberID)
+	}
+
+	plan := &RecoveryPlan{Term: st.Term}
+	voters := 0
+	for _, m := range st.Members {
+		if !keep[m.ID] {
+			plan.Removals = append(plan.Removals, m.ID)
+			continue
+		}
+		plan.Survivors = append(plan.Survivors, m.ID)
+		if !m.IsLearner {
+			voters++
+		}
+	}
+	if voters == 0 {
+		return nil, fmt.Errorf("survivors must include a voting member")
+	}
+	if len(plan.Removals) == 0 {
+		return nil, fmt.Errorf("survivors include all members; nothing to recover")
+	}
+	sort.Sort(types.IDSlice(plan.Survivors))
+	sort.Sort(types.IDSlice(plan.Removals))
+	// the entries beyond the applied index are not committed, so the
+	// removals take their place
+	plan.Index = st.AppliedIndex + uint64(len(plan.Removals))
+	return plan, nil
+}
+
+func findMember(ms []*membership.Member, nameOrID string) *membership.Member {
+	for _, m := range ms {
+		if m.Name == nameOrID {
+			return m
+		}
+	}
+	if id, err := types.IDFromString(nameOrID); err == nil {
+		for _, m := range ms {
+			if m.ID == id {
+				return m
+			}
+		}
+	}
+	return nil
+}
+
+// applyRecovery writes the removals of the plan as conf change entries of a
+// new WAL, followed by a snapshot of the resulting membership, and updates
+// the membership and applied index of a copy of the backend to the
+// snapshot. The new WAL and backend then replace the previous ones, which
+// are kept aside in the backup paths of the plan.
+func applyRecovery(lg *zap.Logger, dataDir, walDir string, md *memberData, plan *RecoveryPlan) error {
+	st := md.state
+	keep := make(map[types.ID]bool)
+	for _, id := range plan.Survivors {
+		keep[id] = true
+	}
+	var survivors []*membership.Member
+	var cs raftpb.ConfState
+	for _, m := range st.Members {
+		if !keep[m.ID] {
+			continue
+		}
+		survivors = append(survivors, m)
+		if m.IsLearner {
+			cs.Learners = append(cs.Learners, uint64(m.ID))
+		} else {
+			cs.Voters = append(cs.Voters, uint64(m.ID))
+		}
+	}
+	removed := append(append([]types.ID{}, st.RemovedMembers...), plan.Removals...)
+
+	ents := make([]raftpb.Entry, 0, len(plan.Removals))
+	for i, id := range plan.Removals {
+		cc := &raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: uint64(id)}
+		ents = append(ents, raftpb.Entry{
+			Type:  raftpb.EntryConfChange,
+			Term:  plan.Term,
+			Index: st.AppliedIndex + uint64(i) + 1,
+			Data:  pbutil.MustMarshal(cc),
+		})
+	}
+
+	// the new WAL and backend are prepared aside, so that a failure leaves
+	// the member as is
+	tmpWALDir := walDir + ".recover"
+	if err := os.RemoveAll(tmpWALDir); err != nil {
+		return err
+	}
+	w, err := wal.Create(lg, tmpWALDir, md.metadata)
+	if err != nil {
+		return err
+	}
+	hs := raftpb.HardState{Term: plan.Term, Vote: md.hardState.Vote, Commit: plan.Index}
+	walsnap := walpb.Snapshot{Index: plan.Index, Term: plan.Term, ConfState: &cs}
+	if err = w.Save(hs, ents); err == nil {
+		err = w.SaveSnapshot(walsnap)
+	}
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	st2 := v2store.New(etcdserver.StoreClusterPrefix, etcdserver.StoreKeysPrefix)
+	if md.snapshot != nil {
+		if err = st2.Recovery(md.snapshot.Data); err != nil {
+			return fmt.Errorf("cannot recover v2store from snapshot: %v", err)
+		}
+	}
+	cl := membership.NewClusterFromMembers(lg, st.ClusterID, survivors)
+	cl.SetID(st.MemberID, st.ClusterID)
+	cl.SetStore(st2)
+	cl.PushMembershipToStorage()
+	for _, id := range removed {
+		if _, err = st2.Create(membership.RemovedMemberStoreKey(id), false, "", false, v2store.TTLOptionSet{ExpireTime: v2store.Permanent}); err != nil {
+			return err
+		}
+	}
+	if md.clusterVersion != "" {
+		if _, err = st2.Set(membership.StoreClusterVersionKey(), false, md.clusterVersion, v2store.TTLOptionSet{ExpireTime: v2store.Permanent}); err != nil {
+			return err
+		}
+	}
+	data, err := st2.Save()
+	if err != nil {
+		return err
+	}
+	// the snapshot is not used until the new WAL records it
+	if err = snap.New(lg, datadir.ToSnapDir(dataDir)).SaveSnap(raftpb.Snapshot{
+		Data: data,
+		Metadata: raftpb.SnapshotMetadata{
+			Index:     plan.Index,
+			Term:      plan.Term,
+			ConfState: cs,
+		},
+	}); err != nil {
+		return err
+	}
+
+	dbPath := datadir.ToBackendFileName(dataDir)
+	tmpDBPath := dbPath + ".recover"
+	if err = copyFile(dbPath, tmpDBPath); err != nil {
+		return err
+	}
+	be := backend.NewDefaultBackend(tmpDBPath)
+	cl = membership.NewClusterFromMembers(lg, st.ClusterID, survivors)
+	cl.SetID(st.MemberID, st.ClusterID)
+	cl.SetBackend(be)
+	cl.PushMembershipToStorage()
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	for _, id := range removed {
+		tx.UnsafePut(buckets.MembersRemoved, []byte(id.String()), []byte("removed"))
+	}
+	cindex.UnsafeUpdateConsistentIndex(tx, plan.Index, plan.Term)
+	tx.Unlock()
+	be.ForceCommit()
+	if err = be.Close(); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	backupDir := fmt.Sprintf("%s.%d.bak", walDir, now)
+	backupDBPath := fmt.Sprintf("%s.%d.bak", dbPath, now)
+	renames := [][2]string{
+		{walDir, backupDir},
+		{dbPath, backupDBPath},
+		{tmpWALDir, walDir},
+		{tmpDBPath, dbPath},
+	}
+	for i, r := range renames {
+		if err = os.Rename(r[0], r[1]); err == nil {
+			continue
+		}
+		// undo the renames done, so that the WAL and the backend are
+		// replaced together or not at all
+		for j := i - 1; j >= 0; j-- {
+			if rerr := os.Rename(renames[j][1], renames[j][0]); rerr != nil {
+				return fmt.Errorf("%v; cannot move %q back to %q: %v", err, renames[j][1], renames[j][0], rerr)
+			}
+		}
+		return err
+	}
+	plan.WALBackupDir, plan.BackendBackupPath = backupDir, backupDBPath
+	lg.Info(
+		"recovered member",
+		zap.String("cluster-id", st.ClusterID.String()),
+		zap.String("local-member-id", st.MemberID.String()),
+		zap.Uint64("index", plan.Index),
+		zap.Uint64("term", plan.Term),
+		zap.String("wal-backup-dir", backupDir),
+		zap.String("backend-backup-path", backupDBPath),
+	)
+	return nil
+}
+
+// copyFile copies the file at src to dst, replacing dst.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err == nil {
+		err = fileutil.Fsync(out)
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
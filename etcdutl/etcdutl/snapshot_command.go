@@ -42,6 +42,7 @@ var (
 	initialMmapSize     = backend.InitialMmapSize
 	markCompacted       bool
 	revisionBump        uint64
+	restoreFilter       snapshot.RestoreFilter
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -98,6 +99,11 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().Uint64Var(&initialMmapSize, "initial-memory-map-size", initialMmapSize, "Initial memory map size of the database in bytes. It uses the default value if not defined or defined to 0")
 	cmd.Flags().Uint64Var(&revisionBump, "bump-revision", 0, "How much to increase the latest revision after restore")
 	cmd.Flags().BoolVar(&markCompacted, "mark-compacted", false, "Mark the latest revision after restore as the point of scheduled compaction (required if --bump-revision > 0, disallowed otherwise)")
+	cmd.Flags().StringSliceVar(&restoreFilter.IncludePrefixes, "include-prefix", nil, "Restore only the keys with this prefix (can be repeated)")
+	cmd.Flags().StringVar(&restoreFilter.RewriteFromPrefix, "rewrite-prefix-from", "", "Prefix of the restored keys and role permissions to replace with --rewrite-prefix-to")
+	cmd.Flags().StringVar(&restoreFilter.RewriteToPrefix, "rewrite-prefix-to", "", "Prefix replacing --rewrite-prefix-from")
+	cmd.Flags().BoolVar(&restoreFilter.SkipAuth, "skip-auth", false, "Drop the users and roles, and disable authentication")
+	cmd.Flags().BoolVar(&restoreFilter.SkipLeases, "skip-leases", false, "Drop the leases, and restore their keys without lease")
 
 	cmd.MarkFlagRequired("data-dir")
 
@@ -120,9 +126,13 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 	printer.DBStatus(ds)
 }
 
-func snapshotRestoreCommandFunc(_ *cobra.Command, args []string) {
-	SnapshotRestoreCommandFunc(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
-		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, args)
+func snapshotRestoreCommandFunc(cmd *cobra.Command, args []string) {
+	if cmd.Flags().Changed("rewrite-prefix-to") && !cmd.Flags().Changed("rewrite-prefix-from") {
+		err := fmt.Errorf("--rewrite-prefix-from required if --rewrite-prefix-to is set")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	snapshotRestore(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
+		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, restoreFilter, args)
 }
 
 func SnapshotRestoreCommandFunc(restoreCluster string,
@@ -136,6 +146,22 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	revisionBump uint64,
 	markCompacted bool,
 	args []string) {
+	snapshotRestore(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
+		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, snapshot.RestoreFilter{}, args)
+}
+
+func snapshotRestore(restoreCluster string,
+	restoreClusterToken string,
+	restoreDataDir string,
+	restoreWalDir string,
+	restorePeerURLs string,
+	restoreName string,
+	skipHashCheck bool,
+	initialMmapSize uint64,
+	revisionBump uint64,
+	markCompacted bool,
+	filter snapshot.RestoreFilter,
+	args []string) {
 	if len(args) != 1 {
 		err := fmt.Errorf("snapshot restore requires exactly one argument")
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
@@ -171,6 +197,7 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		InitialMmapSize:     initialMmapSize,
 		RevisionBump:        revisionBump,
 		MarkCompacted:       markCompacted,
+		Filter:              filter,
 	}); err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
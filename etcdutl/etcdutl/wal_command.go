@@ -0,0 +1,267 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/datadir"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/wal"
+	"go.etcd.io/etcd/server/v3/wal/walpb"
+	"go.uber.org/zap"
+)
+
+var (
+	replayDataDir      string
+	replayWALDir       string
+	replaySnapshot     string
+	replayOutput       string
+	replayIndex        uint64
+	replayTime         string
+	replayPrintEntries bool
+)
+
+// NewWALCommand returns the cobra command for "wal".
+func NewWALCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wal <subcommand>",
+		Short: "Manages the write ahead log of a member",
+	}
+	cmd.AddCommand(NewWALReplayCommand())
+	return cmd
+}
+
+// NewWALReplayCommand returns the cobra command for "wal replay".
+func NewWALReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Rebuilds the backend of a member at a raft index from a snapshot and the WAL",
+		Long: `Copies a backend snapshot to the output file and applies to it the committed
+entries of the WAL of a member that follow the snapshot, up to the given raft
+index or time, as the member applied them. The WAL must still hold the entries
+that follow the snapshot.
+
+The time of an entry is the time its request was proposed, by the clock of the
+proposing member. Membership changes and the requests proposed by members not
+recording the time, such as 3.5 members, have no time and do not stop the
+replay.`,
+		Run: walReplayCommandFunc,
+	}
+	cmd.Flags().StringVar(&replayDataDir, "data-dir", "", "Required. Path to the data directory of the member.")
+	cmd.Flags().StringVar(&replayWALDir, "wal-dir", "", "Path to the WAL directory, if not in the data directory.")
+	cmd.Flags().StringVar(&replaySnapshot, "snapshot", "", "Required. Path to the backend snapshot to start from.")
+	cmd.Flags().StringVar(&replayOutput, "output", "", "Required. Path to the backend file to write.")
+	cmd.Flags().Uint64Var(&replayIndex, "index", 0, "Raft index of the last entry to apply, the commit index if 0.")
+	cmd.Flags().StringVar(&replayTime, "time", "", "Stops before the first entry proposed after this time, in RFC 3339 format.")
+	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	cmd.Flags().BoolVar(&replayPrintEntries, "print-entries", false, "Prints each applied entry as a JSON line.")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagRequired("snapshot")
+	cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func walReplayCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+	var onEntry func(ReplayedEntry)
+	if replayPrintEntries {
+		onEntry = func(e ReplayedEntry) {
+			b, err := json.Marshal(e)
+			if err != nil {
+				cobrautl.ExitWithError(cobrautl.ExitError, err)
+			}
+			fmt.Println(string(b))
+		}
+	}
+	var until time.Time
+	if replayTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, replayTime)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --time: %v", err))
+		}
+		until = t
+	}
+	r, err := ReplayWAL(GetLogger(), ReplayConfig{
+		DataDir:       replayDataDir,
+		WALDir:        replayWALDir,
+		Snapshot:      replaySnapshot,
+		SkipHashCheck: skipHashCheck,
+		Output:        replayOutput,
+		Index:         replayIndex,
+		Until:         until,
+		OnEntry:       onEntry,
+	})
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.Replay(r)
+}
+
+// ReplayReport is the result of the replay command.
+type ReplayReport struct {
+	// SnapshotIndex is the raft index of the snapshot.
+	SnapshotIndex uint64 `json:"snapshotIndex"`
+	// Index is the raft index of the last applied entry.
+	Index    uint64 `json:"index"`
+	Entries  int    `json:"entries"`
+	Revision int64  `json:"revision"`
+	Output   string `json:"output"`
+}
+
+// ReplayConfig configures ReplayWAL.
+type ReplayConfig struct {
+	DataDir string
+	// WALDir is the WAL directory, the one of DataDir if empty.
+	WALDir string
+	// Snapshot is the backend snapshot to start from.
+	Snapshot string
+	// SkipHashCheck accepts snapshots without the hash appended by
+	// snapshot save.
+	SkipHashCheck bool
+	// Output is the backend file to write.
+	Output string
+	// Index is the raft index of the last entry to apply, the commit index
+	// if 0.
+	Index uint64
+	// Until, if not zero, stops the replay before the first request
+	// proposed after it.
+	Until time.Time
+	// OnEntry, if not nil, is called with each entry before it is applied.
+	OnEntry func(ReplayedEntry)
+}
+
+// ReplayedEntry is the decoding of an applied entry. Request is set for
+// the requests of the v3 API, V2Request for those of the v2 API, and
+// ConfChange for membership changes.
+type ReplayedEntry struct {
+	Index      uint64                            `json:"index"`
+	Term       uint64                            `json:"term"`
+	Type       string                            `json:"type"`
+	Request    *etcdserverpb.InternalRaftRequest `json:"request,omitempty"`
+	V2Request  *etcdserverpb.Request             `json:"v2Request,omitempty"`
+	ConfChange *raftpb.ConfChange                `json:"confChange,omitempty"`
+}
+
+func decodeEntry(e raftpb.Entry) ReplayedEntry {
+	re := ReplayedEntry{Index: e.Index, Term: e.Term, Type: e.Type.String()}
+	switch {
+	case len(e.Data) == 0:
+	case e.Type == raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+		if cc.Unmarshal(e.Data) == nil {
+			re.ConfChange = &cc
+		}
+	default:
+		var r etcdserverpb.InternalRaftRequest
+		if pbutil.MaybeUnmarshal(&r, e.Data) {
+			re.Request = &r
+			break
+		}
+		var v2 etcdserverpb.Request
+		if v2.Unmarshal(e.Data) == nil {
+			re.V2Request = &v2
+		}
+	}
+	return re
+}
+
+// ReplayWAL copies the backend snapshot to the output and applies to it the
+// committed entries of the WAL that follow the snapshot, up to the index
+// and the time of the config.
+func ReplayWAL(lg *zap.Logger, cfg ReplayConfig) (ReplayReport, error) {
+	walDir, index := cfg.WALDir, cfg.Index
+	if walDir == "" {
+		walDir = datadir.ToWalDir(cfg.DataDir)
+	}
+	r := ReplayReport{Output: cfg.Output}
+	if _, err := os.Stat(cfg.Output); err == nil {
+		return r, fmt.Errorf("output file %q already exists", cfg.Output)
+	}
+	if err := snapshot.CopyAndVerifyDB(cfg.Snapshot, cfg.Output, cfg.SkipHashCheck); err != nil {
+		return r, err
+	}
+	rp, err := etcdserver.NewReplayer(lg, cfg.Output)
+	if err != nil {
+		return r, err
+	}
+	defer rp.Close()
+	r.SnapshotIndex = rp.ConsistentIndex()
+
+	// the WAL is read from its last snapshot record preceding the snapshot
+	walSnaps, err := wal.ValidSnapshotEntries(lg, walDir)
+	if err != nil {
+		return r, err
+	}
+	var walsnap *walpb.Snapshot
+	for i := range walSnaps {
+		if walSnaps[i].Index <= r.SnapshotIndex {
+			walsnap = &walSnaps[i]
+		}
+	}
+	if walsnap == nil {
+		return r, fmt.Errorf("the WAL does not hold the entries following index %d of the snapshot", r.SnapshotIndex)
+	}
+	w, err := wal.OpenForRead(lg, walDir, *walsnap)
+	if err != nil {
+		return r, err
+	}
+	defer w.Close()
+	_, hs, ents, err := w.ReadAll()
+	if err != nil {
+		return r, err
+	}
+	if index == 0 {
+		index = hs.Commit
+	}
+	if index > hs.Commit {
+		return r, fmt.Errorf("index %d is not committed, the commit index is %d", index, hs.Commit)
+	}
+	if index < r.SnapshotIndex {
+		return r, fmt.Errorf("index %d precedes index %d of the snapshot", index, r.SnapshotIndex)
+	}
+
+	for _, e := range ents {
+		if e.Index <= r.SnapshotIndex {
+			continue
+		}
+		if e.Index > index {
+			break
+		}
+		de := decodeEntry(e)
+		if !cfg.Until.IsZero() && de.Request != nil && de.Request.Header != nil &&
+			de.Request.Header.Timestamp > cfg.Until.UnixNano() {
+			break
+		}
+		if cfg.OnEntry != nil {
+			cfg.OnEntry(de)
+		}
+		if err = rp.Apply(e); err != nil {
+			return r, err
+		}
+		r.Entries++
+	}
+	r.Index, r.Revision = rp.ConsistentIndex(), rp.Revision()
+	return r, nil
+}
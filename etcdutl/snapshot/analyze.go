@@ -0,0 +1,359 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/lease/leasepb"
+	"go.etcd.io/etcd/server/v3/mvcc/buckets"
+)
+
+// revBytesLen is the length of a revision key in the key bucket; tombstone
+// keys have an extra mark byte appended.
+const revBytesLen = 8 + 1 + 8
+
+var finishedCompactKeyName = []byte("finishedCompactRev")
+
+// AnalyzeConfig configures the keyspace analysis.
+type AnalyzeConfig struct {
+	// PrefixDepth is the number of delimited key segments grouped into a prefix.
+	PrefixDepth int
+	// PrefixDelimiter separates the segments of a key.
+	PrefixDelimiter string
+	// Top limits the number of entries in each ranking. Zero means no limit.
+	Top int
+}
+
+// Analysis is the usage of the keyspace stored in a backend database file.
+type Analysis struct {
+	Revision        int64 `json:"revision"`
+	CompactRevision int64 `json:"compactRevision"`
+	// Keys is the number of keys that are not deleted.
+	Keys int `json:"keys"`
+	// Revisions is the number of revisions kept for all keys, including
+	// deletions not yet compacted.
+	Revisions int `json:"revisions"`
+
+	Prefixes      []PrefixUsage `json:"prefixes"`
+	LargestKeys   []KeyUsage    `json:"largestKeys"`
+	LargestValues []KeyUsage    `json:"largestValues"`
+	MostRevisions []KeyUsage    `json:"mostRevisions"`
+	Leases        []LeaseUsage  `json:"leases"`
+	Pages         PageUsage     `json:"pages"`
+}
+
+// PrefixUsage is the usage of the keys sharing a prefix.
+type PrefixUsage struct {
+	Prefix    string `json:"prefix"`
+	Keys      int    `json:"keys"`
+	Revisions int    `json:"revisions"`
+	// LiveBytes is the size of the current keys and values.
+	LiveBytes int64 `json:"liveBytes"`
+	// TotalBytes is the size of all revisions kept in the key bucket.
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// KeyUsage is the usage of a single key.
+type KeyUsage struct {
+	Key         string `json:"key"`
+	ModRevision int64  `json:"modRevision"`
+	KeySize     int    `json:"keySize"`
+	ValueSize   int    `json:"valueSize"`
+	Revisions   int    `json:"revisions"`
+}
+
+// LeaseUsage is the usage of the keys attached to a lease. ID zero stands
+// for the keys without a lease.
+type LeaseUsage struct {
+	ID    int64 `json:"id"`
+	TTL   int64 `json:"ttl"`
+	Keys  int   `json:"keys"`
+	Bytes int64 `json:"bytes"`
+}
+
+// PageUsage is the page usage of the database file.
+type PageUsage struct {
+	PageSize     int           `json:"pageSize"`
+	TotalPages   int64         `json:"totalPages"`
+	FreePages    int           `json:"freePages"`
+	PendingPages int           `json:"pendingPages"`
+	Buckets      []BucketUsage `json:"buckets"`
+}
+
+// BucketUsage is the page usage of a bucket.
+type BucketUsage struct {
+	Name  string `json:"name"`
+	Keys  int    `json:"keys"`
+	Pages int    `json:"pages"`
+	// InuseBytes is the number of bytes used by the pages of the bucket,
+	// the rest being fragmentation.
+	InuseBytes int `json:"inuseBytes"`
+}
+
+type keyStats struct {
+	key       string
+	modRev    int64
+	live      bool
+	keySize   int
+	valueSize int
+	lease     int64
+	revisions int
+	// totalBytes is the size of all revisions of the key in the key bucket.
+	totalBytes int64
+}
+
+// Analyze reports the usage of the keyspace stored in the given backend
+// database file without modifying it.
+func Analyze(dbPath string, cfg AnalyzeConfig) (a Analysis, err error) {
+	if _, err = os.Stat(dbPath); err != nil {
+		return a, err
+	}
+	if cfg.PrefixDepth < 1 {
+		return a, fmt.Errorf("prefix depth must be positive, got %d", cfg.PrefixDepth)
+	}
+
+	// read-only databases do not load the freelist unless asked to
+	db, err := bolt.Open(dbPath, 0400, &bolt.Options{ReadOnly: true, PreLoadFreelist: true})
+	if err != nil {
+		return a, err
+	}
+	defer db.Close()
+
+	err = db.View(func(tx *bolt.Tx) error {
+		keys, err := analyzeKeyBucket(tx, &a)
+		if err != nil {
+			return err
+		}
+		if err := analyzeLeaseBucket(tx, &a, keys, cfg.Top); err != nil {
+			return err
+		}
+		if err := analyzeMetaBucket(tx, &a); err != nil {
+			return err
+		}
+		analyzeKeys(&a, keys, cfg)
+
+		a.Pages.PageSize = db.Info().PageSize
+		a.Pages.TotalPages = tx.Size() / int64(a.Pages.PageSize)
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			st := b.Stats()
+			a.Pages.Buckets = append(a.Pages.Buckets, BucketUsage{
+				Name:       string(name),
+				Keys:       st.KeyN,
+				Pages:      st.BranchPageN + st.BranchOverflowN + st.LeafPageN + st.LeafOverflowN,
+				InuseBytes: st.BranchInuse + st.LeafInuse + st.InlineBucketInuse,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return a, err
+	}
+	st := db.Stats()
+	a.Pages.FreePages, a.Pages.PendingPages = st.FreePageN, st.PendingPageN
+	return a, nil
+}
+
+// analyzeKeyBucket walks all revisions of the key bucket in revision order.
+func analyzeKeyBucket(tx *bolt.Tx, a *Analysis) (map[string]*keyStats, error) {
+	keys := make(map[string]*keyStats)
+	b := tx.Bucket(buckets.Key.Name())
+	if b == nil {
+		return keys, nil
+	}
+	err := b.ForEach(func(k, v []byte) error {
+		if len(k) < revBytesLen {
+			return fmt.Errorf("invalid revision %x in key bucket", k)
+		}
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(v); err != nil {
+			return fmt.Errorf("cannot unmarshal revision %x: %v", k, err)
+		}
+		rev := bytesToRev(k[:revBytesLen])
+		a.Revision = rev.main
+		a.Revisions++
+
+		ks, ok := keys[string(kv.Key)]
+		if !ok {
+			ks = &keyStats{key: string(kv.Key)}
+			keys[ks.key] = ks
+		}
+		ks.revisions++
+		ks.totalBytes += int64(len(k) + len(v))
+		ks.modRev = rev.main
+		if len(k) > revBytesLen {
+			// tombstone
+			ks.live, ks.keySize, ks.valueSize, ks.lease = false, 0, 0, 0
+			return nil
+		}
+		ks.live, ks.keySize, ks.valueSize, ks.lease = true, len(kv.Key), len(kv.Value), kv.Lease
+		return nil
+	})
+	return keys, err
+}
+
+// analyzeLeaseBucket reports the keys attached to each lease, leases
+// without keys included.
+func analyzeLeaseBucket(tx *bolt.Tx, a *Analysis, keys map[string]*keyStats, top int) error {
+	leases := make(map[int64]*LeaseUsage)
+	if b := tx.Bucket(buckets.Lease.Name()); b != nil {
+		if err := b.ForEach(func(k, v []byte) error {
+			var l leasepb.Lease
+			if err := l.Unmarshal(v); err != nil {
+				return fmt.Errorf("cannot unmarshal lease %x: %v", k, err)
+			}
+			leases[l.ID] = &LeaseUsage{ID: l.ID, TTL: l.TTL}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	for _, ks := range keys {
+		if !ks.live {
+			continue
+		}
+		lu, ok := leases[ks.lease]
+		if !ok {
+			lu = &LeaseUsage{ID: ks.lease}
+			leases[ks.lease] = lu
+		}
+		lu.Keys++
+		lu.Bytes += int64(ks.keySize + ks.valueSize)
+	}
+	for _, lu := range leases {
+		a.Leases = append(a.Leases, *lu)
+	}
+	sort.Slice(a.Leases, func(i, j int) bool {
+		if a.Leases[i].Keys != a.Leases[j].Keys {
+			return a.Leases[i].Keys > a.Leases[j].Keys
+		}
+		return a.Leases[i].ID < a.Leases[j].ID
+	})
+	if top > 0 && len(a.Leases) > top {
+		a.Leases = a.Leases[:top]
+	}
+	return nil
+}
+
+func analyzeMetaBucket(tx *bolt.Tx, a *Analysis) error {
+	b := tx.Bucket(buckets.Meta.Name())
+	if b == nil {
+		return nil
+	}
+	if v := b.Get(finishedCompactKeyName); v != nil {
+		if len(v) != revBytesLen {
+			return fmt.Errorf("invalid compact revision %x", v)
+		}
+		a.CompactRevision = bytesToRev(v).main
+	}
+	return nil
+}
+
+// analyzeKeys aggregates the keys by prefix and ranks them by size and
+// number of revisions.
+func analyzeKeys(a *Analysis, keys map[string]*keyStats, cfg AnalyzeConfig) {
+	all := make([]*keyStats, 0, len(keys))
+	prefixes := make(map[string]*PrefixUsage)
+	for _, ks := range keys {
+		all = append(all, ks)
+		p := keyPrefix(ks.key, cfg.PrefixDelimiter, cfg.PrefixDepth)
+		pu, ok := prefixes[p]
+		if !ok {
+			pu = &PrefixUsage{Prefix: p}
+			prefixes[p] = pu
+		}
+		pu.Revisions += ks.revisions
+		pu.TotalBytes += ks.totalBytes
+		if ks.live {
+			a.Keys++
+			pu.Keys++
+			pu.LiveBytes += int64(ks.keySize + ks.valueSize)
+		}
+	}
+
+	for _, pu := range prefixes {
+		a.Prefixes = append(a.Prefixes, *pu)
+	}
+	sort.Slice(a.Prefixes, func(i, j int) bool {
+		if a.Prefixes[i].TotalBytes != a.Prefixes[j].TotalBytes {
+			return a.Prefixes[i].TotalBytes > a.Prefixes[j].TotalBytes
+		}
+		return a.Prefixes[i].Prefix < a.Prefixes[j].Prefix
+	})
+	if cfg.Top > 0 && len(a.Prefixes) > cfg.Top {
+		a.Prefixes = a.Prefixes[:cfg.Top]
+	}
+
+	// rank the current keys by size; deleted keys take no space once compacted
+	live := make([]*keyStats, 0, a.Keys)
+	for _, ks := range all {
+		if ks.live {
+			live = append(live, ks)
+		}
+	}
+	a.LargestKeys = rankKeys(live, cfg.Top, func(ks *keyStats) int { return ks.keySize })
+	a.LargestValues = rankKeys(live, cfg.Top, func(ks *keyStats) int { return ks.valueSize })
+	a.MostRevisions = rankKeys(all, cfg.Top, func(ks *keyStats) int { return ks.revisions })
+}
+
+// rankKeys returns the top keys by the given measure, ties ordered by key.
+func rankKeys(keys []*keyStats, top int, measure func(*keyStats) int) []KeyUsage {
+	sort.Slice(keys, func(i, j int) bool {
+		mi, mj := measure(keys[i]), measure(keys[j])
+		if mi != mj {
+			return mi > mj
+		}
+		return keys[i].key < keys[j].key
+	})
+	if top > 0 && len(keys) > top {
+		keys = keys[:top]
+	}
+	us := make([]KeyUsage, 0, len(keys))
+	for _, ks := range keys {
+		us = append(us, KeyUsage{
+			Key:         ks.key,
+			ModRevision: ks.modRev,
+			KeySize:     ks.keySize,
+			ValueSize:   ks.valueSize,
+			Revisions:   ks.revisions,
+		})
+	}
+	return us
+}
+
+// keyPrefix returns the key up to and including its depth-th delimiter,
+// ignoring a leading delimiter, or the whole key if it has fewer segments.
+func keyPrefix(key, delim string, depth int) string {
+	if delim == "" {
+		return key
+	}
+	start := 0
+	if strings.HasPrefix(key, delim) {
+		start = len(delim)
+	}
+	for i := 0; i < depth; i++ {
+		idx := strings.Index(key[start:], delim)
+		if idx < 0 {
+			return key
+		}
+		start += idx + len(delim)
+	}
+	return key[:start]
+}
@@ -0,0 +1,318 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+	"go.etcd.io/etcd/server/v3/mvcc/buckets"
+	"go.uber.org/zap"
+)
+
+// filterChunkKeys is the number of revisions filtered per range of the key bucket.
+const filterChunkKeys = 10000
+
+// RestoreFilter selects and rewrites the data of a restored snapshot.
+// The zero value restores the snapshot as-is.
+type RestoreFilter struct {
+	// IncludePrefixes restricts the restored keys to those with one of the
+	// prefixes. Leases no restored key is bound to are dropped as well.
+	// If empty, all keys are restored.
+	IncludePrefixes []string
+
+	// RewriteFromPrefix is replaced with RewriteToPrefix in the restored keys
+	// and in the key permissions of the restored roles. Other restored keys
+	// must not have RewriteToPrefix, and the permissions must not cross
+	// RewriteFromPrefix. Keys are not rewritten if both are equal.
+	RewriteFromPrefix string
+	RewriteToPrefix   string
+
+	// SkipAuth drops the users and roles, and disables authentication.
+	SkipAuth bool
+	// SkipLeases drops the leases, and detaches the restored keys from them.
+	SkipLeases bool
+}
+
+func (f RestoreFilter) empty() bool {
+	return len(f.IncludePrefixes) == 0 && !f.rewrite() && !f.SkipAuth && !f.SkipLeases
+}
+
+func (f RestoreFilter) rewrite() bool {
+	return f.RewriteFromPrefix != f.RewriteToPrefix
+}
+
+func (f RestoreFilter) include(key []byte) bool {
+	if len(f.IncludePrefixes) == 0 {
+		return true
+	}
+	for _, p := range f.IncludePrefixes {
+		if bytes.HasPrefix(key, []byte(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteKey returns key with RewriteFromPrefix replaced, and whether it
+// was rewritten.
+func (f RestoreFilter) rewriteKey(key []byte) ([]byte, bool) {
+	if !f.rewrite() || !bytes.HasPrefix(key, []byte(f.RewriteFromPrefix)) {
+		return key, false
+	}
+	return append([]byte(f.RewriteToPrefix), key[len(f.RewriteFromPrefix):]...), true
+}
+
+// rewriteRange rewrites a permission range within RewriteFromPrefix. It
+// fails for a range with keys both within and outside of the prefix, which
+// cannot be rewritten to the same keys.
+func (f RestoreFilter) rewriteRange(key, end []byte) ([]byte, []byte, error) {
+	from := []byte(f.RewriteFromPrefix)
+	nkey, ok := f.rewriteKey(key)
+	if !ok {
+		// the range starts before the prefix and ends within it
+		if f.rewrite() && len(end) > 0 && bytes.HasPrefix(end, from) && !bytes.Equal(end, from) {
+			return nil, nil, fmt.Errorf("range [%q, %q) crosses the rewritten prefix %q", key, end, from)
+		}
+		return key, end, nil
+	}
+	switch {
+	case len(end) == 0:
+		return nkey, end, nil
+	case bytes.Equal(end, prefixRangeEnd(key)):
+		return nkey, prefixRangeEnd(nkey), nil
+	case bytes.Equal(end, prefixRangeEnd(from)):
+		return nkey, prefixRangeEnd([]byte(f.RewriteToPrefix)), nil
+	}
+	nend, ok := f.rewriteKey(end)
+	if !ok {
+		// the range starts within the prefix and ends after it, or has no end
+		return nil, nil, fmt.Errorf("range [%q, %q) crosses the rewritten prefix %q", key, end, from)
+	}
+	return nkey, nend, nil
+}
+
+// prefixRangeEnd should be synced with clientv3.GetPrefixRangeEnd.
+func prefixRangeEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i] = end[i] + 1
+			return end[:i+1]
+		}
+	}
+	// next prefix does not exist (e.g., 0xffff);
+	// default to WithFromKey policy
+	return []byte{0}
+}
+
+// filterDB applies the filter to the restored database. Revisions of the
+// dropped keys are removed from the history, so the current revision of
+// the restored member is the latest revision of the restored keys.
+func (s *v3Manager) filterDB(f RestoreFilter) error {
+	be := backend.NewDefaultBackend(s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
+	defer func() {
+		be.ForceCommit()
+		be.Close()
+	}()
+
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+
+	// the roles are rewritten first, since they may fail to be rewritten
+	// before any key is changed
+	if f.rewrite() && !f.SkipAuth {
+		if err := s.unsafeRewriteRoles(tx, f); err != nil {
+			return err
+		}
+	}
+	leases, err := s.unsafeFilterKeys(tx, f)
+	if err != nil {
+		return err
+	}
+	switch {
+	case f.SkipLeases:
+		s.lg.Info("dropping leases")
+		tx.UnsafeDeleteBucket(buckets.Lease)
+	case len(f.IncludePrefixes) > 0:
+		if err = s.unsafeFilterLeases(tx, leases); err != nil {
+			return err
+		}
+	}
+	if f.SkipAuth {
+		s.lg.Info("dropping auth data")
+		tx.UnsafeDeleteBucket(buckets.Auth)
+		tx.UnsafeDeleteBucket(buckets.AuthUsers)
+		tx.UnsafeDeleteBucket(buckets.AuthRoles)
+		tx.UnsafeDeleteBucket(buckets.AuthSessions)
+		tx.UnsafeDeleteBucket(buckets.AuthSessionRevocations)
+	}
+	return nil
+}
+
+// unsafeForEachRevision calls fn with the revisions of the key bucket, in
+// chunks of filterChunkKeys so that fn may modify the bucket.
+func unsafeForEachRevision(tx backend.ReadTx, fn func(rev []byte, kv *mvccpb.KeyValue) error) error {
+	min, max := make([]byte, 17), make([]byte, 17)
+	revToBytes(min, revision{main: 1})
+	revToBytes(max, revision{main: math.MaxInt64, sub: math.MaxInt64})
+
+	for {
+		keys, vals := tx.UnsafeRange(buckets.Key, min, max, filterChunkKeys)
+		if len(keys) == 0 {
+			return nil
+		}
+		// next range begins after where this one ended
+		next := bytesToRev(keys[len(keys)-1])
+		next.sub++
+
+		for i, k := range keys {
+			kv := &mvccpb.KeyValue{}
+			if err := kv.Unmarshal(vals[i]); err != nil {
+				return fmt.Errorf("cannot unmarshal the key at revision %v: %v", bytesToRev(k), err)
+			}
+			if err := fn(k, kv); err != nil {
+				return err
+			}
+		}
+
+		if len(keys) < filterChunkKeys {
+			return nil
+		}
+		revToBytes(min, next)
+	}
+}
+
+// unsafeFilterKeys drops and rewrites the revisions of the key bucket, and
+// returns the leases the restored keys are bound to. The collisions of the
+// rewritten keys are checked before any revision is changed.
+func (s *v3Manager) unsafeFilterKeys(tx backend.BatchTx, f RestoreFilter) (map[int64]struct{}, error) {
+	if f.rewrite() {
+		err := unsafeForEachRevision(tx, func(_ []byte, kv *mvccpb.KeyValue) error {
+			if _, ok := f.rewriteKey(kv.Key); !ok && f.include(kv.Key) && bytes.HasPrefix(kv.Key, []byte(f.RewriteToPrefix)) {
+				return fmt.Errorf("key %q would collide with the keys rewritten to prefix %q", kv.Key, f.RewriteToPrefix)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	leases := make(map[int64]struct{})
+	var kept, dropped, rewritten int
+	err := unsafeForEachRevision(tx, func(k []byte, kv *mvccpb.KeyValue) error {
+		if !f.include(kv.Key) {
+			tx.UnsafeDelete(buckets.Key, k)
+			dropped++
+			return nil
+		}
+		kept++
+
+		changed := false
+		if nkey, ok := f.rewriteKey(kv.Key); ok {
+			kv.Key, changed = nkey, true
+			rewritten++
+		}
+		if kv.Lease != 0 {
+			if f.SkipLeases {
+				kv.Lease, changed = 0, true
+			} else {
+				leases[kv.Lease] = struct{}{}
+			}
+		}
+		if !changed {
+			return nil
+		}
+		v, err := kv.Marshal()
+		if err != nil {
+			return err
+		}
+		tx.UnsafePut(buckets.Key, k, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.lg.Info(
+		"filtered keys",
+		zap.Strings("include-prefixes", f.IncludePrefixes),
+		zap.String("rewrite-from-prefix", f.RewriteFromPrefix),
+		zap.String("rewrite-to-prefix", f.RewriteToPrefix),
+		zap.Int("kept-revisions", kept),
+		zap.Int("dropped-revisions", dropped),
+		zap.Int("rewritten-revisions", rewritten),
+	)
+	return leases, nil
+}
+
+// unsafeFilterLeases drops the leases that are not in keep.
+func (s *v3Manager) unsafeFilterLeases(tx backend.BatchTx, keep map[int64]struct{}) error {
+	var drop [][]byte
+	err := tx.UnsafeForEach(buckets.Lease, func(k, _ []byte) error {
+		if _, ok := keep[int64(binary.BigEndian.Uint64(k))]; !ok {
+			drop = append(drop, append([]byte{}, k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range drop {
+		tx.UnsafeDelete(buckets.Lease, k)
+	}
+	s.lg.Info("filtered leases", zap.Int("kept-leases", len(keep)), zap.Int("dropped-leases", len(drop)))
+	return nil
+}
+
+// unsafeRewriteRoles rewrites the key permissions of the roles. No role is
+// changed if a permission cannot be rewritten.
+func (s *v3Manager) unsafeRewriteRoles(tx backend.BatchTx, f RestoreFilter) error {
+	var roles []*authpb.Role
+	err := tx.UnsafeForEach(buckets.AuthRoles, func(_, v []byte) error {
+		role := &authpb.Role{}
+		if err := role.Unmarshal(v); err != nil {
+			return err
+		}
+		roles = append(roles, role)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		for _, perm := range role.KeyPermission {
+			if perm.Key, perm.RangeEnd, err = f.rewriteRange(perm.Key, perm.RangeEnd); err != nil {
+				return fmt.Errorf("cannot rewrite a permission of role %q: %v", role.Name, err)
+			}
+		}
+	}
+	for _, role := range roles {
+		v, err := role.Marshal()
+		if err != nil {
+			return err
+		}
+		tx.UnsafePut(buckets.AuthRoles, role.Name, v)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"testing"
+)
+
+func TestRestoreFilterInclude(t *testing.T) {
+	tests := []struct {
+		prefixes []string
+		key      string
+		want     bool
+	}{
+		{nil, "/a/x", true},
+		{[]string{"/a/"}, "/a/x", true},
+		{[]string{"/a/"}, "/a/", true},
+		{[]string{"/a/"}, "/a", false},
+		{[]string{"/a/"}, "/b/x", false},
+		{[]string{"/a/", "/b/"}, "/b/x", true},
+		{[]string{""}, "/b/x", true},
+	}
+	for i, tt := range tests {
+		f := RestoreFilter{IncludePrefixes: tt.prefixes}
+		if got := f.include([]byte(tt.key)); got != tt.want {
+			t.Errorf("#%d: include(%q) = %v, want %v", i, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestRestoreFilterRewriteRange(t *testing.T) {
+	f := RestoreFilter{RewriteFromPrefix: "/a/", RewriteToPrefix: "/b/"}
+	tests := []struct {
+		key, end         string
+		wantKey, wantEnd string
+		wantErr          bool
+	}{
+		// single keys
+		{key: "/a/x", wantKey: "/b/x"},
+		{key: "/c/x", wantKey: "/c/x"},
+		// prefixes within the rewritten prefix
+		{key: "/a/x", end: "/a/y", wantKey: "/b/x", wantEnd: "/b/y"},
+		{key: "/a/", end: "/a0", wantKey: "/b/", wantEnd: "/b0"},
+		// ranges within the rewritten prefix
+		{key: "/a/x", end: "/a/z", wantKey: "/b/x", wantEnd: "/b/z"},
+		{key: "/a/x", end: "/a0", wantKey: "/b/x", wantEnd: "/b0"},
+		// ranges outside of the rewritten prefix
+		{key: "/c/", end: "/c0", wantKey: "/c/", wantEnd: "/c0"},
+		{key: "/", end: "/a/", wantKey: "/", wantEnd: "/a/"},
+		{key: "\x00", end: "\x00", wantKey: "\x00", wantEnd: "\x00"},
+		// ranges crossing the rewritten prefix
+		{key: "/a/x", end: "/c", wantErr: true},
+		{key: "/a/x", end: "\x00", wantErr: true},
+		{key: "/", end: "/a/x", wantErr: true},
+	}
+	for i, tt := range tests {
+		key, end, err := f.rewriteRange([]byte(tt.key), []byte(tt.end))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("#%d: rewriteRange(%q, %q) error = %v, want error %v", i, tt.key, tt.end, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && (string(key) != tt.wantKey || string(end) != tt.wantEnd) {
+			t.Errorf("#%d: rewriteRange(%q, %q) = (%q, %q), want (%q, %q)", i, tt.key, tt.end, key, end, tt.wantKey, tt.wantEnd)
+		}
+	}
+}
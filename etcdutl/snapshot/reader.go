@@ -0,0 +1,149 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/mvcc/buckets"
+)
+
+// KeyValueIterator iterates over the key-values with a given prefix stored
+// in a snapshot file as of a revision, in key order. Opening the iterator
+// indexes the revision of every key; the key-values themselves are read
+// from the snapshot as the iterator advances.
+type KeyValueIterator struct {
+	db  *bolt.DB
+	tx  *bolt.Tx
+	b   *bolt.Bucket
+	rev int64
+	// revs holds the revision of the latest version of each key, in key
+	// order.
+	revs []keyRevision
+}
+
+type keyRevision struct {
+	key string
+	rev []byte
+}
+
+// OpenKeyValues opens an iterator over the key-values with the given prefix
+// stored in a snapshot file as of the given revision. A revision of 0 reads
+// the latest revision. The iterator must be closed.
+func OpenKeyValues(dbPath string, prefix []byte, rev int64) (*KeyValueIterator, error) {
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(dbPath, 0400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(false)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	it := &KeyValueIterator{db: db, tx: tx}
+	if err = it.index(dbPath, prefix, rev); err != nil {
+		it.Close()
+		return nil, err
+	}
+	return it, nil
+}
+
+func (it *KeyValueIterator) index(dbPath string, prefix []byte, rev int64) error {
+	var compactRev int64
+	if b := it.tx.Bucket(buckets.Meta.Name()); b != nil {
+		if v := b.Get(finishedCompactKeyName); len(v) == revBytesLen {
+			compactRev = bytesToRev(v).main
+		}
+	}
+	it.b = it.tx.Bucket(buckets.Key.Name())
+	if it.b == nil {
+		return fmt.Errorf("snapshot %q has no key bucket", dbPath)
+	}
+	var currentRev int64
+	if k, _ := it.b.Cursor().Last(); k != nil {
+		currentRev = bytesToRev(k[:revBytesLen]).main
+	}
+	if rev == 0 {
+		rev = currentRev
+	}
+	if rev > currentRev {
+		return fmt.Errorf("required revision %d is a future revision of snapshot revision %d", rev, currentRev)
+	}
+	if rev < compactRev {
+		return fmt.Errorf("required revision %d has been compacted at revision %d", rev, compactRev)
+	}
+	it.rev = rev
+
+	// the key bucket is ordered by revision, the latest revision of each
+	// key is kept; the buffers of the bucket are valid until the iterator
+	// is closed
+	latest := make(map[string][]byte)
+	c := it.b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if bytesToRev(k[:revBytesLen]).main > rev {
+			break
+		}
+		kv := &mvccpb.KeyValue{}
+		if err := kv.Unmarshal(v); err != nil {
+			return fmt.Errorf("cannot unmarshal revision %x: %v", k, err)
+		}
+		if !bytes.HasPrefix(kv.Key, prefix) {
+			continue
+		}
+		if len(k) > revBytesLen {
+			// tombstone
+			delete(latest, string(kv.Key))
+			continue
+		}
+		latest[string(kv.Key)] = k
+	}
+	it.revs = make([]keyRevision, 0, len(latest))
+	for key, k := range latest {
+		it.revs = append(it.revs, keyRevision{key: key, rev: k})
+	}
+	sort.Slice(it.revs, func(i, j int) bool { return it.revs[i].key < it.revs[j].key })
+	return nil
+}
+
+// Rev returns the revision read.
+func (it *KeyValueIterator) Rev() int64 { return it.rev }
+
+// Next returns the next key-value, or nil at the end of the iteration.
+func (it *KeyValueIterator) Next() (*mvccpb.KeyValue, error) {
+	if len(it.revs) == 0 {
+		return nil, nil
+	}
+	kr := it.revs[0]
+	it.revs = it.revs[1:]
+	kv := &mvccpb.KeyValue{}
+	if err := kv.Unmarshal(it.b.Get(kr.rev)); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal revision %x: %v", kr.rev, err)
+	}
+	return kv, nil
+}
+
+// Close releases the snapshot file.
+func (it *KeyValueIterator) Close() error {
+	it.tx.Rollback()
+	return it.db.Close()
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/mvcc"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestKeyValueIterator(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "db")
+	b := backend.NewDefaultBackend(dbPath)
+	s := mvcc.NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	s.Put([]byte("/a/2"), []byte("v1"), lease.NoLease) // rev 2
+	s.Put([]byte("/a/1"), []byte("v1"), lease.NoLease) // rev 3
+	s.Put([]byte("/b/1"), []byte("v1"), lease.NoLease) // rev 4
+	s.Put([]byte("/a/2"), []byte("v2"), lease.NoLease) // rev 5
+	s.DeleteRange([]byte("/a/1"), nil)                 // rev 6
+	s.Put([]byte("/a/3"), []byte("v1"), lease.NoLease) // rev 7
+	s.Close()
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		rev int64
+
+		wrev int64
+		wkvs []string
+	}{
+		{rev: 0, wrev: 7, wkvs: []string{"/a/2=v2", "/a/3=v1"}},
+		{rev: 5, wrev: 5, wkvs: []string{"/a/1=v1", "/a/2=v2"}},
+		{rev: 3, wrev: 3, wkvs: []string{"/a/1=v1", "/a/2=v1"}},
+	}
+	for _, tt := range tests {
+		it, err := OpenKeyValues(dbPath, []byte("/a/"), tt.rev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var kvs []string
+		for {
+			kv, err := it.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if kv == nil {
+				break
+			}
+			kvs = append(kvs, string(kv.Key)+"="+string(kv.Value))
+		}
+		if err = it.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if it.Rev() != tt.wrev || !reflect.DeepEqual(kvs, tt.wkvs) {
+			t.Errorf("rev %d: expected %v at %d, got %v at %d", tt.rev, tt.wkvs, tt.wrev, kvs, it.Rev())
+		}
+	}
+
+	if _, err := OpenKeyValues(dbPath, nil, 8); err == nil {
+		t.Error("expected an error reading a future revision")
+	}
+}
@@ -210,6 +210,9 @@ type RestoreConfig struct {
 	// MarkCompacted is "true" to mark the latest revision as compacted.
 	// (required if RevisionBump > 0)
 	MarkCompacted bool
+
+	// Filter selects and rewrites the restored keys, leases and auth data.
+	Filter RestoreFilter
 }
 
 // Restore restores a new etcd data directory from given snapshot file.
@@ -275,6 +278,12 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 		return err
 	}
 
+	if !cfg.Filter.empty() {
+		if err = s.filterDB(cfg.Filter); err != nil {
+			return err
+		}
+	}
+
 	if cfg.MarkCompacted && cfg.RevisionBump > 0 {
 		if err = s.modifyLatestRevision(cfg.RevisionBump); err != nil {
 			return err
@@ -393,7 +402,23 @@ func (s *v3Manager) unsafeGetLatestRevision(tx backend.BatchTx) (revision, error
 }
 
 func (s *v3Manager) copyAndVerifyDB() error {
-	srcf, ferr := os.Open(s.srcDbPath)
+	if err := fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
+		return err
+	}
+	if err := CopyAndVerifyDB(s.srcDbPath, s.outDbPath(), s.skipHashCheck); err != nil {
+		return err
+	}
+
+	// db hash is OK, can now modify DB so it can be part of a new cluster
+
+	return nil
+}
+
+// CopyAndVerifyDB copies the backend snapshot at srcPath to dstPath without
+// the sha256 hash appended by snapshot save, and checks the copy against the
+// hash unless skipHashCheck is set, in which case the hash is optional.
+func CopyAndVerifyDB(srcPath, dstPath string, skipHashCheck bool) error {
+	srcf, ferr := os.Open(srcPath)
 	if ferr != nil {
 		return ferr
 	}
@@ -411,13 +436,7 @@ func (s *v3Manager) copyAndVerifyDB() error {
 		return err
 	}
 
-	if err := fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
-		return err
-	}
-
-	outDbPath := s.outDbPath()
-
-	db, dberr := os.OpenFile(outDbPath, os.O_RDWR|os.O_CREATE, 0600)
+	db, dberr := os.OpenFile(dstPath, os.O_RDWR|os.O_CREATE, 0600)
 	if dberr != nil {
 		return dberr
 	}
@@ -439,11 +458,11 @@ func (s *v3Manager) copyAndVerifyDB() error {
 		}
 	}
 
-	if !hasHash && !s.skipHashCheck {
+	if !hasHash && !skipHashCheck {
 		return fmt.Errorf("snapshot missing hash but --skip-hash-check=false")
 	}
 
-	if hasHash && !s.skipHashCheck {
+	if hasHash && !skipHashCheck {
 		// check for match
 		if _, err := db.Seek(0, io.SeekStart); err != nil {
 			return err
@@ -457,9 +476,6 @@ func (s *v3Manager) copyAndVerifyDB() error {
 			return fmt.Errorf("expected sha256 %v, got %v", sha, dbsha)
 		}
 	}
-
-	// db hash is OK, can now modify DB so it can be part of a new cluster
-
 	return nil
 }
 
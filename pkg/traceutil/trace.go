@@ -140,6 +140,40 @@ func (t *Trace) AddField(fields ...Field) {
 	}
 }
 
+// Fields returns the fields recorded for the whole trace.
+func (t *Trace) Fields() []Field {
+	return t.fields
+}
+
+// VisitSteps calls f for each step in the trace, in order, with the time the
+// step was recorded. The fields passed to f include the common fields of the
+// subtrace the step belongs to.
+func (t *Trace) VisitSteps(f func(at time.Time, msg string, fields []Field)) {
+	for i, step := range t.steps {
+		if step.isSubTraceStart || step.isSubTraceEnd {
+			continue
+		}
+		fields := step.fields
+		for j := i - 1; j >= 0; j-- {
+			if t.steps[j].isSubTraceStart {
+				fields = append(append([]Field{}, t.steps[j].fields...), fields...)
+			}
+			if t.steps[j].isSubTraceStart || t.steps[j].isSubTraceEnd {
+				break
+			}
+		}
+		for j := i + 1; j < len(t.steps); j++ {
+			if t.steps[j].isSubTraceEnd {
+				fields = append(append([]Field{}, t.steps[j].fields...), fields...)
+			}
+			if t.steps[j].isSubTraceStart || t.steps[j].isSubTraceEnd {
+				break
+			}
+		}
+		f(step.time, step.msg, fields)
+	}
+}
+
 func (t *Trace) IsEmpty() bool {
 	return t.isEmpty
 }
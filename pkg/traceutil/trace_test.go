@@ -305,3 +305,34 @@ func TestLogIfLong(t *testing.T) {
 		})
 	}
 }
+
+func TestVisitSteps(t *testing.T) {
+	trace := New("test", nil)
+	trace.Step("step1", Field{Key: "f1", Value: "v1"})
+	trace.StartSubTrace(Field{Key: "sub", Value: "start"})
+	trace.Step("step2")
+	trace.StopSubTrace(Field{Key: "sub", Value: "end"})
+	trace.Step("step3")
+
+	type visited struct {
+		msg    string
+		fields []Field
+	}
+	var got []visited
+	trace.VisitSteps(func(_ time.Time, msg string, fields []Field) {
+		got = append(got, visited{msg: msg, fields: fields})
+	})
+	want := []visited{
+		{msg: "step1", fields: []Field{{Key: "f1", Value: "v1"}}},
+		{msg: "step2", fields: []Field{{Key: "sub", Value: "end"}, {Key: "sub", Value: "start"}}},
+		{msg: "step3"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d steps, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i].msg != want[i].msg || fmt.Sprint(got[i].fields) != fmt.Sprint(want[i].fields) {
+			t.Errorf("step %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
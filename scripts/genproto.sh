@@ -33,7 +33,7 @@ echo "  - grpc-gateway-root:       ${GRPC_GATEWAY_ROOT}"
 GOGOPROTO_PATH="${GOGOPROTO_ROOT}:${GOGOPROTO_ROOT}/protobuf"
 
 # directories containing protos to be built
-DIRS="./server/wal/walpb ./api/etcdserverpb ./server/etcdserver/api/snap/snappb ./raft/raftpb ./api/mvccpb ./server/lease/leasepb ./api/authpb ./server/etcdserver/api/v3lock/v3lockpb ./server/etcdserver/api/v3election/v3electionpb ./api/membershippb"
+DIRS="./server/wal/walpb ./api/etcdserverpb ./server/etcdserver/api/snap/snappb ./raft/raftpb ./api/mvccpb ./server/lease/leasepb ./api/authpb ./server/etcdserver/api/v3lock/v3lockpb ./server/etcdserver/api/v3election/v3electionpb ./server/etcdserver/api/v3semaphore/v3semaphorepb ./api/membershippb ./etcdctl/ctlv3/exportpb"
 
 log_callout -e "\\nRunning gofast (gogo) proto generation..."
 
@@ -55,7 +55,7 @@ log_callout -e "\\nRunning swagger & grpc_gateway proto generation..."
 
 # remove old swagger files so it's obvious whether the files fail to generate
 rm -rf Documentation/dev-guide/apispec/swagger/*json
-for pb in api/etcdserverpb/rpc server/etcdserver/api/v3lock/v3lockpb/v3lock server/etcdserver/api/v3election/v3electionpb/v3election; do
+for pb in api/etcdserverpb/rpc server/etcdserver/api/v3lock/v3lockpb/v3lock server/etcdserver/api/v3election/v3electionpb/v3election server/etcdserver/api/v3semaphore/v3semaphorepb/v3semaphore; do
   log_callout "grpc & swagger for: ${pb}.proto"
   run protoc -I. \
       -I"${GRPC_GATEWAY_ROOT}"/third_party/googleapis \
@@ -103,7 +103,7 @@ if [ "$1" != "--skip-protodoc" ]; then
     --disclaimer="This is a generated documentation. Please read the proto files for more." || exit 2
 
   run rm -rf Documentation/dev-guide/api_concurrency_reference_v3.md
-  run_go_tool go.etcd.io/protodoc --directories="server/etcdserver/api/v3lock/v3lockpb=service_message,server/etcdserver/api/v3election/v3electionpb=service_message,api/mvccpb=service_message" \
+  run_go_tool go.etcd.io/protodoc --directories="server/etcdserver/api/v3lock/v3lockpb=service_message,server/etcdserver/api/v3election/v3electionpb=service_message,server/etcdserver/api/v3semaphore/v3semaphorepb=service_message,api/mvccpb=service_message" \
     --title="etcd concurrency API Reference" \
     --output="Documentation/dev-guide/api_concurrency_reference_v3.md" \
     --disclaimer="This is a generated documentation. Please read the proto files for more." || exit 2
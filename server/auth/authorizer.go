@@ -0,0 +1,219 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.uber.org/zap"
+)
+
+// Verb is the access of a request to a key range.
+type Verb string
+
+const (
+	VerbRead  Verb = "read"
+	VerbWrite Verb = "write"
+)
+
+// Attributes describe the access of a request to a key range.
+type Attributes struct {
+	// User is the name of the user of the request.
+	User string `json:"user"`
+	// Groups are the roles of the user, stored in etcd or granted by its
+	// auth token or its client certificate.
+	Groups []string `json:"groups,omitempty"`
+	Verb   Verb     `json:"verb"`
+	// Key and RangeEnd are the key range [Key, RangeEnd), the single key
+	// Key if RangeEnd is empty.
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+}
+
+// Decision is the decision of an Authorizer on the attributes of a request.
+type Decision int
+
+const (
+	// DecisionNoOpinion leaves the decision to the roles of the user.
+	DecisionNoOpinion Decision = iota
+	DecisionAllow
+	DecisionDeny
+)
+
+func (d Decision) String() string {
+	switch d {
+	case DecisionAllow:
+		return "allow"
+	case DecisionDeny:
+		return "deny"
+	default:
+		return "no-opinion"
+	}
+}
+
+// Authorizer authorizes the requests on the key space in place of, or in
+// addition to, the permissions of the roles of the users.
+type Authorizer interface {
+	// Authorize returns its decision on attrs, and the duration for which the
+	// decision may be cached. A decision is not cached if ttl is zero.
+	Authorize(ctx context.Context, attrs Attributes) (d Decision, ttl time.Duration, err error)
+}
+
+// maxAuthorizerCacheEntries bounds the number of decisions cached from the
+// authorizer.
+const maxAuthorizerCacheEntries = 4096
+
+// authorizerCacheKey identifies the attributes of a cached decision. Its
+// fields are compared as a whole, so that the separators allowed in keys and
+// role names cannot make different attributes collide.
+type authorizerCacheKey struct {
+	verb     Verb
+	user     string
+	groups   string
+	key      string
+	rangeEnd string
+}
+
+func newAuthorizerCacheKey(attrs Attributes) authorizerCacheKey {
+	var groups strings.Builder
+	for _, g := range attrs.Groups {
+		groups.WriteString(strconv.Itoa(len(g)))
+		groups.WriteByte(':')
+		groups.WriteString(g)
+	}
+	return authorizerCacheKey{
+		verb:     attrs.Verb,
+		user:     attrs.User,
+		groups:   groups.String(),
+		key:      string(attrs.Key),
+		rangeEnd: string(attrs.RangeEnd),
+	}
+}
+
+type authorizerCacheEntry struct {
+	decision Decision
+	expires  time.Time
+}
+
+// authorizerCache caches the decisions of an Authorizer for their ttl.
+type authorizerCache struct {
+	authorizer Authorizer
+
+	mu      sync.Mutex
+	entries map[authorizerCacheKey]authorizerCacheEntry
+}
+
+func newAuthorizerCache(a Authorizer) *authorizerCache {
+	return &authorizerCache{authorizer: a, entries: make(map[authorizerCacheKey]authorizerCacheEntry)}
+}
+
+func (c *authorizerCache) authorize(ctx context.Context, attrs Attributes) (Decision, error) {
+	key := newAuthorizerCacheKey(attrs)
+	now := time.Now()
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		return e.decision, nil
+	}
+
+	d, ttl, err := c.authorizer.Authorize(ctx, attrs)
+	if err != nil {
+		return DecisionNoOpinion, err
+	}
+	c.mu.Lock()
+	if ttl > 0 {
+		if len(c.entries) >= maxAuthorizerCacheEntries {
+			c.entries = make(map[authorizerCacheKey]authorizerCacheEntry)
+		}
+		c.entries[key] = authorizerCacheEntry{decision: d, expires: now.Add(ttl)}
+	} else {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	return d, nil
+}
+
+func (as *authStore) SetAuthorizer(a Authorizer) {
+	if a == nil {
+		as.authorizer = nil
+		return
+	}
+	as.authorizer = newAuthorizerCache(a)
+}
+
+func (as *authStore) Authorize(ctx context.Context, authInfo *AuthInfo, attrs []Attributes) error {
+	if as.authorizer == nil || len(attrs) == 0 || !as.IsAuthEnabled() || authInfo == nil || authInfo.Revision == 0 {
+		return nil
+	}
+
+	tx := as.be.ReadTx()
+	tx.Lock()
+	user := getUser(as.lg, tx, authInfo.Username)
+	tx.Unlock()
+	// root has the permission on all the key space; it is not left to the
+	// authorizer so that the administrators cannot be locked out
+	if hasRootRole(user) {
+		return nil
+	}
+	var groups []string
+	if user != nil {
+		groups = append(groups, user.Roles...)
+	}
+	groups = append(groups, authInfo.Roles...)
+
+	var authorized []*pb.AuthorizedRange
+	for _, a := range attrs {
+		a.User, a.Groups = authInfo.Username, groups
+		d, err := as.authorizer.authorize(ctx, a)
+		if err != nil {
+			as.lg.Warn(
+				"failed to authorize a request",
+				zap.String("user-name", a.User),
+				zap.String("verb", string(a.Verb)),
+				zap.ByteString("key", a.Key),
+				zap.ByteString("range-end", a.RangeEnd),
+				zap.Error(err),
+			)
+			return ErrPermissionDenied
+		}
+		switch d {
+		case DecisionDeny:
+			return ErrPermissionDenied
+		case DecisionAllow:
+			authorized = append(authorized, &pb.AuthorizedRange{Write: a.Verb == VerbWrite, Key: a.Key, RangeEnd: a.RangeEnd})
+		}
+	}
+	authInfo.Authorized = authorized
+	return nil
+}
+
+// isAuthorized returns true if the authorizer allowed the exact key range
+// of an operation.
+func isAuthorized(authInfo *AuthInfo, key, rangeEnd []byte, permTyp authpb.Permission_Type) bool {
+	for _, r := range authInfo.Authorized {
+		if r.Write == (permTyp == authpb.WRITE) && bytes.Equal(r.Key, key) && bytes.Equal(r.RangeEnd, rangeEnd) {
+			return true
+		}
+	}
+	return false
+}
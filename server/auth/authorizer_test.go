@@ -0,0 +1,226 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type authorizerFunc func(ctx context.Context, attrs Attributes) (Decision, time.Duration, error)
+
+func (f authorizerFunc) Authorize(ctx context.Context, attrs Attributes) (Decision, time.Duration, error) {
+	return f(ctx, attrs)
+}
+
+func TestAuthorize(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	calls := 0
+	as.SetAuthorizer(authorizerFunc(func(_ context.Context, attrs Attributes) (Decision, time.Duration, error) {
+		calls++
+		if attrs.User != "foo" {
+			t.Errorf("expected user foo, got %q", attrs.User)
+		}
+		switch {
+		case strings.HasPrefix(string(attrs.Key), "allowed/"):
+			return DecisionAllow, time.Minute, nil
+		case strings.HasPrefix(string(attrs.Key), "denied/"):
+			return DecisionDeny, 0, nil
+		case strings.HasPrefix(string(attrs.Key), "failed/"):
+			return DecisionAllow, 0, errors.New("unavailable")
+		}
+		return DecisionNoOpinion, 0, nil
+	}))
+
+	rev := as.Revision()
+	tests := []struct {
+		keys       []string
+		err        error
+		authorized []string
+	}{
+		{[]string{"allowed/a", "allowed/b"}, nil, []string{"allowed/a", "allowed/b"}},
+		{[]string{"allowed/a", "other"}, nil, []string{"allowed/a"}},
+		{[]string{"other"}, nil, nil},
+		{[]string{"allowed/a", "denied/a"}, ErrPermissionDenied, nil},
+		{[]string{"failed/a"}, ErrPermissionDenied, nil},
+	}
+	for i, tt := range tests {
+		var attrs []Attributes
+		for _, k := range tt.keys {
+			attrs = append(attrs, Attributes{Verb: VerbWrite, Key: []byte(k)})
+		}
+		ai := &AuthInfo{Username: "foo", Revision: rev}
+		if err := as.Authorize(context.TODO(), ai, attrs); err != tt.err {
+			t.Errorf("#%d: expected %v, got %v", i, tt.err, err)
+		}
+		var authorized []string
+		for _, r := range ai.Authorized {
+			if !r.Write || len(r.RangeEnd) != 0 {
+				t.Errorf("#%d: unexpected authorized range %v", i, r)
+			}
+			authorized = append(authorized, string(r.Key))
+		}
+		if !reflect.DeepEqual(authorized, tt.authorized) {
+			t.Errorf("#%d: expected authorized %v, got %v", i, tt.authorized, authorized)
+		}
+	}
+
+	// the allowed key ranges are not checked against the roles of the user
+	ai := &AuthInfo{Username: "foo", Revision: rev}
+	if err := as.IsPutPermitted(ai, []byte("allowed/a")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err := as.Authorize(context.TODO(), ai, []Attributes{{Verb: VerbWrite, Key: []byte("allowed/a")}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := as.IsPutPermitted(ai, []byte("allowed/a")); err != nil {
+		t.Fatal(err)
+	}
+	// but only the exact key ranges allowed for the verb
+	if err := as.IsPutPermitted(ai, []byte("allowed/b")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err := as.IsRangePermitted(ai, []byte("allowed/a"), nil); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err := as.IsDeleteRangePermitted(ai, []byte("allowed/a"), []byte("allowed/b")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+
+	// the decisions are cached for their ttl
+	calls = 0
+	for i := 0; i < 3; i++ {
+		if err := as.Authorize(context.TODO(), &AuthInfo{Username: "foo", Revision: rev}, []Attributes{{Verb: VerbWrite, Key: []byte("allowed/a")}, {Verb: VerbWrite, Key: []byte("other")}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls to the authorizer, got %d", calls)
+	}
+
+	// root is not left to the authorizer
+	calls = 0
+	if err := as.Authorize(context.TODO(), &AuthInfo{Username: "root", Revision: rev}, []Attributes{{Verb: VerbWrite, Key: []byte("denied/a")}}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no call to the authorizer, got %d", calls)
+	}
+	// unlike the root role stored in etcd, a root role granted by a token
+	// does not bypass the authorizer
+	if err := as.Authorize(context.TODO(), &AuthInfo{Username: "foo", Revision: rev, Roles: []string{"root"}}, []Attributes{{Verb: VerbWrite, Key: []byte("denied/a")}}); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+}
+
+func TestAuthorizerCacheKey(t *testing.T) {
+	c := newAuthorizerCache(authorizerFunc(func(_ context.Context, attrs Attributes) (Decision, time.Duration, error) {
+		if string(attrs.Key) == "a\x00b" && string(attrs.RangeEnd) == "c" {
+			return DecisionAllow, time.Minute, nil
+		}
+		return DecisionDeny, time.Minute, nil
+	}))
+
+	// the attributes would collide if their fields were joined with
+	// separators allowed in the keys and the role names
+	tests := []struct {
+		attrs Attributes
+		d     Decision
+	}{
+		{Attributes{User: "foo", Verb: VerbRead, Key: []byte("a\x00b"), RangeEnd: []byte("c")}, DecisionAllow},
+		{Attributes{User: "foo", Verb: VerbRead, Key: []byte("a"), RangeEnd: []byte("b\x00c")}, DecisionDeny},
+		{Attributes{User: "foo", Groups: []string{"r1,r2"}, Verb: VerbRead, Key: []byte("a")}, DecisionDeny},
+		{Attributes{User: "foo", Groups: []string{"r1", "r2"}, Verb: VerbRead, Key: []byte("a")}, DecisionDeny},
+	}
+	for i, tt := range tests {
+		d, err := c.authorize(context.TODO(), tt.attrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d != tt.d {
+			t.Errorf("#%d: expected %v, got %v", i, tt.d, d)
+		}
+	}
+	if len(c.entries) != len(tests) {
+		t.Fatalf("expected %d cached decisions, got %d", len(tests), len(c.entries))
+	}
+}
+
+func TestWebhookAuthorizer(t *testing.T) {
+	sock := filepath.Join(t.TempDir(), "authz.sock")
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var attrs Attributes
+		if err := json.NewDecoder(r.Body).Decode(&attrs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var resp webhookResponse
+		switch {
+		case attrs.User == "fail":
+			http.Error(w, "failure", http.StatusInternalServerError)
+			return
+		case attrs.Verb == VerbRead && len(attrs.Groups) == 1 && attrs.Groups[0] == "readers":
+			resp = webhookResponse{Decision: "allow", TTLSeconds: 30}
+		case string(attrs.Key) == "secret":
+			resp = webhookResponse{Decision: "deny"}
+		}
+		json.NewEncoder(w).Encode(resp)
+	})}
+	go srv.Serve(l)
+	defer srv.Close()
+
+	if _, err = NewWebhookAuthorizer("tcp://127.0.0.1:1", time.Second); err == nil {
+		t.Fatal("expected an error for an unsupported address")
+	}
+	a, err := NewWebhookAuthorizer("unix://"+sock, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		attrs    Attributes
+		decision Decision
+		ttl      time.Duration
+		err      bool
+	}{
+		{Attributes{User: "u", Groups: []string{"readers"}, Verb: VerbRead, Key: []byte("a"), RangeEnd: []byte("b")}, DecisionAllow, 30 * time.Second, false},
+		{Attributes{User: "u", Groups: []string{"readers"}, Verb: VerbWrite, Key: []byte("secret")}, DecisionDeny, 0, false},
+		{Attributes{User: "u", Verb: VerbWrite, Key: []byte("a")}, DecisionNoOpinion, 0, false},
+		{Attributes{User: "fail", Verb: VerbWrite, Key: []byte("a")}, DecisionNoOpinion, 0, true},
+	}
+	for i, tt := range tests {
+		d, ttl, err := a.Authorize(context.TODO(), tt.attrs)
+		if (err != nil) != tt.err {
+			t.Errorf("#%d: unexpected error %v", i, err)
+		}
+		if d != tt.decision || ttl != tt.ttl {
+			t.Errorf("#%d: expected %v for %v, got %v for %v", i, tt.decision, tt.ttl, d, ttl)
+		}
+	}
+}
@@ -36,6 +36,9 @@ type tokenJWT struct {
 func (t *tokenJWT) enable()                         {}
 func (t *tokenJWT) disable()                        {}
 func (t *tokenJWT) invalidateUser(string)           {}
+func (t *tokenJWT) invalidateSession(uint64)        {}
+func (t *tokenJWT) hasSession(uint64) bool          { return true }
+func (t *tokenJWT) sessionTTL() time.Duration       { return t.ttl }
 func (t *tokenJWT) genTokenPrefix() (string, error) { return "", nil }
 
 func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInfo, bool) {
@@ -43,6 +46,7 @@ func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInf
 	var (
 		username string
 		revision float64
+		session  float64
 	)
 
 	parsed, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
@@ -86,7 +90,10 @@ func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInf
 		return nil, false
 	}
 
-	return &AuthInfo{Username: username, Revision: uint64(revision)}, true
+	// tokens issued before the sessions were recorded have no session
+	session, _ = claims["sid"].(float64)
+
+	return &AuthInfo{Username: username, Revision: uint64(revision), SessionID: uint64(session)}, true
 }
 
 func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64) (string, error) {
@@ -96,12 +103,16 @@ func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64)
 
 	// Future work: let a jwt token include permission information would be useful for
 	// permission checking in proxy side.
-	tk := jwt.NewWithClaims(t.signMethod,
-		jwt.MapClaims{
-			"username": username,
-			"revision": revision,
-			"exp":      time.Now().Add(t.ttl).Unix(),
-		})
+	claims := jwt.MapClaims{
+		"username": username,
+		"revision": revision,
+		"exp":      time.Now().Add(t.ttl).Unix(),
+	}
+	// sid is the ID of the session, checked against the revoked sessions
+	if index, _ := ctx.Value(AuthenticateParamIndex{}).(uint64); index != 0 {
+		claims["sid"] = index
+	}
+	tk := jwt.NewWithClaims(t.signMethod, claims)
 
 	token, err := tk.SignedString(t.key)
 	if err != nil {
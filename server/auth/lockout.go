@@ -0,0 +1,180 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// lockoutMaxEntries bounds the number of tracked users, and of tracked
+// addresses. Above it the stale entries are pruned, then the entries which
+// failed least recently.
+const lockoutMaxEntries = 1024
+
+// LockoutPolicy locks the authentication of a user, or from a source
+// address, after consecutive failed attempts. Each further failed attempt
+// doubles the duration of the lockout. The attempts made while locked out
+// are rejected without being checked.
+//
+// The failed attempts are tracked by each member, in memory: a client may
+// attempt Threshold authentications against each member before being locked
+// out, and the lockouts are lifted when a member restarts.
+type LockoutPolicy struct {
+	// Threshold is the number of consecutive failed attempts after which
+	// the authentication is locked. Zero disables the lockout.
+	Threshold int
+	// Duration is the duration of the first lockout.
+	Duration time.Duration
+	// MaxDuration is the maximum duration of a lockout. The failed attempts
+	// are forgotten after MaxDuration without failures.
+	MaxDuration time.Duration
+}
+
+type lockoutEntry struct {
+	failures int
+	last     time.Time
+	until    time.Time
+}
+
+type lockoutTracker struct {
+	policy LockoutPolicy
+
+	mu    sync.Mutex
+	users map[string]*lockoutEntry
+	addrs map[string]*lockoutEntry
+	now   func() time.Time
+}
+
+func newLockoutTracker() *lockoutTracker {
+	return &lockoutTracker{
+		users: make(map[string]*lockoutEntry),
+		addrs: make(map[string]*lockoutEntry),
+		now:   time.Now,
+	}
+}
+
+func (t *lockoutTracker) setPolicy(p LockoutPolicy) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if p.MaxDuration < p.Duration {
+		p.MaxDuration = p.Duration
+	}
+	t.policy = p
+}
+
+// lockedUntil returns the time until which the authentication of the user,
+// or from the address, is locked. It is zero if it is not locked.
+func (t *lockoutTracker) lockedUntil(username, addr string) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.policy.Threshold <= 0 {
+		return time.Time{}
+	}
+	now := t.now()
+	var until time.Time
+	for _, e := range []*lockoutEntry{t.users[username], t.addrs[addr]} {
+		if e != nil && e.until.After(now) && e.until.After(until) {
+			until = e.until
+		}
+	}
+	return until
+}
+
+// fail records a failed attempt of the user from the address.
+func (t *lockoutTracker) fail(username, addr string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.policy.Threshold <= 0 {
+		return
+	}
+	now := t.now()
+	t.failLocked(t.users, username, now)
+	if addr != "" {
+		t.failLocked(t.addrs, addr, now)
+	}
+}
+
+func (t *lockoutTracker) failLocked(entries map[string]*lockoutEntry, key string, now time.Time) {
+	e, ok := entries[key]
+	if !ok || now.Sub(e.last) > t.policy.MaxDuration {
+		if len(entries) >= lockoutMaxEntries {
+			t.pruneLocked(entries, now)
+		}
+		e = &lockoutEntry{}
+		entries[key] = e
+	}
+	e.failures++
+	e.last = now
+	if e.failures < t.policy.Threshold {
+		return
+	}
+	d := t.policy.Duration
+	for i := t.policy.Threshold; i < e.failures && d < t.policy.MaxDuration; i++ {
+		d *= 2
+	}
+	if d > t.policy.MaxDuration {
+		d = t.policy.MaxDuration
+	}
+	e.until = now.Add(d)
+}
+
+func (t *lockoutTracker) pruneLocked(entries map[string]*lockoutEntry, now time.Time) {
+	for k, e := range entries {
+		if now.Sub(e.last) > t.policy.MaxDuration {
+			delete(entries, k)
+		}
+	}
+	for len(entries) >= lockoutMaxEntries {
+		var (
+			oldest     string
+			oldestLast time.Time
+		)
+		for k, e := range entries {
+			if oldestLast.IsZero() || e.last.Before(oldestLast) {
+				oldest, oldestLast = k, e.last
+			}
+		}
+		delete(entries, oldest)
+	}
+}
+
+// reset forgets the failed attempts of the user. The failed attempts from
+// source addresses are kept, so that a valid account cannot be used to
+// reset them.
+func (t *lockoutTracker) reset(username string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.users, username)
+}
+
+// status returns the consecutive failed attempts of the user and the time
+// until which its authentication is locked, zero if it is not locked.
+func (t *lockoutTracker) status(username string) (int, time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.users[username]
+	if !ok {
+		return 0, time.Time{}
+	}
+	now := t.now()
+	if now.Sub(e.last) > t.policy.MaxDuration {
+		return 0, time.Time{}
+	}
+	if !e.until.After(now) {
+		return e.failures, time.Time{}
+	}
+	return e.failures, e.until
+}
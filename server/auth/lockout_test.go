@@ -0,0 +1,174 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func newTestLockoutTracker(now *time.Time) *lockoutTracker {
+	t := newLockoutTracker()
+	t.now = func() time.Time { return *now }
+	t.setPolicy(LockoutPolicy{Threshold: 3, Duration: time.Second, MaxDuration: 4 * time.Second})
+	return t
+}
+
+func TestLockoutBackoff(t *testing.T) {
+	now := time.Unix(1000, 0)
+	lt := newTestLockoutTracker(&now)
+
+	tests := []struct {
+		failures int
+		locked   time.Duration
+	}{
+		{1, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{6, 4 * time.Second},
+	}
+	for _, tt := range tests {
+		lt.fail("foo", "10.0.0.1")
+		failures, until := lt.status("foo")
+		if failures != tt.failures {
+			t.Fatalf("#%d: failures = %d, want %d", tt.failures, failures, tt.failures)
+		}
+		var want time.Time
+		if tt.locked != 0 {
+			want = now.Add(tt.locked)
+		}
+		if !until.Equal(want) {
+			t.Fatalf("#%d: locked until %v, want %v", tt.failures, until, want)
+		}
+		if got := lt.lockedUntil("foo", ""); !got.Equal(want) {
+			t.Fatalf("#%d: lockedUntil = %v, want %v", tt.failures, got, want)
+		}
+		// the lockout ends before the next attempt
+		now = now.Add(tt.locked)
+	}
+}
+
+func TestLockoutSourceAddress(t *testing.T) {
+	now := time.Unix(1000, 0)
+	lt := newTestLockoutTracker(&now)
+
+	for _, user := range []string{"a", "b", "c"} {
+		lt.fail(user, "10.0.0.1")
+	}
+	if lt.lockedUntil("d", "10.0.0.1").IsZero() {
+		t.Fatal("expected the source address to be locked")
+	}
+	if !lt.lockedUntil("d", "10.0.0.2").IsZero() {
+		t.Fatal("expected another source address not to be locked")
+	}
+
+	// a successful authentication does not reset the source address
+	lt.reset("d")
+	if lt.lockedUntil("d", "10.0.0.1").IsZero() {
+		t.Fatal("expected the source address to stay locked")
+	}
+}
+
+func TestLockoutExpiry(t *testing.T) {
+	now := time.Unix(1000, 0)
+	lt := newTestLockoutTracker(&now)
+
+	lt.fail("foo", "")
+	lt.fail("foo", "")
+	now = now.Add(5 * time.Second)
+	lt.fail("foo", "")
+	if failures, until := lt.status("foo"); failures != 1 || !until.IsZero() {
+		t.Fatalf("status = (%d, %v), want the failures to be forgotten", failures, until)
+	}
+}
+
+func TestLockoutMaxEntries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	lt := newTestLockoutTracker(&now)
+
+	for i := 0; i < 3; i++ {
+		lt.fail("foo", "")
+	}
+	for i := 0; i < 2*lockoutMaxEntries; i++ {
+		now = now.Add(time.Millisecond)
+		lt.fail(fmt.Sprintf("user%d", i), fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+	if len(lt.users) > lockoutMaxEntries || len(lt.addrs) > lockoutMaxEntries {
+		t.Fatalf("tracking %d users and %d addresses, want at most %d", len(lt.users), len(lt.addrs), lockoutMaxEntries)
+	}
+	// the users which failed least recently are forgotten first
+	if failures, _ := lt.status("foo"); failures != 0 {
+		t.Fatalf("failures = %d, want the least recent user to be forgotten", failures)
+	}
+	last := fmt.Sprintf("user%d", 2*lockoutMaxEntries-1)
+	if failures, _ := lt.status(last); failures != 1 {
+		t.Fatalf("failures = %d, want the most recent user to be tracked", failures)
+	}
+}
+
+func TestLockoutDisabled(t *testing.T) {
+	lt := newLockoutTracker()
+	for i := 0; i < 10; i++ {
+		lt.fail("foo", "10.0.0.1")
+	}
+	if !lt.lockedUntil("foo", "10.0.0.1").IsZero() {
+		t.Fatal("expected no lockout without a threshold")
+	}
+}
+
+func TestCheckPasswordLockout(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	as.SetLockoutPolicy(LockoutPolicy{Threshold: 2, Duration: time.Hour, MaxDuration: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		if _, err := as.CheckPassword("foo", "wrong", "10.0.0.1"); err != ErrAuthFailed {
+			t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+		}
+	}
+	// the correct password is rejected while locked out
+	if _, err := as.CheckPassword("foo", "bar", "10.0.0.2"); err != ErrAuthLocked {
+		t.Fatalf("expected %v, got %v", ErrAuthLocked, err)
+	}
+
+	resp, err := as.UserGet(&pb.AuthUserGetRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FailedAttempts != 2 || resp.LockedUntil == 0 {
+		t.Fatalf("unexpected lockout state (%d, %d)", resp.FailedAttempts, resp.LockedUntil)
+	}
+
+	if _, err = as.UserUnlock(&pb.AuthUserUnlockRequest{Name: "foo"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.CheckPassword("foo", "bar", "10.0.0.2"); err != nil {
+		t.Fatal(err)
+	}
+	// the source address of the failed attempts stays locked
+	if _, err = as.CheckPassword("foo", "bar", "10.0.0.1"); err != ErrAuthLocked {
+		t.Fatalf("expected %v, got %v", ErrAuthLocked, err)
+	}
+
+	if _, err = as.UserUnlock(&pb.AuthUserUnlockRequest{Name: "foo-test"}); err != ErrUserNotFound {
+		t.Fatalf("expected %v, got %v", ErrUserNotFound, err)
+	}
+}
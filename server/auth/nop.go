@@ -16,6 +16,7 @@ package auth
 
 import (
 	"context"
+	"time"
 )
 
 type tokenNop struct{}
@@ -23,6 +24,9 @@ type tokenNop struct{}
 func (t *tokenNop) enable()                         {}
 func (t *tokenNop) disable()                        {}
 func (t *tokenNop) invalidateUser(string)           {}
+func (t *tokenNop) invalidateSession(uint64)        {}
+func (t *tokenNop) hasSession(uint64) bool          { return false }
+func (t *tokenNop) sessionTTL() time.Duration       { return 0 }
 func (t *tokenNop) genTokenPrefix() (string, error) { return "", nil }
 func (t *tokenNop) info(ctx context.Context, token string, rev uint64) (*AuthInfo, bool) {
 	return nil, false
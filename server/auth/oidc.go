@@ -0,0 +1,348 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+// oidcMinReloadInterval rate limits the reloads of the JWKS triggered by
+// tokens signed with an unknown key.
+var oidcMinReloadInterval = time.Second
+
+// tokenOIDC verifies JWTs issued outside of etcd, for example by an OIDC
+// provider, against the public keys of a local JWKS file or directory. The
+// keys are reloaded periodically and when a token is signed with an unknown
+// key, so that the keys of the issuer can be rotated without a restart.
+//
+// Users authenticating with a password, and the internal root credential,
+// are given simple tokens.
+type tokenOIDC struct {
+	lg     *zap.Logger
+	opts   oidcOptions
+	simple *tokenSimple
+
+	mu       sync.Mutex
+	keys     map[string]interface{}
+	loadedAt time.Time
+}
+
+func (t *tokenOIDC) enable()                         { t.simple.enable() }
+func (t *tokenOIDC) disable()                        { t.simple.disable() }
+func (t *tokenOIDC) invalidateUser(username string)  { t.simple.invalidateUser(username) }
+func (t *tokenOIDC) invalidateSession(id uint64)     { t.simple.invalidateSession(id) }
+func (t *tokenOIDC) hasSession(id uint64) bool       { return t.simple.hasSession(id) }
+func (t *tokenOIDC) sessionTTL() time.Duration       { return t.simple.sessionTTL() }
+func (t *tokenOIDC) genTokenPrefix() (string, error) { return t.simple.genTokenPrefix() }
+
+func (t *tokenOIDC) assign(ctx context.Context, username string, revision uint64) (string, error) {
+	return t.simple.assign(ctx, username, revision)
+}
+
+func (t *tokenOIDC) info(ctx context.Context, token string, revision uint64) (*AuthInfo, bool) {
+	// a simple token is "<prefix>.<index>", a JWT has three parts
+	if strings.Count(token, ".") == 1 {
+		return t.simple.info(ctx, token, revision)
+	}
+
+	parsed, err := jwt.Parse(token, t.verificationKey)
+	if err != nil {
+		t.lg.Warn("failed to parse an external JWT token", zap.Error(err))
+		return nil, false
+	}
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !parsed.Valid || !ok {
+		t.lg.Warn("invalid external JWT token")
+		return nil, false
+	}
+	if err = t.verifyClaims(claims); err != nil {
+		t.lg.Warn("invalid claims of an external JWT token", zap.Error(err))
+		return nil, false
+	}
+
+	username, ok := claims[t.opts.UsernameClaim].(string)
+	if !ok || username == "" {
+		t.lg.Warn(
+			"failed to obtain the user claim from an external JWT token",
+			zap.String("claim", t.opts.UsernameClaim),
+		)
+		return nil, false
+	}
+
+	var roles []string
+	if t.opts.GroupsClaim != "" {
+		switch groups := claims[t.opts.GroupsClaim].(type) {
+		case string:
+			roles = []string{groups}
+		case []interface{}:
+			for _, g := range groups {
+				if role, ok := g.(string); ok {
+					roles = append(roles, role)
+				}
+			}
+		}
+	}
+	// the root role is never granted by an external issuer
+	roles = filterTokenRoles(roles)
+
+	return &AuthInfo{Username: t.opts.UsernamePrefix + username, Revision: revision, Roles: roles}, true
+}
+
+func (t *tokenOIDC) verifyClaims(claims jwt.MapClaims) error {
+	now := time.Now().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		return errors.New("token is expired or has no expiration time")
+	}
+	if t.opts.Issuer != "" && !claims.VerifyIssuer(t.opts.Issuer, true) {
+		return errors.New("unexpected issuer")
+	}
+	if t.opts.Audience != "" && !claims.VerifyAudience(t.opts.Audience, true) {
+		return errors.New("unexpected audience")
+	}
+	return nil
+}
+
+// verificationKey returns the public key of the JWKS the token is signed with.
+func (t *tokenOIDC) verificationKey(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA, *jwt.SigningMethodEd25519:
+	default:
+		return nil, fmt.Errorf("unsupported signing method %q", token.Method.Alg())
+	}
+	kid, _ := token.Header["kid"].(string)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if time.Since(t.loadedAt) >= t.opts.ReloadInterval {
+		t.reload()
+	}
+	key, ok := t.lookupKey(kid)
+	if !ok && time.Since(t.loadedAt) >= oidcMinReloadInterval {
+		// the issuer may have rotated its keys
+		t.reload()
+		key, ok = t.lookupKey(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	return key, nil
+}
+
+// lookupKey returns the key with the given ID. A token without a key ID can
+// only be verified if the JWKS has a single key.
+func (t *tokenOIDC) lookupKey(kid string) (interface{}, bool) {
+	if kid == "" && len(t.keys) == 1 {
+		for _, key := range t.keys {
+			return key, true
+		}
+	}
+	key, ok := t.keys[kid]
+	return key, ok
+}
+
+// reload replaces the keys with the ones of the JWKS, keeping the previous
+// keys if it cannot be loaded.
+func (t *tokenOIDC) reload() {
+	t.loadedAt = time.Now()
+	keys, err := loadJWKS(t.opts.JWKS)
+	if err != nil {
+		t.lg.Warn("failed to reload JWKS", zap.String("path", t.opts.JWKS), zap.Error(err))
+		return
+	}
+	t.keys = keys
+}
+
+// jsonWebKey is a public key of a JWKS (RFC 7517).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// loadJWKS reads the public keys of a JWKS file, or of the *.json JWKS files
+// of a directory, by key ID.
+func loadJWKS(path string) (map[string]interface{}, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if fi.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(path, "*.json")); err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+	}
+
+	keys := make(map[string]interface{})
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var set jsonWebKeySet
+		if err = json.Unmarshal(b, &set); err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		for _, jwk := range set.Keys {
+			if jwk.Use != "" && jwk.Use != "sig" {
+				continue
+			}
+			key, err := jwk.publicKey()
+			if err != nil {
+				return nil, fmt.Errorf("%s: key %q: %v", file, jwk.Kid, err)
+			}
+			keys[jwk.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, ErrMissingKey
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeJWKInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeJWKInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("missing key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func newTokenProviderOIDC(
+	lg *zap.Logger,
+	optMap map[string]string,
+	indexWaiter func(uint64) <-chan struct{},
+	TokenTTL time.Duration) (*tokenOIDC, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	var opts oidcOptions
+	if err := opts.ParseWithDefaults(optMap); err != nil {
+		lg.Error("problem loading OIDC options", zap.Error(err))
+		return nil, ErrInvalidAuthOpts
+	}
+
+	var unknown []string
+	for k := range optMap {
+		if !knownOIDCOptions[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) > 0 {
+		lg.Warn("unknown OIDC options", zap.Strings("keys", unknown))
+	}
+
+	keys, err := loadJWKS(opts.JWKS)
+	if err != nil {
+		lg.Error("failed to load JWKS", zap.String("path", opts.JWKS), zap.Error(err))
+		return nil, err
+	}
+
+	return &tokenOIDC{
+		lg:       lg,
+		opts:     opts,
+		simple:   newTokenProviderSimple(lg, indexWaiter, TokenTTL),
+		keys:     keys,
+		loadedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+// testIssuer is a local stand-in of an OIDC provider.
+type testIssuer struct {
+	kid    string
+	method jwt.SigningMethod
+	key    interface{}
+	jwk    jsonWebKey
+}
+
+func newTestIssuerEC(t *testing.T, kid string) *testIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testIssuer{kid: kid, method: jwt.SigningMethodES256, key: key, jwk: jsonWebKey{
+		Kty: "EC", Kid: kid, Use: "sig", Crv: "P-256",
+		X: base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+		Y: base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+	}}
+}
+
+func newTestIssuerRSA(t *testing.T, kid string) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testIssuer{kid: kid, method: jwt.SigningMethodRS256, key: key, jwk: jsonWebKey{
+		Kty: "RSA", Kid: kid,
+		N: base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}
+}
+
+func newTestIssuerEd25519(t *testing.T, kid string) *testIssuer {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testIssuer{kid: kid, method: jwt.SigningMethodEdDSA, key: priv, jwk: jsonWebKey{
+		Kty: "OKP", Kid: kid, Crv: "Ed25519",
+		X: base64.RawURLEncoding.EncodeToString(pub),
+	}}
+}
+
+func (i *testIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	tk := jwt.NewWithClaims(i.method, claims)
+	tk.Header["kid"] = i.kid
+	s, err := tk.SignedString(i.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func writeJWKS(t *testing.T, path string, issuers ...*testIssuer) {
+	var set jsonWebKeySet
+	for _, i := range issuers {
+		set.Keys = append(set.Keys, i.jwk)
+	}
+	b, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func validClaims(sub string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":    "https://issuer.example.com",
+		"aud":    "etcd",
+		"sub":    sub,
+		"groups": []string{"tenant", "readers"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestOIDCInfo(t *testing.T) {
+	ec, rs, ed := newTestIssuerEC(t, "ec"), newTestIssuerRSA(t, "rsa"), newTestIssuerEd25519(t, "ed")
+	jwks := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwks, ec, rs, ed)
+
+	to, err := newTokenProviderOIDC(zap.NewExample(), map[string]string{
+		"jwks":            jwks,
+		"issuer":          "https://issuer.example.com",
+		"audience":        "etcd",
+		"username-prefix": "oidc:",
+	}, dummyIndexWaiter, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, i := range []*testIssuer{ec, rs, ed} {
+		ai, ok := to.info(context.TODO(), i.token(t, validClaims("alice")), 3)
+		if !ok {
+			t.Fatalf("%s: expected a valid token", i.kid)
+		}
+		want := &AuthInfo{Username: "oidc:alice", Revision: 3, Roles: []string{"tenant", "readers"}}
+		if !reflect.DeepEqual(ai, want) {
+			t.Errorf("%s: got %+v, want %+v", i.kid, ai, want)
+		}
+	}
+
+	invalid := map[string]jwt.MapClaims{
+		"expired":        {"exp": time.Now().Add(-time.Minute).Unix()},
+		"no expiration":  {"exp": nil},
+		"wrong issuer":   {"iss": "https://other.example.com"},
+		"wrong audience": {"aud": "other"},
+		"no subject":     {"sub": nil},
+	}
+	for name, override := range invalid {
+		claims := validClaims("alice")
+		for k, v := range override {
+			if v == nil {
+				delete(claims, k)
+			} else {
+				claims[k] = v
+			}
+		}
+		if _, ok := to.info(context.TODO(), ec.token(t, claims), 3); ok {
+			t.Errorf("%s: expected an invalid token", name)
+		}
+	}
+
+	// the root role is not granted by the tokens
+	claims := validClaims("alice")
+	claims["groups"] = []string{"root", "readers", ""}
+	ai, ok := to.info(context.TODO(), ec.token(t, claims), 3)
+	if !ok {
+		t.Fatal("expected a valid token")
+	}
+	if !reflect.DeepEqual(ai.Roles, []string{"readers"}) {
+		t.Errorf("expected roles [readers], got %v", ai.Roles)
+	}
+
+	// a token signed by a key outside of the JWKS
+	if _, ok := to.info(context.TODO(), newTestIssuerEC(t, "ec").token(t, validClaims("alice")), 3); ok {
+		t.Error("expected a token with an unknown signature to be invalid")
+	}
+
+	// a token signed with the public key as an HMAC secret
+	tk := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims("alice"))
+	tk.Header["kid"] = "ec"
+	hs, err := tk.SignedString([]byte(ec.jwk.X))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := to.info(context.TODO(), hs, 3); ok {
+		t.Error("expected a token signed with HMAC to be invalid")
+	}
+}
+
+func TestOIDCKeyRotation(t *testing.T) {
+	old, rotated := newTestIssuerEC(t, "old"), newTestIssuerEC(t, "new")
+	dir := t.TempDir()
+	writeJWKS(t, filepath.Join(dir, "old.json"), old)
+
+	to, err := newTokenProviderOIDC(zap.NewExample(), map[string]string{"jwks": dir, "username-prefix": "oidc:"}, dummyIndexWaiter, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := to.info(context.TODO(), rotated.token(t, validClaims("alice")), 1); ok {
+		t.Fatal("expected a token signed by an unknown key to be invalid")
+	}
+
+	writeJWKS(t, filepath.Join(dir, "new.json"), rotated)
+	defer func(d time.Duration) { oidcMinReloadInterval = d }(oidcMinReloadInterval)
+	oidcMinReloadInterval = 0
+
+	if _, ok := to.info(context.TODO(), rotated.token(t, validClaims("alice")), 1); !ok {
+		t.Fatal("expected the rotated key to be loaded")
+	}
+	if _, ok := to.info(context.TODO(), old.token(t, validClaims("alice")), 1); !ok {
+		t.Fatal("expected the old key to be kept")
+	}
+}
+
+func TestOIDCTokenRoles(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "tenant"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "tenant", Perm: &authpb.Permission{
+		PermType: authpb.READWRITE, Key: []byte("/tenants/{user}/"), RangeEnd: []byte("/tenants/{user}0"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a user which does not exist in etcd is granted roles by its token
+	ai := &AuthInfo{Username: "alice", Revision: as.Revision(), Roles: []string{"tenant", "unknown"}}
+	if err = as.IsPutPermitted(ai, []byte("/tenants/alice/a")); err != nil {
+		t.Fatal(err)
+	}
+	if err = as.IsPutPermitted(ai, []byte("/tenants/bob/a")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err = as.IsAdminPermitted(ai); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err = as.IsPutPermitted(&AuthInfo{Username: "alice", Revision: as.Revision()}, []byte("/tenants/alice/a")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+
+	ai.Roles = []string{rootRole}
+	if err = as.IsAdminPermitted(ai); err != nil {
+		t.Fatal(err)
+	}
+	if err = as.IsRangePermitted(ai, []byte("/"), []byte{0}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOIDCUsernamePrefix(t *testing.T) {
+	tests := []struct {
+		opts   map[string]string
+		prefix string
+		err    bool
+	}{
+		{map[string]string{"jwks": "jwks.json"}, "", true},
+		{map[string]string{"jwks": "jwks.json", "username-prefix": ""}, "", true},
+		{map[string]string{"jwks": "jwks.json", "issuer": "https://issuer.example.com"}, "https://issuer.example.com#", false},
+		{map[string]string{"jwks": "jwks.json", "issuer": "https://issuer.example.com", "username-prefix": "oidc:"}, "oidc:", false},
+	}
+	for i, tt := range tests {
+		var opts oidcOptions
+		err := opts.ParseWithDefaults(tt.opts)
+		if (err != nil) != tt.err {
+			t.Errorf("#%d: unexpected error %v", i, err)
+		}
+		if err == nil && opts.UsernamePrefix != tt.prefix {
+			t.Errorf("#%d: expected prefix %q, got %q", i, tt.prefix, opts.UsernamePrefix)
+		}
+	}
+}
@@ -190,3 +190,88 @@ func (opts *jwtOptions) ecKey() (interface{}, error) {
 
 	return priv, nil
 }
+
+const (
+	optJWKS           = "jwks"
+	optIssuer         = "issuer"
+	optAudience       = "audience"
+	optUsernameClaim  = "username-claim"
+	optUsernamePrefix = "username-prefix"
+	optGroupsClaim    = "groups-claim"
+	optReloadInterval = "jwks-reload-interval"
+)
+
+var knownOIDCOptions = map[string]bool{
+	optJWKS:           true,
+	optIssuer:         true,
+	optAudience:       true,
+	optUsernameClaim:  true,
+	optUsernamePrefix: true,
+	optGroupsClaim:    true,
+	optReloadInterval: true,
+}
+
+var (
+	// DefaultJWKSReloadInterval will be used when a 'jwks-reload-interval' is not specified
+	DefaultJWKSReloadInterval = time.Minute
+)
+
+type oidcOptions struct {
+	// JWKS is the path of a JWKS file, or of a directory of *.json JWKS files
+	JWKS string
+	// Issuer and Audience are required to match the claims of the tokens if set
+	Issuer   string
+	Audience string
+	// UsernameClaim is the claim of the user name, prefixed by UsernamePrefix,
+	// "<Issuer>#" by default, so that the users of the tokens are not taken
+	// for the users stored in etcd
+	UsernameClaim  string
+	UsernamePrefix string
+	// GroupsClaim is the claim of the roles of the user, if set
+	GroupsClaim    string
+	ReloadInterval time.Duration
+}
+
+// ParseWithDefaults will load options from the specified map or set defaults where appropriate
+func (opts *oidcOptions) ParseWithDefaults(optMap map[string]string) error {
+	opts.UsernameClaim = "sub"
+	opts.GroupsClaim = "groups"
+	opts.ReloadInterval = DefaultJWKSReloadInterval
+	return opts.Parse(optMap)
+}
+
+// Parse will load options from the specified map
+func (opts *oidcOptions) Parse(optMap map[string]string) error {
+	// the JWKS is a required field
+	opts.JWKS = optMap[optJWKS]
+	if opts.JWKS == "" {
+		return ErrMissingKey
+	}
+	opts.Issuer = optMap[optIssuer]
+	opts.Audience = optMap[optAudience]
+	opts.UsernamePrefix = optMap[optUsernamePrefix]
+	if opts.UsernamePrefix == "" {
+		if opts.Issuer == "" {
+			return fmt.Errorf("%s or %s is required", optUsernamePrefix, optIssuer)
+		}
+		opts.UsernamePrefix = opts.Issuer + "#"
+	}
+	if claim, ok := optMap[optUsernameClaim]; ok {
+		if claim == "" {
+			return fmt.Errorf("empty %s", optUsernameClaim)
+		}
+		opts.UsernameClaim = claim
+	}
+	// an empty groups claim disables the roles granted by tokens
+	if claim, ok := optMap[optGroupsClaim]; ok {
+		opts.GroupsClaim = claim
+	}
+	if interval := optMap[optReloadInterval]; interval != "" {
+		var err error
+		opts.ReloadInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
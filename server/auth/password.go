@@ -0,0 +1,103 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordPolicy is the policy of the passwords given to UserAdd and
+// UserChangePassword.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters of a password.
+	MinLength int
+	// MinCharacterClasses is the minimum number of character classes of a
+	// password, among lower case letters, upper case letters, digits and
+	// other characters.
+	MinCharacterClasses int
+	// History is the number of previous passwords of a user, including the
+	// current one, which cannot be reused.
+	History int
+}
+
+func (p PasswordPolicy) enabled() bool {
+	return p.MinLength > 0 || p.MinCharacterClasses > 0 || p.History > 0
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+func (as *authStore) CheckPasswordPolicy(username, password string) error {
+	p := as.passwordPolicy
+	if !p.enabled() {
+		return nil
+	}
+	// a hashed password given by the client cannot be checked
+	if password == "" ||
+		utf8.RuneCountInString(password) < p.MinLength ||
+		characterClasses(password) < p.MinCharacterClasses {
+		return ErrPasswordTooWeak
+	}
+	if p.History <= 0 {
+		return nil
+	}
+
+	tx := as.be.ReadTx()
+	tx.Lock()
+	user := getUser(as.lg, tx, username)
+	tx.Unlock()
+	if user == nil {
+		return nil
+	}
+	for _, hash := range passwordHistory(user, p.History) {
+		if bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil {
+			return ErrPasswordReused
+		}
+	}
+	return nil
+}
+
+// passwordHistory returns the current and the previous password hashes of
+// the user, at most n of them.
+func passwordHistory(user *authpb.User, n int) [][]byte {
+	var hashes [][]byte
+	if len(user.Password) > 0 {
+		hashes = append(hashes, user.Password)
+	}
+	hashes = append(hashes, user.PasswordHistory...)
+	if len(hashes) > n {
+		hashes = hashes[:n]
+	}
+	return hashes
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestCheckPasswordPolicyStrength(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	as.SetPasswordPolicy(PasswordPolicy{MinLength: 8, MinCharacterClasses: 3})
+
+	tests := []struct {
+		password string
+		err      error
+	}{
+		{"", ErrPasswordTooWeak},
+		{"aB3$", ErrPasswordTooWeak},
+		{"abcdefgh", ErrPasswordTooWeak},
+		{"abcdEFGH", ErrPasswordTooWeak},
+		{"abcdEF12", nil},
+		{"abcdéf-1", nil},
+	}
+	for i, tt := range tests {
+		if err := as.CheckPasswordPolicy("foo", tt.password); err != tt.err {
+			t.Errorf("#%d: CheckPasswordPolicy(%q) = %v, want %v", i, tt.password, err, tt.err)
+		}
+	}
+}
+
+func TestCheckPasswordPolicyHistory(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	as.SetPasswordPolicy(PasswordPolicy{History: 3})
+
+	// the current password of foo is "bar"
+	if err := as.CheckPasswordPolicy("foo", "bar"); err != ErrPasswordReused {
+		t.Fatalf("expected %v, got %v", ErrPasswordReused, err)
+	}
+	for _, password := range []string{"baz", "qux", "quux"} {
+		if err := as.CheckPasswordPolicy("foo", password); err != nil {
+			t.Fatal(err)
+		}
+		_, err := as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "foo", HashedPassword: encodePassword(password), PasswordHistory: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the last 3 passwords cannot be reused, the older ones can
+	for _, password := range []string{"baz", "qux", "quux"} {
+		if err := as.CheckPasswordPolicy("foo", password); err != ErrPasswordReused {
+			t.Errorf("%q: expected %v, got %v", password, ErrPasswordReused, err)
+		}
+	}
+	if err := as.CheckPasswordPolicy("foo", "bar"); err != nil {
+		t.Errorf("expected the oldest password to be accepted, got %v", err)
+	}
+	// the policy does not apply to unknown users
+	if err := as.CheckPasswordPolicy("foo-test", "bar"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -15,6 +15,9 @@
 package auth
 
 import (
+	"bytes"
+	"strings"
+
 	"go.etcd.io/etcd/api/v3/authpb"
 	"go.etcd.io/etcd/pkg/v3/adt"
 	"go.etcd.io/etcd/server/v3/mvcc/backend"
@@ -27,11 +30,18 @@ func getMergedPerms(lg *zap.Logger, tx backend.ReadTx, userName string) *unified
 	if user == nil {
 		return nil
 	}
+	return mergeRolePerms(lg, tx, userName, user.Roles)
+}
 
+// mergeRolePerms merges the permissions of roles for the user userName, who
+// does not need to exist if the roles are granted by its auth token.
+func mergeRolePerms(lg *zap.Logger, tx backend.ReadTx, userName string, roles []string) *unifiedRangePermissions {
 	readPerms := adt.NewIntervalTree()
 	writePerms := adt.NewIntervalTree()
+	readDenies := adt.NewIntervalTree()
+	writeDenies := adt.NewIntervalTree()
 
-	for _, roleName := range user.Roles {
+	for _, roleName := range roles {
 		role := getRole(lg, tx, roleName)
 		if role == nil {
 			continue
@@ -41,36 +51,110 @@ func getMergedPerms(lg *zap.Logger, tx backend.ReadTx, userName string) *unified
 			var ivl adt.Interval
 			var rangeEnd []byte
 
-			if len(perm.RangeEnd) != 1 || perm.RangeEnd[0] != 0 {
-				rangeEnd = perm.RangeEnd
+			key, permRangeEnd := expandPermUser(perm.Key, perm.RangeEnd, userName)
+			if !isValidPermissionRange(key, permRangeEnd) {
+				lg.Warn(
+					"ignored a templated permission with an invalid range for the user",
+					zap.String("user-name", userName),
+					zap.String("role-name", roleName),
+					zap.ByteString("key", perm.Key),
+					zap.ByteString("range-end", perm.RangeEnd),
+				)
+				continue
+			}
+
+			if len(permRangeEnd) != 1 || permRangeEnd[0] != 0 {
+				rangeEnd = permRangeEnd
 			}
 
-			if len(perm.RangeEnd) != 0 {
-				ivl = adt.NewBytesAffineInterval(perm.Key, rangeEnd)
+			if len(permRangeEnd) != 0 {
+				ivl = adt.NewBytesAffineInterval(key, rangeEnd)
 			} else {
-				ivl = adt.NewBytesAffinePoint(perm.Key)
+				ivl = adt.NewBytesAffinePoint(key)
+			}
+
+			reads, writes := readPerms, writePerms
+			if perm.Deny {
+				reads, writes = readDenies, writeDenies
 			}
 
 			switch perm.PermType {
 			case authpb.READWRITE:
-				readPerms.Insert(ivl, struct{}{})
-				writePerms.Insert(ivl, struct{}{})
+				reads.Insert(ivl, struct{}{})
+				writes.Insert(ivl, struct{}{})
 
 			case authpb.READ:
-				readPerms.Insert(ivl, struct{}{})
+				reads.Insert(ivl, struct{}{})
 
 			case authpb.WRITE:
-				writePerms.Insert(ivl, struct{}{})
+				writes.Insert(ivl, struct{}{})
 			}
 		}
 	}
 
 	return &unifiedRangePermissions{
-		readPerms:  readPerms,
-		writePerms: writePerms,
+		readPerms:   readPerms,
+		writePerms:  writePerms,
+		readDenies:  readDenies,
+		writeDenies: writeDenies,
 	}
 }
 
+// permUserPlaceholder is replaced in the keys and range ends of permissions
+// by the name of the user whose permissions are checked, so that a single
+// role can grant every user its own subtree.
+var permUserPlaceholder = []byte("{user}")
+
+// permUserEscaper escapes the user names expanded in the permissions. A name
+// containing a slash would otherwise reach into the subtree of another user,
+// e.g. "a/b" into the subtree of "a".
+var permUserEscaper = strings.NewReplacer("%", "%25", "/", "%2F", "{", "%7B", "}", "%7D")
+
+// IsTemplatedPermission returns true if the range of perm contains the user
+// placeholder.
+func IsTemplatedPermission(perm *authpb.Permission) bool {
+	return bytes.Contains(perm.Key, permUserPlaceholder) || bytes.Contains(perm.RangeEnd, permUserPlaceholder)
+}
+
+// expandPermUser replaces permUserPlaceholder in the range of a permission
+// with the escaped userName. A range end which is the prefix end of the key
+// stays the prefix end of the expanded key, even if the key ends with the
+// placeholder.
+func expandPermUser(key, rangeEnd []byte, userName string) ([]byte, []byte) {
+	if !bytes.Contains(key, permUserPlaceholder) && !bytes.Contains(rangeEnd, permUserPlaceholder) {
+		return key, rangeEnd
+	}
+	name := []byte(permUserEscaper.Replace(userName))
+	expanded := bytes.ReplaceAll(key, permUserPlaceholder, name)
+	switch {
+	case len(rangeEnd) == 0 || isOpenEnded(rangeEnd):
+	case bytes.Equal(rangeEnd, prefixEnd(key)):
+		rangeEnd = prefixEnd(expanded)
+	default:
+		rangeEnd = bytes.ReplaceAll(rangeEnd, permUserPlaceholder, name)
+	}
+	return expanded, rangeEnd
+}
+
+// prefixEnd returns the range end of the keys with the given prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i] = end[i] + 1
+			return end[:i+1]
+		}
+	}
+	// no prefix end exists, e.g. for 0xffff, so the range is open ended
+	return []byte{0}
+}
+
+// isDenied returns true if any part of ivl is covered by a deny permission.
+func isDenied(denies adt.IntervalTree, ivl adt.Interval) bool {
+	return denies != nil && denies.Intersects(ivl)
+}
+
 func checkKeyInterval(
 	lg *zap.Logger,
 	cachedPerms *unifiedRangePermissions,
@@ -85,9 +169,9 @@ func checkKeyInterval(
 	ivl := adt.NewBytesAffineInterval(key, rangeEnd)
 	switch permtyp {
 	case authpb.READ:
-		return cachedPerms.readPerms.Contains(ivl)
+		return cachedPerms.readPerms.Contains(ivl) && !isDenied(cachedPerms.readDenies, ivl)
 	case authpb.WRITE:
-		return cachedPerms.writePerms.Contains(ivl)
+		return cachedPerms.writePerms.Contains(ivl) && !isDenied(cachedPerms.writeDenies, ivl)
 	default:
 		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
 	}
@@ -98,9 +182,9 @@ func checkKeyPoint(lg *zap.Logger, cachedPerms *unifiedRangePermissions, key []b
 	pt := adt.NewBytesAffinePoint(key)
 	switch permtyp {
 	case authpb.READ:
-		return cachedPerms.readPerms.Intersects(pt)
+		return cachedPerms.readPerms.Intersects(pt) && !isDenied(cachedPerms.readDenies, pt)
 	case authpb.WRITE:
-		return cachedPerms.writePerms.Intersects(pt)
+		return cachedPerms.writePerms.Intersects(pt) && !isDenied(cachedPerms.writeDenies, pt)
 	default:
 		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
 	}
@@ -154,6 +238,11 @@ func (as *authStore) refreshRangePermCache(tx backend.ReadTx) {
 type unifiedRangePermissions struct {
 	readPerms  adt.IntervalTree
 	writePerms adt.IntervalTree
+	// readDenies and writeDenies hold the deny permissions of all the roles
+	// of a user. A range is permitted only if it is covered by the grants
+	// and does not overlap with any denial.
+	readDenies  adt.IntervalTree
+	writeDenies adt.IntervalTree
 }
 
 // Constraints related to key range
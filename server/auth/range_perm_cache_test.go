@@ -131,6 +131,43 @@ func TestKeyPermission(t *testing.T) {
 	}
 }
 
+func TestDenyPermission(t *testing.T) {
+	readPerms := adt.NewIntervalTree()
+	readPerms.Insert(adt.NewBytesAffineInterval([]byte("a"), []byte("z")), struct{}{})
+	readDenies := adt.NewIntervalTree()
+	readDenies.Insert(adt.NewBytesAffineInterval([]byte("c"), []byte("d")), struct{}{})
+	readDenies.Insert(adt.NewBytesAffinePoint([]byte("x")), struct{}{})
+	perms := &unifiedRangePermissions{readPerms: readPerms, readDenies: readDenies}
+
+	tests := []struct {
+		begin []byte
+		end   []byte
+		want  bool
+	}{
+		{[]byte("b"), nil, true},
+		{[]byte("c"), nil, false},
+		{[]byte("cc"), nil, false},
+		{[]byte("d"), nil, true},
+		{[]byte("x"), nil, false},
+		{[]byte("a"), []byte("c"), true},
+		{[]byte("a"), []byte("cc"), false},
+		{[]byte("d"), []byte("x"), true},
+		{[]byte("d"), []byte("y"), false},
+	}
+
+	for i, tt := range tests {
+		var result bool
+		if len(tt.end) == 0 {
+			result = checkKeyPoint(zap.NewExample(), perms, tt.begin, authpb.READ)
+		} else {
+			result = checkKeyInterval(zap.NewExample(), perms, tt.begin, tt.end, authpb.READ)
+		}
+		if result != tt.want {
+			t.Errorf("#%d: result=%t, want=%t", i, result, tt.want)
+		}
+	}
+}
+
 func TestRangeCheck(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -215,3 +252,26 @@ func TestRangeCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandPermUser(t *testing.T) {
+	tests := []struct {
+		user, key, rangeEnd   string
+		wantKey, wantRangeEnd string
+	}{
+		{"foo", "/a/{user}/", "/a/{user}0", "/a/foo/", "/a/foo0"},
+		{"foo", "/a/{user}", "/a/{user~", "/a/foo", "/a/fop"},
+		{"foo", "/a/{user}", "", "/a/foo", ""},
+		{"foo", "/a/{user}/", "\x00", "/a/foo/", "\x00"},
+		{"foo", "/a/", "/a0", "/a/", "/a0"},
+		// the names cannot reach into the subtree of another user
+		{"foo/bar", "/a/{user}/", "/a/{user}0", "/a/foo%2Fbar/", "/a/foo%2Fbar0"},
+		{"{user}", "/a/{user}/", "/a/{user}0", "/a/%7Buser%7D/", "/a/%7Buser%7D0"},
+		{"foo%2Fbar", "/a/{user}", "", "/a/foo%252Fbar", ""},
+	}
+	for i, tt := range tests {
+		key, rangeEnd := expandPermUser([]byte(tt.key), []byte(tt.rangeEnd), tt.user)
+		if string(key) != tt.wantKey || string(rangeEnd) != tt.wantRangeEnd {
+			t.Errorf("#%d: got [%q, %q), want [%q, %q)", i, key, rangeEnd, tt.wantKey, tt.wantRangeEnd)
+		}
+	}
+}
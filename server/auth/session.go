@@ -0,0 +1,273 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+	"go.etcd.io/etcd/server/v3/mvcc/buckets"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// sessionMaxPerUser is the number of sessions of a user kept in the
+	// registry. The oldest sessions of the user are dropped above it; their
+	// tokens are still revoked when all the sessions of the user are.
+	sessionMaxPerUser = 100
+
+	// sessionExpiryGrace delays the removal of the expired sessions from the
+	// registry, since the expiration of the tokens is checked with the clock
+	// of each member.
+	sessionExpiryGrace = 5 * time.Minute
+
+	// sessionMaxAge bounds the time the sessions without an expiration, like
+	// the sessions of the simple tokens, are kept in the registry. Their
+	// tokens are refreshed on the member they are used with, which the other
+	// members cannot tell. A token still in use past this age is only
+	// revoked with all the sessions of its user.
+	sessionMaxAge = 24 * time.Hour
+)
+
+// sessionRegistry is the in-memory copy of the sessions stored in the
+// buckets authSessions and authSessionRevocations. The sessions are
+// replicated: they are recorded and revoked when the requests are applied.
+//
+// The ID of a session is the index of the raft entry of its authentication.
+// A simple token ends with this index, a JWT carries it as the "sid" claim.
+type sessionRegistry struct {
+	mu       sync.RWMutex
+	sessions map[uint64]*authpb.Session
+	// revokedBefore maps the users whose sessions were all revoked to the
+	// ID of the last session issued at that time. The sessions of the user
+	// up to this ID are revoked.
+	revokedBefore map[string]uint64
+	// lastID is the ID of the last recorded session.
+	lastID uint64
+}
+
+func (as *authStore) loadSessions(tx backend.ReadTx) {
+	sessions := make(map[uint64]*authpb.Session)
+	err := tx.UnsafeForEach(buckets.AuthSessions, func(_, v []byte) error {
+		s := &authpb.Session{}
+		if err := s.Unmarshal(v); err != nil {
+			return err
+		}
+		sessions[s.ID] = s
+		return nil
+	})
+	if err != nil {
+		as.lg.Panic("failed to unmarshal 'authpb.Session'", zap.Error(err))
+	}
+
+	revokedBefore := make(map[string]uint64)
+	tx.UnsafeForEach(buckets.AuthSessionRevocations, func(k, v []byte) error {
+		revokedBefore[string(k)] = binary.BigEndian.Uint64(v)
+		return nil
+	})
+
+	var lastID uint64
+	if _, vs := tx.UnsafeRange(buckets.Auth, lastSessionIDKey, nil, 0); len(vs) == 1 {
+		lastID = binary.BigEndian.Uint64(vs[0])
+	}
+
+	as.sessions.mu.Lock()
+	as.sessions.sessions = sessions
+	as.sessions.revokedBefore = revokedBefore
+	as.sessions.lastID = lastID
+	as.sessions.mu.Unlock()
+}
+
+// recordSession adds a session to the registry. The stale sessions are
+// removed, and the oldest sessions of the user above sessionMaxPerUser.
+func (as *authStore) recordSession(tx backend.BatchTx, s *authpb.Session) {
+	as.sessions.mu.Lock()
+	defer as.sessions.mu.Unlock()
+
+	now := time.Unix(s.IssuedAt, 0)
+	var owned []*authpb.Session
+	for _, o := range as.sessions.sessions {
+		if isSessionStale(o, now) {
+			as.delSessionLocked(tx, o)
+			continue
+		}
+		if o.Username == s.Username {
+			owned = append(owned, o)
+		}
+	}
+	if len(owned) >= sessionMaxPerUser {
+		sort.Slice(owned, func(i, j int) bool { return owned[i].ID < owned[j].ID })
+		for _, o := range owned[:len(owned)-sessionMaxPerUser+1] {
+			as.delSessionLocked(tx, o)
+		}
+	}
+
+	as.putSessionLocked(tx, s)
+	if s.ID > as.sessions.lastID {
+		as.sessions.lastID = s.ID
+		idBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(idBytes, s.ID)
+		tx.UnsafePut(buckets.Auth, lastSessionIDKey, idBytes)
+	}
+}
+
+// isSessionStale reports whether a session is to be removed from the
+// registry at the time a session is recorded.
+func isSessionStale(s *authpb.Session, now time.Time) bool {
+	if s.ExpiresAt == 0 {
+		return now.After(time.Unix(s.IssuedAt, 0).Add(sessionMaxAge))
+	}
+	return now.After(time.Unix(s.ExpiresAt, 0).Add(sessionExpiryGrace))
+}
+
+func (as *authStore) putSessionLocked(tx backend.BatchTx, s *authpb.Session) {
+	b, err := s.Marshal()
+	if err != nil {
+		as.lg.Panic("failed to marshal 'authpb.Session'", zap.Uint64("session-id", s.ID), zap.Error(err))
+	}
+	// the backends of the snapshots sent by the members of older versions
+	// lack the bucket
+	tx.UnsafeCreateBucket(buckets.AuthSessions)
+	tx.UnsafePut(buckets.AuthSessions, sessionKey(s.ID), b)
+	as.sessions.sessions[s.ID] = s
+}
+
+func (as *authStore) delSessionLocked(tx backend.BatchTx, s *authpb.Session) {
+	tx.UnsafeDelete(buckets.AuthSessions, sessionKey(s.ID))
+	delete(as.sessions.sessions, s.ID)
+}
+
+func sessionKey(id uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, id)
+	return k
+}
+
+// revokeSession revokes a session of a user. A simple token is invalidated
+// right away. A JWT remains valid by itself until it expires, so its session
+// is kept in the registry as revoked until then.
+func (as *authStore) revokeSession(tx backend.BatchTx, username string, id uint64) error {
+	as.sessions.mu.Lock()
+	defer as.sessions.mu.Unlock()
+
+	s, ok := as.sessions.sessions[id]
+	if !ok || s.Username != username || s.Revoked {
+		return ErrSessionNotFound
+	}
+	if s.ExpiresAt == 0 {
+		as.delSessionLocked(tx, s)
+		as.tokenProvider.invalidateSession(id)
+		return nil
+	}
+	revoked := *s
+	revoked.Revoked = true
+	as.putSessionLocked(tx, &revoked)
+	return nil
+}
+
+// revokeUserSessions revokes all the sessions of a user, including the
+// sessions dropped from the registry.
+func (as *authStore) revokeUserSessions(tx backend.BatchTx, username string) {
+	as.sessions.mu.Lock()
+	defer as.sessions.mu.Unlock()
+
+	for _, s := range as.sessions.sessions {
+		if s.Username == username {
+			as.delSessionLocked(tx, s)
+		}
+	}
+	if as.sessions.lastID > as.sessions.revokedBefore[username] {
+		as.sessions.revokedBefore[username] = as.sessions.lastID
+		idBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(idBytes, as.sessions.lastID)
+		tx.UnsafeCreateBucket(buckets.AuthSessionRevocations)
+		tx.UnsafePut(buckets.AuthSessionRevocations, []byte(username), idBytes)
+	}
+	as.tokenProvider.invalidateUser(username)
+}
+
+// isSessionRevoked checks the session of the token of authInfo against the
+// revoked sessions. Tokens without a session, like the external tokens, are
+// not revoked this way.
+func (as *authStore) isSessionRevoked(authInfo *AuthInfo) bool {
+	if authInfo.SessionID == 0 {
+		return false
+	}
+	as.sessions.mu.RLock()
+	defer as.sessions.mu.RUnlock()
+	if s, ok := as.sessions.sessions[authInfo.SessionID]; ok && s.Revoked {
+		return true
+	}
+	return authInfo.SessionID <= as.sessions.revokedBefore[authInfo.Username]
+}
+
+func (as *authStore) SessionList(r *pb.AuthSessionListRequest) (*pb.AuthSessionListResponse, error) {
+	tx := as.be.ReadTx()
+	tx.Lock()
+	user := getUser(as.lg, tx, r.Name)
+	tx.Unlock()
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	now := time.Now().Unix()
+	resp := &pb.AuthSessionListResponse{}
+	as.sessions.mu.RLock()
+	for _, s := range as.sessions.sessions {
+		if s.Username != r.Name || s.Revoked {
+			continue
+		}
+		// the tokens without an expiration are listed while this member
+		// holds them
+		if (s.ExpiresAt > 0 && s.ExpiresAt <= now) || (s.ExpiresAt == 0 && !as.tokenProvider.hasSession(s.ID)) {
+			continue
+		}
+		resp.Sessions = append(resp.Sessions, s)
+	}
+	as.sessions.mu.RUnlock()
+	sort.Slice(resp.Sessions, func(i, j int) bool { return resp.Sessions[i].ID < resp.Sessions[j].ID })
+	return resp, nil
+}
+
+func (as *authStore) SessionRevoke(r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error) {
+	tx := as.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+
+	if getUser(as.lg, tx, r.Name) == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// the revision is bumped so that the requests authorized with a revoked
+	// token before the revocation fail with ErrAuthOldRevision
+	if r.ID == 0 {
+		as.revokeUserSessions(tx, r.Name)
+		as.commitRevision(tx)
+		as.lg.Info("revoked all the sessions of a user", zap.String("user-name", r.Name))
+		return &pb.AuthSessionRevokeResponse{}, nil
+	}
+	if err := as.revokeSession(tx, r.Name, r.ID); err != nil {
+		return nil, err
+	}
+	as.commitRevision(tx)
+	as.lg.Info("revoked a session of a user", zap.String("user-name", r.Name), zap.Uint64("session-id", r.ID))
+	return &pb.AuthSessionRevokeResponse{}, nil
+}
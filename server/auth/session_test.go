@@ -0,0 +1,230 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	betesting "go.etcd.io/etcd/server/v3/mvcc/backend/testing"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+)
+
+func authenticateSession(t *testing.T, as *authStore, username string, index uint64, issuedAt time.Time) string {
+	ctx := context.WithValue(context.TODO(), AuthenticateParamIndex{}, index)
+	ctx = context.WithValue(ctx, AuthenticateParamSimpleTokenPrefix{}, "dummy")
+	ctx = context.WithValue(ctx, AuthenticateParamSession{}, &authpb.Session{
+		IssuedAt:      issuedAt.Unix(),
+		SourceAddress: "127.0.0.1",
+		MemberId:      1,
+		ExpiresAt:     expiresAt(as, issuedAt),
+	})
+	resp, err := as.Authenticate(ctx, username, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Token
+}
+
+// expiresAt mirrors the expiration the issuing member puts in the
+// authentication request.
+func expiresAt(as *authStore, issuedAt time.Time) int64 {
+	if ttl := as.SessionTTL(); ttl > 0 {
+		return issuedAt.Add(ttl).Unix()
+	}
+	return 0
+}
+
+func authInfoFromToken(as *authStore, token string) (*AuthInfo, error) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{rpctypes.TokenFieldNameGRPC: token}))
+	return as.AuthInfoFromCtx(ctx)
+}
+
+func sessionIDs(t *testing.T, as *authStore, username string) []uint64 {
+	resp, err := as.SessionList(&pb.AuthSessionListRequest{Name: username})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []uint64
+	for _, s := range resp.Sessions {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func TestSessionRevokeSimpleToken(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	now := time.Now()
+	token1 := authenticateSession(t, as, "foo", 10, now)
+	token2 := authenticateSession(t, as, "foo", 11, now)
+	if ids := sessionIDs(t, as, "foo"); len(ids) != 2 || ids[0] != 10 || ids[1] != 11 {
+		t.Fatalf("unexpected sessions %v", ids)
+	}
+
+	ai, err := authInfoFromToken(as, token2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.SessionRevoke(&pb.AuthSessionRevokeRequest{Name: "foo", ID: 10}); err != nil {
+		t.Fatal(err)
+	}
+	// the requests authorized before the revocation are retried
+	if err = as.IsPutPermitted(ai, []byte("foo")); err != ErrAuthOldRevision {
+		t.Fatalf("expected %v, got %v", ErrAuthOldRevision, err)
+	}
+	if _, err := authInfoFromToken(as, token1); err != ErrInvalidAuthToken {
+		t.Fatalf("expected %v, got %v", ErrInvalidAuthToken, err)
+	}
+	if _, err := authInfoFromToken(as, token2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := as.SessionRevoke(&pb.AuthSessionRevokeRequest{Name: "foo", ID: 10}); err != ErrSessionNotFound {
+		t.Fatalf("expected %v, got %v", ErrSessionNotFound, err)
+	}
+	// the session of another user cannot be revoked through this user
+	if _, err := as.SessionRevoke(&pb.AuthSessionRevokeRequest{Name: "foo-no-user-options", ID: 11}); err != ErrSessionNotFound {
+		t.Fatalf("expected %v, got %v", ErrSessionNotFound, err)
+	}
+
+	if _, err := as.SessionRevoke(&pb.AuthSessionRevokeRequest{Name: "foo"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := authInfoFromToken(as, token2); err != ErrInvalidAuthToken {
+		t.Fatalf("expected %v, got %v", ErrInvalidAuthToken, err)
+	}
+	if ids := sessionIDs(t, as, "foo"); len(ids) != 0 {
+		t.Fatalf("unexpected sessions %v", ids)
+	}
+}
+
+func TestSessionRevokeJWT(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	tp, err := NewTokenProvider(zap.NewExample(), "jwt,pub-key="+jwtRSAPubKey+",priv-key="+jwtRSAPrivKey+",sign-method=RS256,ttl=1h", dummyIndexWaiter, simpleTokenTTLDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	as := NewAuthStore(zap.NewExample(), b, tp, bcrypt.MinCost)
+	defer as.Close()
+	if err = enableAuthAndCreateRoot(as); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.UserAdd(&pb.AuthUserAddRequest{Name: "foo", HashedPassword: encodePassword("bar"), Options: &authpb.UserAddOptions{NoPassword: false}}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	token1 := authenticateSession(t, as, "foo", 10, now)
+	token2 := authenticateSession(t, as, "foo", 11, now)
+	token3 := authenticateSession(t, as, "foo", 12, now)
+
+	ai, err := authInfoFromToken(as, token1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ai.SessionID != 10 {
+		t.Fatalf("expected session 10, got %d", ai.SessionID)
+	}
+
+	// a JWT stays valid by itself, it is rejected by the revocation list
+	if _, err = as.SessionRevoke(&pb.AuthSessionRevokeRequest{Name: "foo", ID: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = authInfoFromToken(as, token1); err != ErrInvalidAuthToken {
+		t.Fatalf("expected %v, got %v", ErrInvalidAuthToken, err)
+	}
+	if ids := sessionIDs(t, as, "foo"); len(ids) != 2 {
+		t.Fatalf("unexpected sessions %v", ids)
+	}
+
+	// the revocations are restored with the registry
+	as.Recover(b)
+	if _, err = authInfoFromToken(as, token1); err != ErrInvalidAuthToken {
+		t.Fatalf("expected %v, got %v", ErrInvalidAuthToken, err)
+	}
+
+	// changing the password revokes all the sessions, including the
+	// sessions dropped from the registry
+	as.sessions.mu.Lock()
+	delete(as.sessions.sessions, 11)
+	as.sessions.mu.Unlock()
+	if _, err = as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "foo", HashedPassword: encodePassword("baz")}); err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range []string{token2, token3} {
+		if _, err = authInfoFromToken(as, token); err != ErrInvalidAuthToken {
+			t.Fatalf("expected %v, got %v", ErrInvalidAuthToken, err)
+		}
+	}
+
+	token4 := authenticateSession(t, as, "foo", 13, now)
+	if _, err = authInfoFromToken(as, token4); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSessionRecordPrune(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	now := time.Now()
+	for i := uint64(1); i <= sessionMaxPerUser+5; i++ {
+		authenticateSession(t, as, "foo", i, now)
+	}
+	ids := sessionIDs(t, as, "foo")
+	if len(ids) != sessionMaxPerUser || ids[0] != 6 {
+		t.Fatalf("expected %d sessions from 6, got %d from %v", sessionMaxPerUser, len(ids), ids[0])
+	}
+
+	// expired sessions are dropped when a session is recorded
+	as.sessions.mu.Lock()
+	as.sessions.sessions[1] = &authpb.Session{ID: 1, Username: "foo-no-user-options", IssuedAt: now.Add(-time.Hour).Unix(), ExpiresAt: now.Add(-time.Hour).Unix()}
+	as.sessions.mu.Unlock()
+	authenticateSession(t, as, "foo", sessionMaxPerUser+6, now)
+	as.sessions.mu.RLock()
+	_, ok := as.sessions.sessions[1]
+	as.sessions.mu.RUnlock()
+	if ok {
+		t.Fatal("expected the expired session to be dropped")
+	}
+}
+
+func TestSessionSimpleTokenLifetime(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	now := time.Now()
+	authenticateSession(t, as, "foo", 10, now.Add(-sessionMaxAge-time.Minute))
+	authenticateSession(t, as, "foo", 11, now)
+	if ids := sessionIDs(t, as, "foo"); len(ids) != 1 || ids[0] != 11 {
+		t.Fatalf("expected the session past the max age to be dropped, got %v", ids)
+	}
+
+	// the session is not listed once the token expired on this member
+	authenticateSession(t, as, "foo", 12, now)
+	as.tokenProvider.invalidateSession(12)
+	if ids := sessionIDs(t, as, "foo"); len(ids) != 1 || ids[0] != 11 {
+		t.Fatalf("unexpected sessions %v", ids)
+	}
+}
@@ -155,6 +155,36 @@ func (t *tokenSimple) invalidateUser(username string) {
 	t.simpleTokensMu.Unlock()
 }
 
+func (t *tokenSimple) invalidateSession(id uint64) {
+	if t.simpleTokenKeeper == nil {
+		return
+	}
+	suffix := "." + strconv.FormatUint(id, 10)
+	t.simpleTokensMu.Lock()
+	for token := range t.simpleTokens {
+		if strings.HasSuffix(token, suffix) {
+			delete(t.simpleTokens, token)
+			t.simpleTokenKeeper.deleteSimpleToken(token)
+		}
+	}
+	t.simpleTokensMu.Unlock()
+}
+
+func (t *tokenSimple) hasSession(id uint64) bool {
+	suffix := "." + strconv.FormatUint(id, 10)
+	t.simpleTokensMu.Lock()
+	defer t.simpleTokensMu.Unlock()
+	for token := range t.simpleTokens {
+		if strings.HasSuffix(token, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionTTL is zero since the simple tokens are refreshed while they are used.
+func (t *tokenSimple) sessionTTL() time.Duration { return 0 }
+
 func (t *tokenSimple) enable() {
 	t.simpleTokensMu.Lock()
 	defer t.simpleTokensMu.Unlock()
@@ -46,6 +46,8 @@ var (
 
 	revisionKey = []byte("authRevision")
 
+	lastSessionIDKey = []byte("lastSessionID")
+
 	ErrRootUserNotExist     = errors.New("auth: root user does not exist")
 	ErrRootRoleNotExist     = errors.New("auth: root user does not have root role")
 	ErrUserAlreadyExist     = errors.New("auth: user already exists")
@@ -57,9 +59,13 @@ var (
 	ErrPermissionNotGiven   = errors.New("auth: permission not given")
 	ErrAuthFailed           = errors.New("auth: authentication failed, invalid user ID or password")
 	ErrNoPasswordUser       = errors.New("auth: authentication failed, password was given for no password user")
+	ErrAuthLocked           = errors.New("auth: authentication is temporarily locked after too many failed attempts")
+	ErrPasswordTooWeak      = errors.New("auth: password does not satisfy the password policy")
+	ErrPasswordReused       = errors.New("auth: password was used recently")
 	ErrPermissionDenied     = errors.New("auth: permission denied")
 	ErrRoleNotGranted       = errors.New("auth: role is not granted to the user")
 	ErrPermissionNotGranted = errors.New("auth: permission is not granted to the role")
+	ErrSessionNotFound      = errors.New("auth: session not found")
 	ErrAuthNotEnabled       = errors.New("auth: authentication is not enabled")
 	ErrAuthOldRevision      = errors.New("auth: revision in header is old")
 	ErrInvalidAuthToken     = errors.New("auth: invalid auth token")
@@ -77,6 +83,7 @@ const (
 
 	tokenTypeSimple = "simple"
 	tokenTypeJWT    = "jwt"
+	tokenTypeOIDC   = "oidc"
 
 	revBytesLen = 8
 )
@@ -84,6 +91,16 @@ const (
 type AuthInfo struct {
 	Username string
 	Revision uint64
+	// Roles are granted to the user by its auth token or its client
+	// certificate, in addition to the roles of the user stored in etcd.
+	// The user does not need to exist if it is granted roles this way.
+	Roles []string
+	// SessionID is the ID of the session of the auth token, zero if the
+	// token is not bound to a session of the registry.
+	SessionID uint64
+	// Authorized are the key ranges of the request allowed by the
+	// authorizer. Their permissions are then not checked.
+	Authorized []*pb.AuthorizedRange
 }
 
 // AuthenticateParamIndex is used for a key of context in the parameters of Authenticate()
@@ -92,6 +109,10 @@ type AuthenticateParamIndex struct{}
 // AuthenticateParamSimpleTokenPrefix is used for a key of context in the parameters of Authenticate()
 type AuthenticateParamSimpleTokenPrefix struct{}
 
+// AuthenticateParamSession is used for a key of context in the parameters of Authenticate(),
+// its value is the *authpb.Session recorded for the authenticated user
+type AuthenticateParamSession struct{}
+
 // AuthStore defines auth storage interface.
 type AuthStore interface {
 	// AuthEnable turns on the authentication feature
@@ -127,6 +148,15 @@ type AuthStore interface {
 	// UserRevokeRole revokes a role of a user
 	UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
 
+	// UserUnlock clears the failed authentication attempts and the lockout of a user
+	UserUnlock(r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error)
+
+	// SessionList gets the active sessions of a user
+	SessionList(r *pb.AuthSessionListRequest) (*pb.AuthSessionListResponse, error)
+
+	// SessionRevoke revokes a session, or all the sessions, of a user
+	SessionRevoke(r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error)
+
 	// RoleAdd adds a new role
 	RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 
@@ -160,15 +190,47 @@ type AuthStore interface {
 	// IsAdminPermitted checks admin permission of the user
 	IsAdminPermitted(authInfo *AuthInfo) error
 
+	// IsOperationPermitted checks the permission of the user to perform an
+	// operation outside of the key space
+	IsOperationPermitted(authInfo *AuthInfo, op authpb.OperationPermission_Type) error
+
 	// GenTokenPrefix produces a random string in a case of simple token
 	// in a case of JWT, it produces an empty string
 	GenTokenPrefix() (string, error)
 
+	// SessionTTL returns the lifetime of the tokens of this member, or zero
+	// if they are refreshed while they are used
+	SessionTTL() time.Duration
+
 	// Revision gets current revision of authStore
 	Revision() uint64
 
-	// CheckPassword checks a given pair of username and password is correct
-	CheckPassword(username, password string) (uint64, error)
+	// CheckPassword checks a given pair of username and password is correct.
+	// addr is the source address of the attempt, used by the lockout policy.
+	CheckPassword(username, password, addr string) (uint64, error)
+
+	// CheckPasswordPolicy checks a new password of a user against the password policy
+	CheckPasswordPolicy(username, password string) error
+
+	// SetPasswordPolicy sets the policy of the passwords of the users
+	SetPasswordPolicy(p PasswordPolicy)
+
+	// SetLockoutPolicy sets the lockout policy of failed authentications
+	SetLockoutPolicy(p LockoutPolicy)
+
+	// SetTLSIdentity sets the attributes of the client certificates used by AuthInfoFromTLS
+	SetTLSIdentity(id TLSIdentity)
+
+	// SetAuthorizer sets the authorizer consulted by Authorize
+	SetAuthorizer(a Authorizer)
+
+	// Authorize consults the authorizer on the key ranges of a request. It
+	// returns ErrPermissionDenied if the authorizer denies a key range, or
+	// fails, and sets authInfo.Authorized to the key ranges it allows. The
+	// permissions of the other key ranges are checked against the roles of
+	// the user. The authorizer is not consulted for the users with the root
+	// role.
+	Authorize(ctx context.Context, authInfo *AuthInfo, attrs []Attributes) error
 
 	// Close does cleanup of AuthStore
 	Close() error
@@ -196,6 +258,16 @@ type TokenProvider interface {
 	disable()
 
 	invalidateUser(string)
+	// invalidateSession invalidates the token of a session, if the
+	// provider keeps track of its tokens.
+	invalidateSession(id uint64)
+	// hasSession reports whether the token of a session is still held by
+	// this member. It is asked only for the tokens refreshed while they
+	// are used, the others expire with their session.
+	hasSession(id uint64) bool
+	// sessionTTL returns the lifetime of the tokens, or zero if they are
+	// refreshed while they are used.
+	sessionTTL() time.Duration
 	genTokenPrefix() (string, error)
 }
 
@@ -219,6 +291,13 @@ type authStore struct {
 
 	tokenProvider TokenProvider
 	bcryptCost    int // the algorithm cost / strength for hashing auth passwords
+
+	passwordPolicy PasswordPolicy
+	lockout        *lockoutTracker
+	tlsIdentity    TLSIdentity
+	authorizer     *authorizerCache
+
+	sessions sessionRegistry
 }
 
 func (as *authStore) AuthEnable() error {
@@ -314,6 +393,13 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 		return nil, err
 	}
 
+	// the entries proposed by older versions do not describe the session
+	if s, ok := ctx.Value(AuthenticateParamSession{}).(*authpb.Session); ok && s.IssuedAt != 0 {
+		s.ID, _ = ctx.Value(AuthenticateParamIndex{}).(uint64)
+		s.Username = username
+		as.recordSession(tx, s)
+	}
+
 	as.lg.Debug(
 		"authenticated a user",
 		zap.String("user-name", username),
@@ -322,11 +408,21 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 	return &pb.AuthenticateResponse{Token: token}, nil
 }
 
-func (as *authStore) CheckPassword(username, password string) (uint64, error) {
+func (as *authStore) CheckPassword(username, password, addr string) (uint64, error) {
 	if !as.IsAuthEnabled() {
 		return 0, ErrAuthNotEnabled
 	}
 
+	if until := as.lockout.lockedUntil(username, addr); !until.IsZero() {
+		as.lg.Warn(
+			"authentication is locked",
+			zap.String("user-name", username),
+			zap.String("address", addr),
+			zap.Time("locked-until", until),
+		)
+		return 0, ErrAuthLocked
+	}
+
 	var user *authpb.User
 	// CompareHashAndPassword is very expensive, so we use closures
 	// to avoid putting it in the critical section of the tx lock.
@@ -347,16 +443,25 @@ func (as *authStore) CheckPassword(username, password string) (uint64, error) {
 		return getRevision(tx), nil
 	}()
 	if err != nil {
+		as.lockout.fail(username, addr)
 		return 0, err
 	}
 
 	if bcrypt.CompareHashAndPassword(user.Password, []byte(password)) != nil {
 		as.lg.Info("invalid password", zap.String("user-name", username))
+		as.lockout.fail(username, addr)
 		return 0, ErrAuthFailed
 	}
+	as.lockout.reset(username)
 	return revision, nil
 }
 
+func (as *authStore) SetPasswordPolicy(p PasswordPolicy) { as.passwordPolicy = p }
+
+func (as *authStore) SetLockoutPolicy(p LockoutPolicy) { as.lockout.setPolicy(p) }
+
+func (as *authStore) SetTLSIdentity(id TLSIdentity) { as.tlsIdentity = id }
+
 func (as *authStore) Recover(be backend.Backend) {
 	enabled := false
 	as.be = be
@@ -371,6 +476,7 @@ func (as *authStore) Recover(be backend.Backend) {
 
 	as.setRevision(getRevision(tx))
 	as.refreshRangePermCache(tx)
+	as.loadSessions(tx)
 
 	tx.Unlock()
 
@@ -456,7 +562,8 @@ func (as *authStore) UserDelete(r *pb.AuthUserDeleteRequest) (*pb.AuthUserDelete
 	as.commitRevision(tx)
 	as.refreshRangePermCache(tx)
 
-	as.tokenProvider.invalidateUser(r.Name)
+	as.revokeUserSessions(tx, r.Name)
+	as.lockout.reset(r.Name)
 
 	as.lg.Info(
 		"deleted a user",
@@ -493,13 +600,19 @@ func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*p
 		Password: password,
 		Options:  user.Options,
 	}
+	// the current password is kept in the history, which does not include
+	// the new password
+	if r.PasswordHistory > 1 {
+		updatedUser.PasswordHistory = passwordHistory(user, int(r.PasswordHistory)-1)
+	}
 
 	putUser(as.lg, tx, updatedUser)
 
 	as.commitRevision(tx)
 	as.refreshRangePermCache(tx)
 
-	as.tokenProvider.invalidateUser(r.Name)
+	as.revokeUserSessions(tx, r.Name)
+	as.lockout.reset(r.Name)
 
 	as.lg.Info(
 		"changed a password of a user",
@@ -566,9 +679,31 @@ func (as *authStore) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse,
 
 	var resp pb.AuthUserGetResponse
 	resp.Roles = append(resp.Roles, user.Roles...)
+
+	failures, until := as.lockout.status(r.Name)
+	resp.FailedAttempts = int64(failures)
+	if !until.IsZero() {
+		resp.LockedUntil = until.Unix()
+	}
 	return &resp, nil
 }
 
+func (as *authStore) UserUnlock(r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error) {
+	tx := as.be.BatchTx()
+	tx.LockInsideApply()
+	user := getUser(as.lg, tx, r.Name)
+	tx.Unlock()
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	as.lockout.reset(r.Name)
+
+	as.lg.Info("unlocked a user", zap.String("user-name", r.Name))
+	return &pb.AuthUserUnlockResponse{}, nil
+}
+
 func (as *authStore) UserList(r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error) {
 	tx := as.be.BatchTx()
 	tx.LockInsideApply()
@@ -644,6 +779,7 @@ func (as *authStore) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse,
 		return nil, ErrRoleNotFound
 	}
 	resp.Perm = append(resp.Perm, role.KeyPermission...)
+	resp.OperationPerm = append(resp.OperationPerm, role.OperationPermission...)
 	return &resp, nil
 }
 
@@ -670,8 +806,13 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 		return nil, ErrRoleNotFound
 	}
 
+	if r.OperationPerm != nil {
+		return as.roleRevokeOperationPermission(tx, role, r.OperationPerm.Operation)
+	}
+
 	updatedRole := &authpb.Role{
-		Name: role.Name,
+		Name:                role.Name,
+		OperationPermission: role.OperationPermission,
 	}
 
 	for _, perm := range role.KeyPermission {
@@ -698,6 +839,25 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 	return &pb.AuthRoleRevokePermissionResponse{}, nil
 }
 
+func (as *authStore) roleRevokeOperationPermission(tx backend.BatchTx, role *authpb.Role, op authpb.OperationPermission_Type) (*pb.AuthRoleRevokePermissionResponse, error) {
+	idx := operationPermissionIndex(role, op)
+	if idx < 0 {
+		return nil, ErrPermissionNotGranted
+	}
+	role.OperationPermission = append(role.OperationPermission[:idx], role.OperationPermission[idx+1:]...)
+
+	putRole(as.lg, tx, role)
+
+	as.commitRevision(tx)
+
+	as.lg.Info(
+		"revoked an operation permission",
+		zap.String("role-name", string(role.Name)),
+		zap.String("operation", op.String()),
+	)
+	return &pb.AuthRoleRevokePermissionResponse{}, nil
+}
+
 func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
 	if as.enabled && r.Role == rootRole {
 		as.lg.Error("cannot delete 'root' role", zap.String("role-name", r.Role))
@@ -789,6 +949,12 @@ func (perms permSlice) Swap(i, j int) {
 }
 
 func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
+	if r.OperationPerm != nil {
+		if r.Perm != nil {
+			return nil, ErrInvalidAuthMgmt
+		}
+		return as.roleGrantOperationPermission(r.Name, r.OperationPerm.Operation)
+	}
 	if r.Perm == nil {
 		return nil, ErrPermissionNotGiven
 	}
@@ -812,12 +978,14 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 	if idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key) && bytes.Equal(role.KeyPermission[idx].RangeEnd, r.Perm.RangeEnd) {
 		// update existing permission
 		role.KeyPermission[idx].PermType = r.Perm.PermType
+		role.KeyPermission[idx].Deny = r.Perm.Deny
 	} else {
 		// append new permission to the role
 		newPerm := &authpb.Permission{
 			Key:      r.Perm.Key,
 			RangeEnd: r.Perm.RangeEnd,
 			PermType: r.Perm.PermType,
+			Deny:     r.Perm.Deny,
 		}
 
 		role.KeyPermission = append(role.KeyPermission, newPerm)
@@ -833,62 +1001,129 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 		"granted/updated a permission to a user",
 		zap.String("user-name", r.Name),
 		zap.String("permission-name", authpb.Permission_Type_name[int32(r.Perm.PermType)]),
+		zap.Bool("deny", r.Perm.Deny),
 	)
 	return &pb.AuthRoleGrantPermissionResponse{}, nil
 }
 
-func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
+func (as *authStore) roleGrantOperationPermission(name string, op authpb.OperationPermission_Type) (*pb.AuthRoleGrantPermissionResponse, error) {
+	if _, ok := authpb.OperationPermission_Type_name[int32(op)]; !ok {
+		return nil, ErrInvalidAuthMgmt
+	}
+
+	tx := as.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+
+	role := getRole(as.lg, tx, name)
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+
+	if operationPermissionIndex(role, op) < 0 {
+		role.OperationPermission = append(role.OperationPermission, &authpb.OperationPermission{Operation: op})
+		sort.Slice(role.OperationPermission, func(i, j int) bool {
+			return role.OperationPermission[i].Operation < role.OperationPermission[j].Operation
+		})
+	}
+
+	putRole(as.lg, tx, role)
+
+	as.commitRevision(tx)
+
+	as.lg.Info(
+		"granted an operation permission to a role",
+		zap.String("role-name", name),
+		zap.String("operation", op.String()),
+	)
+	return &pb.AuthRoleGrantPermissionResponse{}, nil
+}
+
+// operationPermissionIndex returns the index of the permission of op in
+// the role, or -1 if the role does not have it.
+func operationPermissionIndex(role *authpb.Role, op authpb.OperationPermission_Type) int {
+	for i, perm := range role.OperationPermission {
+		if perm.Operation == op {
+			return i
+		}
+	}
+	return -1
+}
+
+func (as *authStore) isOpPermitted(authInfo *AuthInfo, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
 	// TODO(mitake): this function would be costly so we need a caching mechanism
 	if !as.IsAuthEnabled() {
 		return nil
 	}
 
 	// only gets rev == 0 when passed AuthInfo{}; no user given
-	if revision == 0 {
+	if authInfo.Revision == 0 {
 		return ErrUserEmpty
 	}
 	rev := as.Revision()
-	if revision < rev {
+	if authInfo.Revision < rev {
 		as.lg.Warn("request auth revision is less than current node auth revision",
 			zap.Uint64("current node auth revision", rev),
-			zap.Uint64("request auth revision", revision),
+			zap.Uint64("request auth revision", authInfo.Revision),
 			zap.ByteString("request key", key),
 			zap.Error(ErrAuthOldRevision))
 		return ErrAuthOldRevision
 	}
 
+	if isAuthorized(authInfo, key, rangeEnd, permTyp) {
+		return nil
+	}
+
 	tx := as.be.ReadTx()
 	tx.Lock()
 	defer tx.Unlock()
 
+	userName := authInfo.Username
 	user := getUser(as.lg, tx, userName)
-	if user == nil {
+	if user == nil && len(authInfo.Roles) == 0 {
 		as.lg.Error("cannot find a user for permission check", zap.String("user-name", userName))
 		return ErrPermissionDenied
 	}
 
 	// root role should have permission on all ranges
-	if hasRootRole(user) {
+	if hasRootRole(user) || hasTokenRole(authInfo, rootRole) {
 		return nil
 	}
 
-	if as.isRangeOpPermitted(userName, key, rangeEnd, permTyp) {
-		return nil
+	if len(authInfo.Roles) == 0 {
+		if as.isRangeOpPermitted(userName, key, rangeEnd, permTyp) {
+			return nil
+		}
+		return ErrPermissionDenied
 	}
 
+	// The permissions of the roles granted by a token are merged for every
+	// check, as the roles vary between the tokens of a user.
+	roles := authInfo.Roles
+	if user != nil {
+		roles = append(append([]string{}, user.Roles...), roles...)
+	}
+	perms := mergeRolePerms(as.lg, tx, userName, roles)
+	if len(rangeEnd) == 0 {
+		if checkKeyPoint(as.lg, perms, key, permTyp) {
+			return nil
+		}
+	} else if checkKeyInterval(as.lg, perms, key, rangeEnd, permTyp) {
+		return nil
+	}
 	return ErrPermissionDenied
 }
 
 func (as *authStore) IsPutPermitted(authInfo *AuthInfo, key []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, nil, authpb.WRITE)
+	return as.isOpPermitted(authInfo, key, nil, authpb.WRITE)
 }
 
 func (as *authStore) IsRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.READ)
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.READ)
 }
 
 func (as *authStore) IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.WRITE)
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.WRITE)
 }
 
 func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
@@ -904,17 +1139,52 @@ func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
 	u := getUser(as.lg, tx, authInfo.Username)
 	tx.Unlock()
 
-	if u == nil {
+	if u == nil && len(authInfo.Roles) == 0 {
 		return ErrUserNotFound
 	}
 
-	if !hasRootRole(u) {
+	if !hasRootRole(u) && !hasTokenRole(authInfo, rootRole) {
 		return ErrPermissionDenied
 	}
 
 	return nil
 }
 
+func (as *authStore) IsOperationPermitted(authInfo *AuthInfo, op authpb.OperationPermission_Type) error {
+	if !as.IsAuthEnabled() {
+		return nil
+	}
+	if authInfo == nil || authInfo.Username == "" {
+		return ErrUserEmpty
+	}
+
+	tx := as.be.ReadTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	u := getUser(as.lg, tx, authInfo.Username)
+	if u == nil && len(authInfo.Roles) == 0 {
+		return ErrUserNotFound
+	}
+
+	// root role is permitted to perform every operation
+	if hasRootRole(u) || hasTokenRole(authInfo, rootRole) {
+		return nil
+	}
+
+	roles := authInfo.Roles
+	if u != nil {
+		roles = append(append([]string{}, u.Roles...), roles...)
+	}
+	for _, roleName := range roles {
+		role := getRole(as.lg, tx, roleName)
+		if role != nil && operationPermissionIndex(role, op) >= 0 {
+			return nil
+		}
+	}
+	return ErrPermissionDenied
+}
+
 func getUser(lg *zap.Logger, tx backend.ReadTx, username string) *authpb.User {
 	_, vs := tx.UnsafeRange(buckets.AuthUsers, []byte(username), nil, 0)
 	if len(vs) == 0 {
@@ -1049,6 +1319,8 @@ func NewAuthStore(lg *zap.Logger, be backend.Backend, tp TokenProvider, bcryptCo
 	tx.UnsafeCreateBucket(buckets.Auth)
 	tx.UnsafeCreateBucket(buckets.AuthUsers)
 	tx.UnsafeCreateBucket(buckets.AuthRoles)
+	tx.UnsafeCreateBucket(buckets.AuthSessions)
+	tx.UnsafeCreateBucket(buckets.AuthSessionRevocations)
 
 	enabled := false
 	_, vs := tx.UnsafeRange(buckets.Auth, enableFlagKey, nil, 0)
@@ -1066,6 +1338,8 @@ func NewAuthStore(lg *zap.Logger, be backend.Backend, tp TokenProvider, bcryptCo
 		rangePermCache: make(map[string]*unifiedRangePermissions),
 		tokenProvider:  tp,
 		bcryptCost:     bcryptCost,
+		lockout:        newLockoutTracker(),
+		tlsIdentity:    TLSIdentity{Username: CertAttributeCommonName},
 	}
 
 	if enabled {
@@ -1079,6 +1353,7 @@ func NewAuthStore(lg *zap.Logger, be backend.Backend, tp TokenProvider, bcryptCo
 	as.setupMetricsReporter()
 
 	as.refreshRangePermCache(tx)
+	as.loadSessions(tx)
 
 	tx.Unlock()
 	be.ForceCommit()
@@ -1087,11 +1362,36 @@ func NewAuthStore(lg *zap.Logger, be backend.Backend, tp TokenProvider, bcryptCo
 }
 
 func hasRootRole(u *authpb.User) bool {
+	if u == nil {
+		return false
+	}
 	// u.Roles is sorted in UserGrantRole(), so we can use binary search.
 	idx := sort.SearchStrings(u.Roles, rootRole)
 	return idx != len(u.Roles) && u.Roles[idx] == rootRole
 }
 
+// hasTokenRole returns true if the token of authInfo grants role.
+func hasTokenRole(authInfo *AuthInfo, role string) bool {
+	for _, r := range authInfo.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTokenRoles returns the roles that may be granted by a token, that
+// is all but the empty ones and the root role.
+func filterTokenRoles(roles []string) []string {
+	var filtered []string
+	for _, role := range roles {
+		if role != "" && role != rootRole {
+			filtered = append(filtered, role)
+		}
+	}
+	return filtered
+}
+
 func (as *authStore) commitRevision(tx backend.BatchTx) {
 	atomic.AddUint64(&as.revision, 1)
 	revBytes := make([]byte, revBytesLen)
@@ -1127,9 +1427,15 @@ func (as *authStore) AuthInfoFromTLS(ctx context.Context) (ai *AuthInfo) {
 		if len(chains) < 1 {
 			continue
 		}
+		username, roles := as.tlsIdentity.identify(chains[0])
+		if username == "" {
+			// the certificate does not identify a user
+			continue
+		}
 		ai = &AuthInfo{
-			Username: chains[0].Subject.CommonName,
+			Username: username,
 			Revision: as.Revision(),
+			Roles:    roles,
 		}
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -1142,7 +1448,7 @@ func (as *authStore) AuthInfoFromTLS(ctx context.Context) (ai *AuthInfo) {
 		if gw := md["grpcgateway-accept"]; len(gw) > 0 {
 			as.lg.Warn(
 				"ignoring common name in gRPC-gateway proxy request",
-				zap.String("common-name", ai.Username),
+				zap.String("common-name", chains[0].Subject.CommonName),
 				zap.String("user-name", ai.Username),
 				zap.Uint64("revision", ai.Revision),
 			)
@@ -1150,8 +1456,9 @@ func (as *authStore) AuthInfoFromTLS(ctx context.Context) (ai *AuthInfo) {
 		}
 		as.lg.Debug(
 			"found command name",
-			zap.String("common-name", ai.Username),
+			zap.String("common-name", chains[0].Subject.CommonName),
 			zap.String("user-name", ai.Username),
+			zap.Strings("user-roles", ai.Roles),
 			zap.Uint64("revision", ai.Revision),
 		)
 		break
@@ -1183,6 +1490,10 @@ func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
 		as.lg.Warn("invalid auth token", zap.String("token", token))
 		return nil, ErrInvalidAuthToken
 	}
+	if as.isSessionRevoked(authInfo) {
+		as.lg.Warn("auth token of a revoked session", zap.String("user-name", authInfo.Username), zap.Uint64("session-id", authInfo.SessionID))
+		return nil, ErrInvalidAuthToken
+	}
 
 	return authInfo, nil
 }
@@ -1191,6 +1502,10 @@ func (as *authStore) GenTokenPrefix() (string, error) {
 	return as.tokenProvider.genTokenPrefix()
 }
 
+func (as *authStore) SessionTTL() time.Duration {
+	return as.tokenProvider.sessionTTL()
+}
+
 func decomposeOpts(lg *zap.Logger, optstr string) (string, map[string]string, error) {
 	opts := strings.Split(optstr, ",")
 	tokenType := opts[0]
@@ -1245,6 +1560,9 @@ func NewTokenProvider(
 	case tokenTypeJWT:
 		return newTokenProviderJWT(lg, typeSpecificOpts)
 
+	case tokenTypeOIDC:
+		return newTokenProviderOIDC(lg, typeSpecificOpts, indexWaiter, TokenTTL)
+
 	case "":
 		return newTokenProviderNop()
 
@@ -1265,8 +1583,14 @@ func (as *authStore) WithRoot(ctx context.Context) context.Context {
 		return ctx
 	}
 
+	// simple tokens are also assigned by the OIDC token provider
+	ts, _ := as.tokenProvider.(*tokenSimple)
+	if to, ok := as.tokenProvider.(*tokenOIDC); ok && to != nil {
+		ts = to.simple
+	}
+
 	var ctxForAssign context.Context
-	if ts, ok := as.tokenProvider.(*tokenSimple); ok && ts != nil {
+	if ts != nil {
 		ctx1 := context.WithValue(ctx, AuthenticateParamIndex{}, uint64(0))
 		prefix, err := ts.genTokenPrefix()
 		if err != nil {
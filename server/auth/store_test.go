@@ -234,7 +234,7 @@ func TestCheckPassword(t *testing.T) {
 	defer tearDown(t)
 
 	// auth a non-existing user
-	_, err := as.CheckPassword("foo-test", "bar")
+	_, err := as.CheckPassword("foo-test", "bar", "")
 	if err == nil {
 		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
 	}
@@ -243,13 +243,13 @@ func TestCheckPassword(t *testing.T) {
 	}
 
 	// auth an existing user with correct password
-	_, err = as.CheckPassword("foo", "bar")
+	_, err = as.CheckPassword("foo", "bar", "")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// auth an existing user but with wrong password
-	_, err = as.CheckPassword("foo", "")
+	_, err = as.CheckPassword("foo", "", "")
 	if err == nil {
 		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
 	}
@@ -456,12 +456,121 @@ func TestIsOpPermitted(t *testing.T) {
 
 	// check permission reflected to user
 
-	err = as.isOpPermitted("foo", as.Revision(), perm.Key, perm.RangeEnd, perm.PermType)
+	err = as.isOpPermitted(&AuthInfo{Username: "foo", Revision: as.Revision()}, perm.Key, perm.RangeEnd, perm.PermType)
 	if err != nil {
 		t.Fatal(err)
 	}
 }
 
+func TestIsOpPermittedWithDeny(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perms := []*authpb.Permission{
+		{PermType: authpb.READWRITE, Key: []byte("/app/"), RangeEnd: []byte("/app0")},
+		{PermType: authpb.READWRITE, Key: []byte("/app/secrets/"), RangeEnd: []byte("/app/secrets0"), Deny: true},
+	}
+	for _, perm := range perms {
+		_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test-1", Perm: perm})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key      string
+		rangeEnd string
+		want     error
+	}{
+		{"/app/config", "", nil},
+		{"/app/secrets/password", "", ErrPermissionDenied},
+		{"/app/", "/app0", ErrPermissionDenied},
+		{"/app/", "/app/secrets/", nil},
+	}
+	for i, tt := range tests {
+		err = as.isOpPermitted(&AuthInfo{Username: "foo", Revision: as.Revision()}, []byte(tt.key), []byte(tt.rangeEnd), authpb.READ)
+		if err != tt.want {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.want)
+		}
+	}
+
+	// granting the denied range replaces the denial
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test-1", Perm: &authpb.Permission{
+		PermType: authpb.READ, Key: []byte("/app/secrets/"), RangeEnd: []byte("/app/secrets0"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = as.isOpPermitted(&AuthInfo{Username: "foo", Revision: as.Revision()}, []byte("/app/secrets/password"), nil, authpb.READ)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsOpPermittedWithUserTemplate(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "tenant"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perms := []*authpb.Permission{
+		// prefix permissions, as granted by etcdctl role grant-permission --prefix
+		{PermType: authpb.READWRITE, Key: []byte("/tenants/{user}/"), RangeEnd: []byte("/tenants/{user}0")},
+		{PermType: authpb.READ, Key: []byte("/homes/{user}"), RangeEnd: []byte("/homes/{user~")},
+		{PermType: authpb.WRITE, Key: []byte("/inbox/{user}")},
+	}
+	for _, perm := range perms {
+		_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "tenant", Perm: perm})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, user := range []string{"foo", "foo-no-user-options"} {
+		_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: user, Role: "tenant"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		user     string
+		key      string
+		rangeEnd string
+		permType authpb.Permission_Type
+		want     error
+	}{
+		{"foo", "/tenants/foo/a", "", authpb.WRITE, nil},
+		{"foo", "/tenants/foo/", "/tenants/foo0", authpb.READ, nil},
+		{"foo", "/tenants/foo-no-user-options/a", "", authpb.READ, ErrPermissionDenied},
+		{"foo-no-user-options", "/tenants/foo-no-user-options/a", "", authpb.READ, nil},
+		{"foo", "/tenants/{user}/a", "", authpb.READ, ErrPermissionDenied},
+		{"foo", "/homes/foo", "/homes/fop", authpb.READ, nil},
+		{"foo", "/homes/foo/a", "", authpb.READ, nil},
+		{"foo", "/inbox/foo", "", authpb.WRITE, nil},
+		{"foo", "/inbox/foo-no-user-options", "", authpb.WRITE, ErrPermissionDenied},
+	}
+	for i, tt := range tests {
+		err = as.isOpPermitted(&AuthInfo{Username: tt.user, Revision: as.Revision()}, []byte(tt.key), []byte(tt.rangeEnd), tt.permType)
+		if err != tt.want {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.want)
+		}
+	}
+}
+
 func TestGetUser(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -983,6 +1092,113 @@ func TestIsAdminPermitted(t *testing.T) {
 	}
 }
 
+func TestRoleGrantOperationPermission(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	for _, op := range []authpb.OperationPermission_Type{authpb.SNAPSHOT, authpb.MEMBER_LIST, authpb.SNAPSHOT} {
+		_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+			Name:          "role-test",
+			OperationPerm: &authpb.OperationPermission{Operation: op},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "role-test",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("foo")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*authpb.OperationPermission{{Operation: authpb.MEMBER_LIST}, {Operation: authpb.SNAPSHOT}}
+	if !reflect.DeepEqual(r.OperationPerm, want) {
+		t.Errorf("expected %v, got %v", want, r.OperationPerm)
+	}
+
+	// revoking a key permission keeps the operation permissions
+	_, err = as.RoleRevokePermission(&pb.AuthRoleRevokePermissionRequest{Role: "role-test", Key: []byte("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleRevokePermission(&pb.AuthRoleRevokePermissionRequest{
+		Role:          "role-test",
+		OperationPerm: &authpb.OperationPermission{Operation: authpb.MEMBER_LIST},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []*authpb.OperationPermission{{Operation: authpb.SNAPSHOT}}
+	if len(r.Perm) != 0 || !reflect.DeepEqual(r.OperationPerm, want) {
+		t.Errorf("expected no key permission and %v, got %v and %v", want, r.Perm, r.OperationPerm)
+	}
+
+	_, err = as.RoleRevokePermission(&pb.AuthRoleRevokePermissionRequest{
+		Role:          "role-test",
+		OperationPerm: &authpb.OperationPermission{Operation: authpb.MEMBER_LIST},
+	})
+	if err != ErrPermissionNotGranted {
+		t.Errorf("expected %v, got %v", ErrPermissionNotGranted, err)
+	}
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name:          "role-test",
+		OperationPerm: &authpb.OperationPermission{Operation: authpb.OperationPermission_Type(100)},
+	})
+	if err != ErrInvalidAuthMgmt {
+		t.Errorf("expected %v, got %v", ErrInvalidAuthMgmt, err)
+	}
+}
+
+func TestIsOperationPermitted(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name:          "role-test",
+		OperationPerm: &authpb.OperationPermission{Operation: authpb.SNAPSHOT},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		authInfo *AuthInfo
+		op       authpb.OperationPermission_Type
+		err      error
+	}{
+		{&AuthInfo{Username: "root", Revision: 1}, authpb.DEFRAGMENT, nil},
+		{&AuthInfo{Username: "", Revision: 1}, authpb.SNAPSHOT, ErrUserEmpty},
+		{&AuthInfo{Username: "foo-test", Revision: 1}, authpb.SNAPSHOT, ErrUserNotFound},
+		{&AuthInfo{Username: "foo", Revision: 1}, authpb.SNAPSHOT, ErrPermissionDenied},
+		// roles granted by the token or the client certificate
+		{&AuthInfo{Username: "foo-test", Revision: 1, Roles: []string{"role-test"}}, authpb.SNAPSHOT, nil},
+		{&AuthInfo{Username: "foo-test", Revision: 1, Roles: []string{"role-test"}}, authpb.DEFRAGMENT, ErrPermissionDenied},
+	}
+	for i, tt := range tests {
+		if err = as.IsOperationPermitted(tt.authInfo, tt.op); err != tt.err {
+			t.Errorf("#%d: expected %v, got %v", i, tt.err, err)
+		}
+	}
+
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = as.IsOperationPermitted(&AuthInfo{Username: "foo", Revision: 1}, authpb.SNAPSHOT); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
+
 func TestRecoverFromSnapshot(t *testing.T) {
 	as, teardown := setupAuthStore(t)
 	defer teardown(t)
@@ -0,0 +1,136 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+)
+
+// CertAttribute is an attribute of a client certificate.
+type CertAttribute string
+
+const (
+	// CertAttributeCommonName is the common name of the subject.
+	CertAttributeCommonName CertAttribute = "cn"
+	// CertAttributeOrganizationalUnit is the organizational units of the subject.
+	CertAttributeOrganizationalUnit CertAttribute = "ou"
+	// CertAttributeDNSSAN is the DNS names of the subject alternative name.
+	CertAttributeDNSSAN CertAttribute = "dns-san"
+	// CertAttributeURISAN is the URIs of the subject alternative name.
+	CertAttributeURISAN CertAttribute = "uri-san"
+	// CertAttributeSPIFFEID is the SPIFFE ID, the only URI of the subject
+	// alternative name of a SPIFFE certificate.
+	CertAttributeSPIFFEID CertAttribute = "spiffe"
+)
+
+// TLSIdentity selects the attributes of a verified client certificate
+// which identify the client.
+type TLSIdentity struct {
+	// Username is the attribute used as the username. The first value is
+	// used if the attribute has several.
+	Username CertAttribute
+	// Roles is the attribute whose values are granted as roles to the
+	// client, without the user having to exist. No roles are granted if
+	// it is empty.
+	Roles CertAttribute
+}
+
+// ParseTLSIdentity parses a TLS identity of the form
+// "username=<attribute>[,roles=<attribute>]". The username is the common
+// name if s is empty.
+func ParseTLSIdentity(s string) (TLSIdentity, error) {
+	id := TLSIdentity{Username: CertAttributeCommonName}
+	if s == "" {
+		return id, nil
+	}
+	seen := make(map[string]bool)
+	for _, opt := range strings.Split(s, ",") {
+		pair := strings.SplitN(opt, "=", 2)
+		if len(pair) != 2 || seen[pair[0]] {
+			return id, fmt.Errorf("invalid client certificate identity option %q", opt)
+		}
+		seen[pair[0]] = true
+
+		attr := CertAttribute(pair[1])
+		if !attr.valid() {
+			return id, fmt.Errorf("unknown client certificate attribute %q", pair[1])
+		}
+		switch pair[0] {
+		case "username":
+			id.Username = attr
+		case "roles":
+			id.Roles = attr
+		default:
+			return id, fmt.Errorf("unknown client certificate identity option %q", pair[0])
+		}
+	}
+	return id, nil
+}
+
+func (a CertAttribute) valid() bool {
+	switch a {
+	case CertAttributeCommonName, CertAttributeOrganizationalUnit, CertAttributeDNSSAN,
+		CertAttributeURISAN, CertAttributeSPIFFEID:
+		return true
+	}
+	return false
+}
+
+// values returns the non-empty values of the attribute in the certificate.
+func (a CertAttribute) values(cert *x509.Certificate) []string {
+	var vs []string
+	switch a {
+	case CertAttributeCommonName:
+		vs = []string{cert.Subject.CommonName}
+	case CertAttributeOrganizationalUnit:
+		vs = cert.Subject.OrganizationalUnit
+	case CertAttributeDNSSAN:
+		vs = cert.DNSNames
+	case CertAttributeURISAN:
+		for _, u := range cert.URIs {
+			vs = append(vs, u.String())
+		}
+	case CertAttributeSPIFFEID:
+		// a SPIFFE certificate has exactly one URI
+		if len(cert.URIs) == 1 && cert.URIs[0].Scheme == "spiffe" {
+			vs = []string{cert.URIs[0].String()}
+		}
+	}
+
+	var nonEmpty []string
+	for _, v := range vs {
+		if v != "" {
+			nonEmpty = append(nonEmpty, v)
+		}
+	}
+	return nonEmpty
+}
+
+// identify returns the username and the roles of the client certificate.
+// The username is empty if the certificate does not have it. The root role
+// is never granted by a certificate.
+func (id TLSIdentity) identify(cert *x509.Certificate) (string, []string) {
+	var username string
+	if vs := id.Username.values(cert); len(vs) > 0 {
+		username = vs[0]
+	}
+	var roles []string
+	if id.Roles != "" {
+		roles = filterTokenRoles(id.Roles.values(cert))
+	}
+	return username, roles
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestParseTLSIdentity(t *testing.T) {
+	tests := []struct {
+		s    string
+		id   TLSIdentity
+		werr bool
+	}{
+		{"", TLSIdentity{Username: CertAttributeCommonName}, false},
+		{"username=spiffe", TLSIdentity{Username: CertAttributeSPIFFEID}, false},
+		{"username=uri-san,roles=ou", TLSIdentity{Username: CertAttributeURISAN, Roles: CertAttributeOrganizationalUnit}, false},
+		{"roles=dns-san", TLSIdentity{Username: CertAttributeCommonName, Roles: CertAttributeDNSSAN}, false},
+		{"username=email", TLSIdentity{}, true},
+		{"username=cn,username=ou", TLSIdentity{}, true},
+		{"groups=ou", TLSIdentity{}, true},
+		{"username", TLSIdentity{}, true},
+	}
+	for i, tt := range tests {
+		id, err := ParseTLSIdentity(tt.s)
+		if (err != nil) != tt.werr {
+			t.Fatalf("#%d: err = %v, want error %v", i, err, tt.werr)
+		}
+		if err == nil && id != tt.id {
+			t.Errorf("#%d: id = %+v, want %+v", i, id, tt.id)
+		}
+	}
+}
+
+func newTestCert() *x509.Certificate {
+	return &x509.Certificate{
+		Subject: pkix.Name{
+			OrganizationalUnit: []string{"role-test", "root", "role-test-1"},
+		},
+		DNSNames: []string{"foo.example.com", "bar.example.com"},
+		URIs:     []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/ns/default/sa/foo"}},
+	}
+}
+
+func TestTLSIdentityIdentify(t *testing.T) {
+	cert := newTestCert()
+	tests := []struct {
+		id       TLSIdentity
+		username string
+		roles    []string
+	}{
+		{TLSIdentity{Username: CertAttributeCommonName}, "", nil},
+		{TLSIdentity{Username: CertAttributeSPIFFEID}, "spiffe://example.org/ns/default/sa/foo", nil},
+		{TLSIdentity{Username: CertAttributeURISAN}, "spiffe://example.org/ns/default/sa/foo", nil},
+		{TLSIdentity{Username: CertAttributeDNSSAN, Roles: CertAttributeOrganizationalUnit}, "foo.example.com", []string{"role-test", "role-test-1"}},
+	}
+	for i, tt := range tests {
+		username, roles := tt.id.identify(cert)
+		if username != tt.username || !reflect.DeepEqual(roles, tt.roles) {
+			t.Errorf("#%d: identify = (%q, %v), want (%q, %v)", i, username, roles, tt.username, tt.roles)
+		}
+	}
+
+	// a certificate with several URIs is not a SPIFFE certificate
+	cert.URIs = append(cert.URIs, &url.URL{Scheme: "https", Host: "example.org"})
+	if username, _ := (TLSIdentity{Username: CertAttributeSPIFFEID}).identify(cert); username != "" {
+		t.Errorf("unexpected SPIFFE ID %q", username)
+	}
+}
+
+func TestAuthInfoFromTLSRoles(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "role-test",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("foo")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	as.SetTLSIdentity(TLSIdentity{Username: CertAttributeSPIFFEID, Roles: CertAttributeOrganizationalUnit})
+
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{newTestCert()}},
+		}},
+	})
+	ctx = metadata.NewIncomingContext(ctx, metadata.New(nil))
+
+	ai := as.AuthInfoFromTLS(ctx)
+	if ai == nil {
+		t.Fatal("expected auth info from the client certificate")
+	}
+	if ai.Username != "spiffe://example.org/ns/default/sa/foo" {
+		t.Fatalf("unexpected username %q", ai.Username)
+	}
+	// the user does not exist, its roles are granted by the certificate
+	if err = as.IsRangePermitted(ai, []byte("foo"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = as.IsPutPermitted(ai, []byte("foo")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	// the root role of the certificate is dropped
+	if err = as.IsAdminPermitted(ai); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+
+	// no auth info without the username attribute
+	as.SetTLSIdentity(TLSIdentity{Username: CertAttributeCommonName, Roles: CertAttributeOrganizationalUnit})
+	if ai = as.AuthInfoFromTLS(ctx); ai != nil {
+		t.Fatalf("expected no auth info, got %+v", ai)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// webhookUnixPrefix is the prefix of the addresses of the webhooks
+	// listening on a Unix socket.
+	webhookUnixPrefix = "unix://"
+
+	// webhookMaxResponseBytes bounds the size of the responses of a webhook.
+	webhookMaxResponseBytes = 64 * 1024
+)
+
+// webhookResponse is the response of a webhook. Decision is "allow", "deny"
+// or "no-opinion", the default, and TTLSeconds the number of seconds the
+// decision may be cached.
+type webhookResponse struct {
+	Decision   string `json:"decision"`
+	TTLSeconds int64  `json:"ttl_seconds,omitempty"`
+}
+
+// webhookAuthorizer is an Authorizer posting the attributes of the requests
+// as JSON to a webhook.
+type webhookAuthorizer struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAuthorizer returns an Authorizer consulting the webhook at addr,
+// an HTTP URL or "unix://<socket path>" for a webhook listening on a Unix
+// socket. The requests to the webhook time out after timeout.
+func NewWebhookAuthorizer(addr string, timeout time.Duration) (Authorizer, error) {
+	w := &webhookAuthorizer{url: addr, client: &http.Client{Timeout: timeout}}
+	switch {
+	case strings.HasPrefix(addr, webhookUnixPrefix):
+		path := strings.TrimPrefix(addr, webhookUnixPrefix)
+		if path == "" {
+			return nil, fmt.Errorf("missing socket path in webhook address %q", addr)
+		}
+		w.url = "http://localhost/"
+		w.client.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", path)
+			},
+		}
+	case strings.HasPrefix(addr, "http://"), strings.HasPrefix(addr, "https://"):
+	default:
+		return nil, fmt.Errorf("unsupported webhook address %q (expected http://, https:// or %s)", addr, webhookUnixPrefix)
+	}
+	return w, nil
+}
+
+func (w *webhookAuthorizer) Authorize(ctx context.Context, attrs Attributes) (Decision, time.Duration, error) {
+	body, err := json.Marshal(attrs)
+	if err != nil {
+		return DecisionNoOpinion, 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return DecisionNoOpinion, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return DecisionNoOpinion, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return DecisionNoOpinion, 0, fmt.Errorf("webhook returned status %q", resp.Status)
+	}
+
+	var wr webhookResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, webhookMaxResponseBytes)).Decode(&wr); err != nil {
+		return DecisionNoOpinion, 0, fmt.Errorf("failed to decode webhook response (%v)", err)
+	}
+	var d Decision
+	switch wr.Decision {
+	case "allow":
+		d = DecisionAllow
+	case "deny":
+		d = DecisionDeny
+	case "", "no-opinion":
+		d = DecisionNoOpinion
+	default:
+		return DecisionNoOpinion, 0, fmt.Errorf("unknown webhook decision %q", wr.Decision)
+	}
+	return d, time.Duration(wr.TTLSeconds) * time.Second, nil
+}
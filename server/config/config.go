@@ -25,8 +25,11 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/netutil"
+	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/datadir"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
 
 	bolt "go.etcd.io/bbolt"
 	"go.uber.org/zap"
@@ -136,6 +139,34 @@ type ServerConfig struct {
 	BcryptCost uint
 	TokenTTL   uint
 
+	// AuthLockoutThreshold is the number of consecutive failed
+	// authentications after which a user, or a source address, is locked
+	// out. Zero disables the lockout.
+	AuthLockoutThreshold int
+	// AuthLockoutDuration is the duration of the first lockout, doubled by
+	// each failed attempt up to AuthLockoutMaxDuration.
+	AuthLockoutDuration    time.Duration
+	AuthLockoutMaxDuration time.Duration
+
+	// PasswordMinLength, PasswordMinCharacterClasses and PasswordHistory
+	// are the password policy of the users. Zero disables each check.
+	PasswordMinLength           int
+	PasswordMinCharacterClasses int
+	PasswordHistory             int
+
+	// ClientCertIdentity selects the attributes of the client certificates
+	// giving the username and the roles of the clients. See auth.ParseTLSIdentity.
+	ClientCertIdentity string
+
+	// EnforceOperationPermissions requires the permission of the operation,
+	// or the root role, for the operations outside of the key space which
+	// are otherwise open to every user, such as listing the members.
+	EnforceOperationPermissions bool
+
+	// Authorizer is consulted on the requests on the key space before the
+	// permissions of the roles of the users, if set.
+	Authorizer auth.Authorizer
+
 	// InitialCorruptCheck is true to check data corruption on boot
 	// before serving any peer/client traffic.
 	InitialCorruptCheck     bool
@@ -143,6 +174,21 @@ type ServerConfig struct {
 	CompactHashCheckEnabled bool
 	CompactHashCheckTime    time.Duration
 
+	// LatencyAlarmWindow is the window over which disk latency percentiles
+	// are computed to raise or clear the LATENCY alarm. Zero disables it.
+	LatencyAlarmWindow time.Duration
+	// LatencyAlarmPercentile is the percentile of WAL fsync and backend
+	// commit durations compared against the thresholds.
+	LatencyAlarmPercentile float64
+	// LatencyAlarmWALFsyncThreshold and LatencyAlarmBackendCommitThreshold
+	// are the durations above which the LATENCY alarm is raised. Zero
+	// disables the check of that operation.
+	LatencyAlarmWALFsyncThreshold      time.Duration
+	LatencyAlarmBackendCommitThreshold time.Duration
+	// LatencyAlarmLeaderTransfer is true to transfer leadership away from
+	// the member while its LATENCY alarm is raised.
+	LatencyAlarmLeaderTransfer bool
+
 	// PreVote is true to enable Raft Pre-Vote.
 	PreVote bool
 
@@ -167,9 +213,15 @@ type ServerConfig struct {
 	ExperimentalEnableDistributedTracing bool
 	// ExperimentalTracerOptions are options for OpenTelemetry gRPC interceptor.
 	ExperimentalTracerOptions []otelgrpc.Option
+	// ExperimentalTracerProvider provides the tracer for spans emitted by the
+	// server while processing raft requests.
+	ExperimentalTracerProvider trace.TracerProvider
 
 	WatchProgressNotifyInterval time.Duration
 
+	// AuditLogger records the client requests selected by its policy, if set.
+	AuditLogger *v3audit.Logger
+
 	// UnsafeNoFsync disables all uses of fsync.
 	// Setting this is unsafe and will cause data loss.
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
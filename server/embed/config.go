@@ -35,6 +35,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/flags"
 	"go.etcd.io/etcd/pkg/v3/netutil"
+	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
@@ -63,6 +64,17 @@ const (
 	DefaultGRPCKeepAliveTimeout  = 20 * time.Second
 	DefaultDowngradeCheckTime    = 5 * time.Second
 
+	DefaultLatencyAlarmPercentile             = 0.99
+	DefaultLatencyAlarmWALFsyncThreshold      = time.Second
+	DefaultLatencyAlarmBackendCommitThreshold = time.Second
+
+	DefaultAuditLogMaxSize = 100
+
+	DefaultAuthLockoutDuration    = time.Second
+	DefaultAuthLockoutMaxDuration = 5 * time.Minute
+
+	DefaultAuthorizationWebhookTimeout = time.Second
+
 	DefaultListenPeerURLs   = "http://localhost:2380"
 	DefaultListenClientURLs = "http://localhost:2379"
 
@@ -320,10 +332,69 @@ type Config struct {
 	// AuthTokenTTL specifies the TTL in seconds of the simple token
 	AuthTokenTTL uint `json:"auth-token-ttl"`
 
+	// ExperimentalAuthLockoutThreshold is the number of consecutive failed
+	// authentications after which a user, or a source address, is locked
+	// out. Zero disables the lockout. The failed authentications are counted
+	// by each member, in memory.
+	ExperimentalAuthLockoutThreshold int `json:"experimental-auth-lockout-threshold"`
+	// ExperimentalAuthLockoutDuration is the duration of the first lockout.
+	// It is doubled by each further failed attempt.
+	ExperimentalAuthLockoutDuration time.Duration `json:"experimental-auth-lockout-duration"`
+	// ExperimentalAuthLockoutMaxDuration is the maximum duration of a lockout.
+	ExperimentalAuthLockoutMaxDuration time.Duration `json:"experimental-auth-lockout-max-duration"`
+
+	// ExperimentalPasswordMinLength is the minimum length of the passwords.
+	ExperimentalPasswordMinLength int `json:"experimental-password-min-length"`
+	// ExperimentalPasswordMinCharacterClasses is the minimum number of
+	// character classes (lower case, upper case, digits, others) of the passwords.
+	ExperimentalPasswordMinCharacterClasses int `json:"experimental-password-min-character-classes"`
+	// ExperimentalPasswordHistory is the number of previous passwords of a
+	// user which cannot be reused.
+	ExperimentalPasswordHistory int `json:"experimental-password-history"`
+
+	// ExperimentalClientCertIdentity selects the attributes of the client
+	// certificates used as the username and the roles of the clients, in the
+	// form "username=<attribute>[,roles=<attribute>]" where the attributes are
+	// "cn", "ou", "dns-san", "uri-san" or "spiffe". The username is the common
+	// name if empty. The root role is never granted by a certificate.
+	ExperimentalClientCertIdentity string `json:"experimental-client-cert-identity"`
+
+	// ExperimentalEnforceOperationPermissions requires the operation
+	// permissions granted to the roles, or the root role, to list the members,
+	// move the leader, disarm alarms, compact and grant or revoke leases.
+	// Users may then only revoke the leases they granted, unless root.
+	ExperimentalEnforceOperationPermissions bool `json:"experimental-enforce-operation-permissions"`
+
+	// ExperimentalAuthorizationWebhook is the address of a webhook authorizing
+	// the requests on the key space, an HTTP URL or "unix://<socket path>".
+	// The webhook is consulted before the permissions of the roles of the
+	// users, which are only checked when it has no opinion.
+	ExperimentalAuthorizationWebhook string `json:"experimental-authorization-webhook"`
+	// ExperimentalAuthorizationWebhookTimeout is the timeout of the requests
+	// to the authorization webhook.
+	ExperimentalAuthorizationWebhookTimeout time.Duration `json:"experimental-authorization-webhook-timeout"`
+	// ExperimentalAuthorizer authorizes the requests on the key space in
+	// process, in place of the authorization webhook, when etcd is embedded.
+	ExperimentalAuthorizer auth.Authorizer `json:"-"`
+
 	ExperimentalInitialCorruptCheck     bool          `json:"experimental-initial-corrupt-check"`
 	ExperimentalCorruptCheckTime        time.Duration `json:"experimental-corrupt-check-time"`
 	ExperimentalCompactHashCheckEnabled bool          `json:"experimental-compact-hash-check-enabled"`
 	ExperimentalCompactHashCheckTime    time.Duration `json:"experimental-compact-hash-check-time"`
+	// ExperimentalLatencyAlarmWindow is the window over which disk latency
+	// percentiles are computed to raise or clear the LATENCY alarm. Zero disables it.
+	ExperimentalLatencyAlarmWindow time.Duration `json:"experimental-latency-alarm-window"`
+	// ExperimentalLatencyAlarmPercentile is the latency percentile compared against the thresholds.
+	ExperimentalLatencyAlarmPercentile float64 `json:"experimental-latency-alarm-percentile"`
+	// ExperimentalLatencyAlarmWALFsyncThreshold is the WAL fsync latency above which
+	// the LATENCY alarm is raised. Zero disables the check.
+	ExperimentalLatencyAlarmWALFsyncThreshold time.Duration `json:"experimental-latency-alarm-wal-fsync-threshold"`
+	// ExperimentalLatencyAlarmBackendCommitThreshold is the backend commit latency above
+	// which the LATENCY alarm is raised. Zero disables the check.
+	ExperimentalLatencyAlarmBackendCommitThreshold time.Duration `json:"experimental-latency-alarm-backend-commit-threshold"`
+	// ExperimentalLatencyAlarmLeaderTransfer transfers leadership away from the member
+	// while its LATENCY alarm is raised.
+	ExperimentalLatencyAlarmLeaderTransfer bool `json:"experimental-latency-alarm-leader-transfer"`
 	// ExperimentalEnableV2V3 configures URLs that expose deprecated V2 API working on V3 store.
 	// Deprecated in v3.5.
 	// TODO: Delete in v3.6 (https://github.com/etcd-io/etcd/issues/12913)
@@ -346,6 +417,22 @@ type Config struct {
 	// consider running defrag during bootstrap. Needs to be set to non-zero value to take effect.
 	ExperimentalBootstrapDefragThresholdMegabytes uint `json:"experimental-bootstrap-defrag-threshold-megabytes"`
 
+	// ExperimentalAuditLogPath is the file the audit records are written to as
+	// JSON lines. Auditing is disabled if empty.
+	ExperimentalAuditLogPath string `json:"experimental-audit-log-path"`
+	// ExperimentalAuditPolicyFile is the YAML file of the audit policy. The
+	// requests which change the state of the cluster are audited if empty.
+	ExperimentalAuditPolicyFile string `json:"experimental-audit-policy-file"`
+	// ExperimentalAuditLogMaxSize is the size in megabytes of the audit log
+	// before it is rotated.
+	ExperimentalAuditLogMaxSize int `json:"experimental-audit-log-max-size"`
+	// ExperimentalAuditLogMaxBackups is the number of rotated audit logs to
+	// keep, all if zero.
+	ExperimentalAuditLogMaxBackups int `json:"experimental-audit-log-max-backups"`
+	// ExperimentalAuditLogMaxAge is the number of days to keep the rotated
+	// audit logs, forever if zero.
+	ExperimentalAuditLogMaxAge int `json:"experimental-audit-log-max-age"`
+
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster bool `json:"force-new-cluster"`
 
@@ -527,6 +614,17 @@ func NewConfig() *Config {
 		ExperimentalCompactHashCheckEnabled: false,
 		ExperimentalCompactHashCheckTime:    time.Minute,
 
+		ExperimentalLatencyAlarmPercentile:             DefaultLatencyAlarmPercentile,
+		ExperimentalLatencyAlarmWALFsyncThreshold:      DefaultLatencyAlarmWALFsyncThreshold,
+		ExperimentalLatencyAlarmBackendCommitThreshold: DefaultLatencyAlarmBackendCommitThreshold,
+
+		ExperimentalAuditLogMaxSize: DefaultAuditLogMaxSize,
+
+		ExperimentalAuthLockoutDuration:    DefaultAuthLockoutDuration,
+		ExperimentalAuthLockoutMaxDuration: DefaultAuthLockoutMaxDuration,
+
+		ExperimentalAuthorizationWebhookTimeout: DefaultAuthorizationWebhookTimeout,
+
 		V2Deprecation: config.V2_DEPR_DEFAULT,
 	}
 	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
@@ -752,6 +850,51 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("--experimental-compact-hash-check-time must be >0 (set to %v)", cfg.ExperimentalCompactHashCheckTime)
 	}
 
+	if cfg.ExperimentalLatencyAlarmWindow < 0 {
+		return fmt.Errorf("--experimental-latency-alarm-window must be >=0 (set to %v)", cfg.ExperimentalLatencyAlarmWindow)
+	}
+	if cfg.ExperimentalLatencyAlarmPercentile <= 0 || cfg.ExperimentalLatencyAlarmPercentile > 1 {
+		return fmt.Errorf("--experimental-latency-alarm-percentile must be in (0, 1] (set to %v)", cfg.ExperimentalLatencyAlarmPercentile)
+	}
+
+	if cfg.ExperimentalAuditLogPath == "" && cfg.ExperimentalAuditPolicyFile != "" {
+		return fmt.Errorf("setting experimental-audit-policy-file requires experimental-audit-log-path")
+	}
+	if cfg.ExperimentalAuditLogMaxSize <= 0 {
+		return fmt.Errorf("--experimental-audit-log-max-size must be >0 (set to %v)", cfg.ExperimentalAuditLogMaxSize)
+	}
+	if cfg.ExperimentalAuditLogMaxBackups < 0 || cfg.ExperimentalAuditLogMaxAge < 0 {
+		return fmt.Errorf("--experimental-audit-log-max-backups and --experimental-audit-log-max-age must be >=0")
+	}
+
+	if cfg.ExperimentalAuthorizationWebhook != "" {
+		if cfg.ExperimentalAuthorizer != nil {
+			return fmt.Errorf("cannot set both experimental-authorization-webhook and an in-process authorizer")
+		}
+		if cfg.ExperimentalAuthorizationWebhookTimeout <= 0 {
+			return fmt.Errorf("--experimental-authorization-webhook-timeout must be >0 (set to %v)", cfg.ExperimentalAuthorizationWebhookTimeout)
+		}
+	}
+
+	if cfg.ExperimentalAuthLockoutThreshold < 0 {
+		return fmt.Errorf("--experimental-auth-lockout-threshold must be >=0 (set to %v)", cfg.ExperimentalAuthLockoutThreshold)
+	}
+	if cfg.ExperimentalAuthLockoutThreshold > 0 {
+		if cfg.ExperimentalAuthLockoutDuration <= 0 {
+			return fmt.Errorf("--experimental-auth-lockout-duration must be >0 (set to %v)", cfg.ExperimentalAuthLockoutDuration)
+		}
+		if cfg.ExperimentalAuthLockoutMaxDuration < cfg.ExperimentalAuthLockoutDuration {
+			return fmt.Errorf("--experimental-auth-lockout-max-duration (%v) must be >= --experimental-auth-lockout-duration (%v)",
+				cfg.ExperimentalAuthLockoutMaxDuration, cfg.ExperimentalAuthLockoutDuration)
+		}
+	}
+	if cfg.ExperimentalPasswordMinLength < 0 || cfg.ExperimentalPasswordHistory < 0 {
+		return fmt.Errorf("--experimental-password-min-length and --experimental-password-history must be >=0")
+	}
+	if cfg.ExperimentalPasswordMinCharacterClasses < 0 || cfg.ExperimentalPasswordMinCharacterClasses > 4 {
+		return fmt.Errorf("--experimental-password-min-character-classes must be in [0, 4] (set to %v)", cfg.ExperimentalPasswordMinCharacterClasses)
+	}
+
 	minVersion, err := tlsutil.GetTLSVersion(cfg.TlsMinVersion)
 	if err != nil {
 		return err
@@ -37,12 +37,14 @@ import (
 	"go.etcd.io/etcd/client/v3/credentials"
 	"go.etcd.io/etcd/pkg/v3/debugutil"
 	runtimeutil "go.etcd.io/etcd/pkg/v3/runtime"
+	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2http"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2v3"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
 	"go.etcd.io/etcd/server/v3/verify"
@@ -79,6 +81,8 @@ type Etcd struct {
 
 	tracingExporterShutdown func()
 
+	auditLogger *v3audit.Logger
+
 	Server *etcdserver.EtcdServer
 
 	cfg Config
@@ -215,12 +219,25 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		AuthToken:                                cfg.AuthToken,
 		BcryptCost:                               cfg.BcryptCost,
 		TokenTTL:                                 cfg.AuthTokenTTL,
+		AuthLockoutThreshold:                     cfg.ExperimentalAuthLockoutThreshold,
+		AuthLockoutDuration:                      cfg.ExperimentalAuthLockoutDuration,
+		AuthLockoutMaxDuration:                   cfg.ExperimentalAuthLockoutMaxDuration,
+		PasswordMinLength:                        cfg.ExperimentalPasswordMinLength,
+		PasswordMinCharacterClasses:              cfg.ExperimentalPasswordMinCharacterClasses,
+		PasswordHistory:                          cfg.ExperimentalPasswordHistory,
+		ClientCertIdentity:                       cfg.ExperimentalClientCertIdentity,
+		EnforceOperationPermissions:              cfg.ExperimentalEnforceOperationPermissions,
 		CORS:                                     cfg.CORS,
 		HostWhitelist:                            cfg.HostWhitelist,
 		InitialCorruptCheck:                      cfg.ExperimentalInitialCorruptCheck,
 		CorruptCheckTime:                         cfg.ExperimentalCorruptCheckTime,
 		CompactHashCheckEnabled:                  cfg.ExperimentalCompactHashCheckEnabled,
 		CompactHashCheckTime:                     cfg.ExperimentalCompactHashCheckTime,
+		LatencyAlarmWindow:                       cfg.ExperimentalLatencyAlarmWindow,
+		LatencyAlarmPercentile:                   cfg.ExperimentalLatencyAlarmPercentile,
+		LatencyAlarmWALFsyncThreshold:            cfg.ExperimentalLatencyAlarmWALFsyncThreshold,
+		LatencyAlarmBackendCommitThreshold:       cfg.ExperimentalLatencyAlarmBackendCommitThreshold,
+		LatencyAlarmLeaderTransfer:               cfg.ExperimentalLatencyAlarmLeaderTransfer,
 		PreVote:                                  cfg.PreVote,
 		Logger:                                   cfg.logger,
 		ForceNewCluster:                          cfg.ForceNewCluster,
@@ -251,10 +268,37 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 			tracingExporter.Close(tctx)
 		}
 		srvcfg.ExperimentalTracerOptions = tracingExporter.opts
+		srvcfg.ExperimentalTracerProvider = tracingExporter.provider
 
 		e.cfg.logger.Info("distributed tracing setup enabled")
 	}
 
+	if cfg.ExperimentalAuditLogPath != "" {
+		policy := v3audit.DefaultPolicy()
+		if cfg.ExperimentalAuditPolicyFile != "" {
+			if policy, err = v3audit.LoadPolicy(cfg.ExperimentalAuditPolicyFile); err != nil {
+				return e, err
+			}
+		}
+		e.auditLogger = v3audit.NewLogger(v3audit.LogConfig{
+			Path:       cfg.ExperimentalAuditLogPath,
+			MaxSize:    cfg.ExperimentalAuditLogMaxSize,
+			MaxBackups: cfg.ExperimentalAuditLogMaxBackups,
+			MaxAge:     cfg.ExperimentalAuditLogMaxAge,
+		}, policy)
+		srvcfg.AuditLogger = e.auditLogger
+
+		e.cfg.logger.Info("audit log enabled", zap.String("path", cfg.ExperimentalAuditLogPath))
+	}
+
+	srvcfg.Authorizer = cfg.ExperimentalAuthorizer
+	if cfg.ExperimentalAuthorizationWebhook != "" {
+		if srvcfg.Authorizer, err = auth.NewWebhookAuthorizer(cfg.ExperimentalAuthorizationWebhook, cfg.ExperimentalAuthorizationWebhookTimeout); err != nil {
+			return e, err
+		}
+		e.cfg.logger.Info("authorization webhook enabled", zap.String("address", cfg.ExperimentalAuthorizationWebhook))
+	}
+
 	print(e.cfg.logger, *cfg, srvcfg, memberInitialized)
 
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
@@ -362,6 +406,21 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("corrupt-check-time-interval", sc.CorruptCheckTime.String()),
 		zap.Bool("compact-check-time-enabled", sc.CompactHashCheckEnabled),
 		zap.Duration("compact-check-time-interval", sc.CompactHashCheckTime),
+		zap.Duration("latency-alarm-window", sc.LatencyAlarmWindow),
+		zap.Float64("latency-alarm-percentile", sc.LatencyAlarmPercentile),
+		zap.Duration("latency-alarm-wal-fsync-threshold", sc.LatencyAlarmWALFsyncThreshold),
+		zap.Duration("latency-alarm-backend-commit-threshold", sc.LatencyAlarmBackendCommitThreshold),
+		zap.Bool("latency-alarm-leader-transfer", sc.LatencyAlarmLeaderTransfer),
+		zap.String("audit-log-path", ec.ExperimentalAuditLogPath),
+		zap.String("audit-policy-file", ec.ExperimentalAuditPolicyFile),
+		zap.Int("auth-lockout-threshold", sc.AuthLockoutThreshold),
+		zap.Int("password-min-length", sc.PasswordMinLength),
+		zap.Int("password-min-character-classes", sc.PasswordMinCharacterClasses),
+		zap.Int("password-history", sc.PasswordHistory),
+		zap.String("client-cert-identity", sc.ClientCertIdentity),
+		zap.Bool("enforce-operation-permissions", sc.EnforceOperationPermissions),
+		zap.String("authorization-webhook", ec.ExperimentalAuthorizationWebhook),
+		zap.Bool("authorizer", sc.Authorizer != nil),
 		zap.String("auto-compaction-mode", sc.AutoCompactionMode),
 		zap.Duration("auto-compaction-retention", sc.AutoCompactionRetention),
 		zap.String("auto-compaction-interval", sc.AutoCompactionRetention.String()),
@@ -457,6 +516,10 @@ func (e *Etcd) Close() {
 		e.Server.Stop()
 	}
 
+	if e.auditLogger != nil {
+		e.auditLogger.Close()
+	}
+
 	// close all idle connections in peer handler (wait up to 1-second)
 	for i := range e.Peers {
 		if e.Peers[i] != nil && e.Peers[i].close != nil {
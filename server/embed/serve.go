@@ -39,6 +39,9 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
 	v3lockgw "go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb/gw"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+	v3semaphoregw "go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb/gw"
 
 	gw "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/soheilhy/cmux"
@@ -133,6 +136,7 @@ func (sctx *serveCtx) serve(
 	v3c := v3client.New(s)
 	servElection := v3election.NewElectionServer(v3c)
 	servLock := v3lock.NewLockServer(v3c)
+	servSemaphore := v3semaphore.NewSemaphoreServer(v3c)
 
 	var gwmux *gw.ServeMux
 	if s.Cfg.EnableGRPCGateway {
@@ -171,6 +175,7 @@ func (sctx *serveCtx) serve(
 			gs = v3rpc.Server(s, nil, nil, gopts...)
 			v3electionpb.RegisterElectionServer(gs, servElection)
 			v3lockpb.RegisterLockServer(gs, servLock)
+			v3semaphorepb.RegisterSemaphoreServer(gs, servSemaphore)
 			if sctx.serviceRegister != nil {
 				sctx.serviceRegister(gs)
 			}
@@ -227,6 +232,7 @@ func (sctx *serveCtx) serve(
 			gs = v3rpc.Server(s, tlscfg, nil, gopts...)
 			v3electionpb.RegisterElectionServer(gs, servElection)
 			v3lockpb.RegisterLockServer(gs, servLock)
+			v3semaphorepb.RegisterSemaphoreServer(gs, servSemaphore)
 			if sctx.serviceRegister != nil {
 				sctx.serviceRegister(gs)
 			}
@@ -329,6 +335,7 @@ func (sctx *serveCtx) registerGateway(dial func(ctx context.Context) (*grpc.Clie
 		etcdservergw.RegisterMaintenanceHandler,
 		etcdservergw.RegisterAuthHandler,
 		v3lockgw.RegisterLockHandler,
+		v3semaphoregw.RegisterSemaphoreHandler,
 		v3electiongw.RegisterElectionHandler,
 	}
 	for _, h := range handlers {
@@ -295,6 +295,16 @@ func newConfig() *config {
 	fs.StringVar(&cfg.ec.AuthToken, "auth-token", cfg.ec.AuthToken, "Specify auth token specific options.")
 	fs.UintVar(&cfg.ec.BcryptCost, "bcrypt-cost", cfg.ec.BcryptCost, "Specify bcrypt algorithm cost factor for auth password hashing.")
 	fs.UintVar(&cfg.ec.AuthTokenTTL, "auth-token-ttl", cfg.ec.AuthTokenTTL, "The lifetime in seconds of the auth token.")
+	fs.IntVar(&cfg.ec.ExperimentalAuthLockoutThreshold, "experimental-auth-lockout-threshold", 0, "Number of consecutive failed authentications after which a user or a source address is locked out. 0 disables the lockout.")
+	fs.DurationVar(&cfg.ec.ExperimentalAuthLockoutDuration, "experimental-auth-lockout-duration", cfg.ec.ExperimentalAuthLockoutDuration, "Duration of the first authentication lockout, doubled by each further failed attempt.")
+	fs.DurationVar(&cfg.ec.ExperimentalAuthLockoutMaxDuration, "experimental-auth-lockout-max-duration", cfg.ec.ExperimentalAuthLockoutMaxDuration, "Maximum duration of an authentication lockout.")
+	fs.IntVar(&cfg.ec.ExperimentalPasswordMinLength, "experimental-password-min-length", 0, "Minimum length of the user passwords.")
+	fs.IntVar(&cfg.ec.ExperimentalPasswordMinCharacterClasses, "experimental-password-min-character-classes", 0, "Minimum number of character classes (lower case, upper case, digits, others) of the user passwords.")
+	fs.IntVar(&cfg.ec.ExperimentalPasswordHistory, "experimental-password-history", 0, "Number of previous passwords of a user, including the current one, which cannot be reused.")
+	fs.StringVar(&cfg.ec.ExperimentalClientCertIdentity, "experimental-client-cert-identity", "", "Client certificate attributes used as the username and the roles of the clients, as 'username=<attribute>[,roles=<attribute>]' with the attributes 'cn', 'ou', 'dns-san', 'uri-san' or 'spiffe'.")
+	fs.BoolVar(&cfg.ec.ExperimentalEnforceOperationPermissions, "experimental-enforce-operation-permissions", false, "Require the operation permissions of the roles, or the root role, to list the members, move the leader, disarm alarms, compact and grant or revoke leases.")
+	fs.StringVar(&cfg.ec.ExperimentalAuthorizationWebhook, "experimental-authorization-webhook", "", "Address of a webhook authorizing the requests on the key space, as an HTTP URL or 'unix://<socket path>'. The roles of the users decide when it has no opinion.")
+	fs.DurationVar(&cfg.ec.ExperimentalAuthorizationWebhookTimeout, "experimental-authorization-webhook-timeout", cfg.ec.ExperimentalAuthorizationWebhookTimeout, "Timeout of the requests to the authorization webhook.")
 
 	// gateway
 	fs.BoolVar(&cfg.ec.EnableGRPCGateway, "enable-grpc-gateway", cfg.ec.EnableGRPCGateway, "Enable GRPC gateway.")
@@ -304,6 +314,16 @@ func newConfig() *config {
 	fs.DurationVar(&cfg.ec.ExperimentalCorruptCheckTime, "experimental-corrupt-check-time", cfg.ec.ExperimentalCorruptCheckTime, "Duration of time between cluster corruption check passes.")
 	fs.BoolVar(&cfg.ec.ExperimentalCompactHashCheckEnabled, "experimental-compact-hash-check-enabled", cfg.ec.ExperimentalCompactHashCheckEnabled, "Enable leader to periodically check followers compaction hashes.")
 	fs.DurationVar(&cfg.ec.ExperimentalCompactHashCheckTime, "experimental-compact-hash-check-time", cfg.ec.ExperimentalCompactHashCheckTime, "Duration of time between leader checks followers compaction hashes.")
+	fs.DurationVar(&cfg.ec.ExperimentalLatencyAlarmWindow, "experimental-latency-alarm-window", cfg.ec.ExperimentalLatencyAlarmWindow, "Duration of the window over which disk latency is checked to raise or clear the LATENCY alarm. 0 disables the alarm.")
+	fs.Float64Var(&cfg.ec.ExperimentalLatencyAlarmPercentile, "experimental-latency-alarm-percentile", cfg.ec.ExperimentalLatencyAlarmPercentile, "Percentile of disk latency compared against the LATENCY alarm thresholds.")
+	fs.DurationVar(&cfg.ec.ExperimentalLatencyAlarmWALFsyncThreshold, "experimental-latency-alarm-wal-fsync-threshold", cfg.ec.ExperimentalLatencyAlarmWALFsyncThreshold, "WAL fsync latency above which the LATENCY alarm is raised. 0 disables the check.")
+	fs.DurationVar(&cfg.ec.ExperimentalLatencyAlarmBackendCommitThreshold, "experimental-latency-alarm-backend-commit-threshold", cfg.ec.ExperimentalLatencyAlarmBackendCommitThreshold, "Backend commit latency above which the LATENCY alarm is raised. 0 disables the check.")
+	fs.BoolVar(&cfg.ec.ExperimentalLatencyAlarmLeaderTransfer, "experimental-latency-alarm-leader-transfer", cfg.ec.ExperimentalLatencyAlarmLeaderTransfer, "Transfer leadership away from the member while its LATENCY alarm is raised.")
+	fs.StringVar(&cfg.ec.ExperimentalAuditLogPath, "experimental-audit-log-path", "", "Path of the audit log, written as JSON lines. Auditing is disabled if empty.")
+	fs.StringVar(&cfg.ec.ExperimentalAuditPolicyFile, "experimental-audit-policy-file", "", "Path of the YAML audit policy selecting the audited request types and prefixes and the level of the records.")
+	fs.IntVar(&cfg.ec.ExperimentalAuditLogMaxSize, "experimental-audit-log-max-size", cfg.ec.ExperimentalAuditLogMaxSize, "Size in megabytes of the audit log before it is rotated.")
+	fs.IntVar(&cfg.ec.ExperimentalAuditLogMaxBackups, "experimental-audit-log-max-backups", 0, "Number of rotated audit logs to keep. 0 keeps all of them.")
+	fs.IntVar(&cfg.ec.ExperimentalAuditLogMaxAge, "experimental-audit-log-max-age", 0, "Number of days to keep the rotated audit logs. 0 keeps them forever.")
 
 	fs.BoolVar(&cfg.ec.ExperimentalEnableLeaseCheckpoint, "experimental-enable-lease-checkpoint", false, "Enable leader to send regular checkpoints to other members to prevent reset of remaining TTL on leader change.")
 	// TODO: delete in v3.7
@@ -199,7 +199,9 @@ Security:
 
 Auth:
   --auth-token 'simple'
-    Specify a v3 authentication token type and its options ('simple' or 'jwt').
+    Specify a v3 authentication token type and its options ('simple', 'jwt' or 'oidc').
+    For example 'oidc,jwks=<file or directory>,issuer=<issuer>,audience=<audience>' accepts tokens of an external issuer.
+    Their user names are prefixed with '<issuer>#' unless 'username-prefix' is set, and the 'root' role is never granted by them.
   --bcrypt-cost ` + fmt.Sprintf("%d", bcrypt.DefaultCost) + `
     Specify the cost / strength of the bcrypt algorithm for hashing auth passwords. Valid values are between ` + fmt.Sprintf("%d", bcrypt.MinCost) + ` and ` + fmt.Sprintf("%d", bcrypt.MaxCost) + `.
   --auth-token-ttl 300
@@ -260,6 +262,50 @@ Experimental feature:
     Enable leader to periodically check followers compaction hashes.
   --experimental-compact-hash-check-time '1m'
     Duration of time between leader checks followers compaction hashes.
+  --experimental-latency-alarm-window '0s'
+    Duration of the window over which disk latency is checked to raise or clear the LATENCY alarm. The alarm is cleared only by a window with enough operations. The alarm is not raised until all the members advertise the apply extensions. 0 disables the alarm.
+  --experimental-latency-alarm-percentile '0.99'
+    Percentile of disk latency compared against the LATENCY alarm thresholds.
+  --experimental-latency-alarm-wal-fsync-threshold '1s'
+    WAL fsync latency above which the LATENCY alarm is raised. 0 disables the check.
+  --experimental-latency-alarm-backend-commit-threshold '1s'
+    Backend commit latency above which the LATENCY alarm is raised. 0 disables the check.
+  --experimental-latency-alarm-leader-transfer 'false'
+    Transfer leadership away from the member while its LATENCY alarm is raised.
+  --experimental-audit-log-path ''
+    Path of the audit log, written as JSON lines. Auditing is disabled if empty.
+  --experimental-audit-policy-file ''
+    Path of the YAML audit policy selecting the audited request types and prefixes and the level of the records.
+  --experimental-audit-log-max-size '100'
+    Size in megabytes of the audit log before it is rotated.
+  --experimental-audit-log-max-backups '0'
+    Number of rotated audit logs to keep. 0 keeps all of them.
+  --experimental-audit-log-max-age '0'
+    Number of days to keep the rotated audit logs. 0 keeps them forever.
+  --experimental-auth-lockout-threshold '0'
+    Number of consecutive failed authentications after which a user or a source address is locked out. 0 disables the lockout.
+    The failed authentications are counted by each member, in memory, and forgotten on restart.
+  --experimental-auth-lockout-duration '1s'
+    Duration of the first authentication lockout, doubled by each further failed attempt.
+  --experimental-auth-lockout-max-duration '5m0s'
+    Maximum duration of an authentication lockout.
+  --experimental-password-min-length '0'
+    Minimum length of the user passwords.
+  --experimental-password-min-character-classes '0'
+    Minimum number of character classes (lower case, upper case, digits, others) of the user passwords.
+  --experimental-password-history '0'
+    Number of previous passwords of a user, including the current one, which cannot be reused.
+  --experimental-client-cert-identity ''
+    Client certificate attributes used as the username and the roles of the clients, as 'username=<attribute>[,roles=<attribute>]' with the attributes 'cn', 'ou', 'dns-san', 'uri-san' or 'spiffe'.
+    For example 'username=spiffe,roles=ou' authenticates SPIFFE certificates and grants their organizational units as roles. The root role is never granted by a certificate.
+  --experimental-enforce-operation-permissions 'false'
+    Require the operation permissions of the roles, or the root role, to list the members, move the leader, disarm alarms, compact and grant or revoke leases.
+    Users other than root may then only revoke the leases they granted.
+  --experimental-authorization-webhook ''
+    Address of a webhook authorizing the requests on the key space, as an HTTP URL or 'unix://<socket path>'. The roles of the users decide when it has no opinion.
+    The webhook receives the user, its roles as groups, the verb and the key range of each request as JSON, and replies with the decision and the seconds it may be cached.
+  --experimental-authorization-webhook-timeout '1s'
+    Timeout of the requests to the authorization webhook.
   --experimental-enable-v2v3 ''
     Serve v2 requests through the v3 backend under a given prefix. Deprecated and to be decommissioned in v3.6.
   --experimental-enable-lease-checkpoint 'false'
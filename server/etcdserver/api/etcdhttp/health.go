@@ -54,6 +54,7 @@ type ServerHealth interface {
 	Config() config.ServerConfig
 	AuthStore() auth.AuthStore
 	IsLearner() bool
+	ID() types.ID
 }
 
 type serverHealthV2V3 interface {
@@ -207,6 +208,11 @@ func checkAlarms(lg *zap.Logger, srv serverHealthV2V3, excludedAlarms StringSet)
 				lg.Debug("/health excluded alarm", zap.String("alarm", v.String()))
 				continue
 			}
+			// the LATENCY alarm concerns the disk of its member only; the v2
+			// servers without a member ID report it for any member
+			if m, ok := srv.(interface{ ID() types.ID }); ok && v.Alarm == pb.AlarmType_LATENCY && types.ID(v.MemberID) != m.ID() {
+				continue
+			}
 
 			h.Health = "false"
 			switch v.Alarm {
@@ -214,6 +220,8 @@ func checkAlarms(lg *zap.Logger, srv serverHealthV2V3, excludedAlarms StringSet)
 				h.Reason = "ALARM NOSPACE"
 			case pb.AlarmType_CORRUPT:
 				h.Reason = "ALARM CORRUPT"
+			case pb.AlarmType_LATENCY:
+				h.Reason = "ALARM LATENCY"
 			default:
 				h.Reason = "ALARM UNKNOWN"
 			}
@@ -283,6 +291,8 @@ func installLivezEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHeal
 func installReadyzEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHealth) {
 	reg := CheckRegistry{checkType: checkTypeReadyz, checks: make(map[string]HealthCheck)}
 	reg.Register("data_corruption", activeAlarmCheck(server, pb.AlarmType_CORRUPT))
+	// disk_latency checks if the local member has raised a latency alarm.
+	reg.Register("disk_latency", memberAlarmCheck(server, pb.AlarmType_LATENCY))
 	// serializable_read checks if local read is ok.
 	// linearizable_read checks if there is consensus in the cluster.
 	// Having both serializable_read and linearizable_read helps isolate the cause of problems if there is a read failure.
@@ -454,6 +464,18 @@ func activeAlarmCheck(srv ServerHealth, at pb.AlarmType) func(context.Context) e
 	}
 }
 
+func memberAlarmCheck(srv ServerHealth, at pb.AlarmType) func(context.Context) error {
+	return func(ctx context.Context) error {
+		as := srv.Alarms()
+		for _, v := range as {
+			if v.Alarm == at && types.ID(v.MemberID) == srv.ID() {
+				return fmt.Errorf("alarm activated: %s", at.String())
+			}
+		}
+		return nil
+	}
+}
+
 func readCheck(srv ServerHealth, serializable bool) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		ctx = srv.AuthStore().WithRoot(ctx)
@@ -52,6 +52,8 @@ func (s *fakeHealthServer) Leader() types.ID {
 	return types.ID(raft.None)
 }
 
+func (s *fakeHealthServer) ID() types.ID { return 1 }
+
 func (s *fakeHealthServer) AuthStore() auth.AuthStore { return s.authStore }
 
 func (s *fakeHealthServer) ClientCertAuthEnabled() bool { return false }
@@ -115,6 +117,24 @@ func TestHealthHandler(t *testing.T) {
 			healthCheckURL:   "/health?exclude=NOSPACE&exclude=CORRUPT",
 			expectStatusCode: http.StatusOK,
 		},
+		{
+			name:             "Unhealthy if LATENCY alarm is on",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(1), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/health",
+			expectStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:             "Healthy if LATENCY alarm is on for another member",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(2), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/health",
+			expectStatusCode: http.StatusOK,
+		},
+		{
+			name:             "Healthy if LATENCY alarm is on and excluded",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(1), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/health?exclude=LATENCY",
+			expectStatusCode: http.StatusOK,
+		},
 		{
 			name:             "Unhealthy if api is not available",
 			healthCheckURL:   "/health",
@@ -256,6 +276,54 @@ func TestDataCorruptionCheck(t *testing.T) {
 	}
 }
 
+func TestDiskLatencyCheck(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	tests := []healthTestCase{
+		{
+			name:             "Live if LATENCY alarm is on",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(1), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/livez",
+			expectStatusCode: http.StatusOK,
+			notInResult:      []string{"disk_latency"},
+		},
+		{
+			name:             "Not ready if local LATENCY alarm is on",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(1), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/readyz",
+			expectStatusCode: http.StatusServiceUnavailable,
+			inResult:         []string{"[-]disk_latency failed: alarm activated: LATENCY"},
+		},
+		{
+			name:             "ready if LATENCY alarm is on for another member",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(2), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/readyz",
+			expectStatusCode: http.StatusOK,
+		},
+		{
+			name:             "ready if LATENCY alarm is excluded",
+			alarms:           []*pb.AlarmMember{{MemberID: uint64(1), Alarm: pb.AlarmType_LATENCY}},
+			healthCheckURL:   "/readyz?exclude=disk_latency",
+			expectStatusCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			logger := zaptest.NewLogger(t)
+			s := &fakeHealthServer{
+				authStore: auth.NewAuthStore(logger, be, nil, 0),
+			}
+			HandleHealth(logger, mux, s)
+			ts := httptest.NewServer(mux)
+			defer ts.Close()
+			checkHttpResponse(t, ts, tt.healthCheckURL, http.StatusOK, nil, nil)
+			s.alarms = tt.alarms
+			checkHttpResponse(t, ts, tt.healthCheckURL, tt.expectStatusCode, tt.inResult, tt.notInResult)
+		})
+	}
+}
+
 func TestSerializableReadCheck(t *testing.T) {
 	be, _ := betesting.NewDefaultTmpBackend(t)
 	defer betesting.Close(t, be)
@@ -133,6 +133,22 @@ func (c *RaftCluster) Members() []*Member {
 	return []*Member(ms)
 }
 
+// IsCapabilityEnabled returns true if all the members of the cluster
+// advertise the capability c.
+func (c *RaftCluster) IsCapabilityEnabled(capability string) bool {
+	c.Lock()
+	defer c.Unlock()
+	if len(c.members) == 0 {
+		return false
+	}
+	for _, m := range c.members {
+		if !m.HasCapability(capability) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *RaftCluster) Member(id types.ID) *Member {
 	c.Lock()
 	defer c.Unlock()
@@ -1022,6 +1022,44 @@ func TestIsVersionChangable(t *testing.T) {
 	}
 }
 
+func TestIsCapabilityEnabled(t *testing.T) {
+	capable := Attributes{Capabilities: []string{"other", ApplyExtensionsCapability}}
+	tests := []struct {
+		name           string
+		members        []*Member
+		expectedResult bool
+	}{
+		{
+			name:           "When there is no member",
+			expectedResult: false,
+		},
+		{
+			name:           "When all the members advertise the capability",
+			members:        []*Member{{ID: 1, Attributes: capable}, {ID: 2, Attributes: capable}},
+			expectedResult: true,
+		},
+		{
+			name:           "When a member advertises no capability",
+			members:        []*Member{{ID: 1, Attributes: capable}, {ID: 2}},
+			expectedResult: false,
+		},
+		{
+			name:           "When a member advertises other capabilities",
+			members:        []*Member{{ID: 1, Attributes: capable}, {ID: 2, Attributes: Attributes{Capabilities: []string{"other"}}}},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCluster(t, tt.members)
+			if ret := c.IsCapabilityEnabled(ApplyExtensionsCapability); ret != tt.expectedResult {
+				t.Errorf("Expected %v; Got %v", tt.expectedResult, ret)
+			}
+		})
+	}
+}
+
 func TestAddMemberSyncsBackendAndStoreV2(t *testing.T) {
 	now := time.Now()
 	alice := NewMember("", nil, "alice", &now)
@@ -35,10 +35,30 @@ type RaftAttributes struct {
 	IsLearner bool `json:"isLearner,omitempty"`
 }
 
+// ApplyExtensionsCapability is advertised by the members that apply the
+// raft requests and request fields added on top of the 3.5 apply, which the
+// members not advertising it reject or ignore: the deny and templated
+// permissions, the session and unlock requests, the roles granted by auth
+// tokens, the key ranges allowed by the authorizer and the latency alarm.
+const ApplyExtensionsCapability = "apply-extensions"
+
 // Attributes represents all the non-raft related attributes of an etcd member.
 type Attributes struct {
 	Name       string   `json:"name,omitempty"`
 	ClientURLs []string `json:"clientURLs,omitempty"`
+	// Capabilities are the capabilities the member advertises when it
+	// publishes its attributes.
+	Capabilities []string `json:"capabilities,omitempty"`
+}
+
+// HasCapability returns true if the member advertises the capability c.
+func (a Attributes) HasCapability(c string) bool {
+	for _, mc := range a.Capabilities {
+		if mc == c {
+			return true
+		}
+	}
+	return false
 }
 
 type Member struct {
@@ -118,6 +138,10 @@ func (m *Member) Clone() *Member {
 		mm.ClientURLs = make([]string, len(m.ClientURLs))
 		copy(mm.ClientURLs, m.ClientURLs)
 	}
+	if m.Capabilities != nil {
+		mm.Capabilities = make([]string, len(m.Capabilities))
+		copy(mm.Capabilities, m.Capabilities)
+	}
 	return mm
 }
 
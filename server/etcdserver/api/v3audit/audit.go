@@ -0,0 +1,120 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v3audit records the requests of clients as JSON lines.
+package v3audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Results of the audited requests.
+const (
+	ResultSuccess = "success"
+	ResultDenied  = "denied"
+	ResultFailure = "failure"
+)
+
+// KeyRange is a key, or a range of keys if RangeEnd is set, of a request.
+type KeyRange struct {
+	Key      string `json:"key"`
+	RangeEnd string `json:"range-end,omitempty"`
+	// Value is the value written to the key, if the policy includes values.
+	Value string `json:"value,omitempty"`
+}
+
+// Record is an audited request.
+type Record struct {
+	Time     time.Time `json:"time"`
+	MemberID string    `json:"member-id"`
+	// User is the user of the auth token or of the TLS certificate of the
+	// request, or the user authenticated by an Authenticate request.
+	User   string   `json:"user,omitempty"`
+	Roles  []string `json:"roles,omitempty"`
+	Remote string   `json:"remote,omitempty"`
+	Type   string   `json:"type"`
+	Method string   `json:"method"`
+	// Name is the user, role, member or lease a request is about.
+	Name     string     `json:"name,omitempty"`
+	Keys     []KeyRange `json:"keys,omitempty"`
+	Result   string     `json:"result"`
+	Error    string     `json:"error,omitempty"`
+	Revision int64      `json:"revision,omitempty"`
+}
+
+// LogConfig configures the file of the records and its rotation.
+type LogConfig struct {
+	Path string
+	// MaxSize is the size in megabytes of the file before it is rotated.
+	MaxSize int
+	// MaxBackups is the number of rotated files to keep, all if zero.
+	MaxBackups int
+	// MaxAge is the number of days to keep the rotated files, forever if zero.
+	MaxAge int
+}
+
+// Logger writes records as JSON lines.
+type Logger struct {
+	policy *Policy
+
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+// NewLogger returns a logger of the requests audited by policy to the
+// rotated file of cfg.
+func NewLogger(cfg LogConfig, policy *Policy) *Logger {
+	return NewLoggerWithWriter(&lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    cfg.MaxSize,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAge,
+	}, policy)
+}
+
+// NewLoggerWithWriter returns a logger of the requests audited by policy to w.
+func NewLoggerWithWriter(w io.WriteCloser, policy *Policy) *Logger {
+	if policy == nil {
+		policy = DefaultPolicy()
+	}
+	return &Logger{policy: policy, w: w}
+}
+
+// Policy returns the policy of the audited requests.
+func (l *Logger) Policy() *Policy { return l.policy }
+
+// Log writes a record.
+func (l *Logger) Log(r *Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.w.Write(b)
+	return err
+}
+
+// Close closes the file of the records.
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Close()
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3audit
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Request types of the audited requests.
+const (
+	TypeRange        = "range"
+	TypeWatch        = "watch"
+	TypePut          = "put"
+	TypeDelete       = "delete"
+	TypeTxn          = "txn"
+	TypeCompact      = "compact"
+	TypeLease        = "lease"
+	TypeAuthenticate = "authenticate"
+	TypeAuth         = "auth"
+	TypeMember       = "member"
+	TypeMaintenance  = "maintenance"
+)
+
+var knownTypes = map[string]bool{
+	TypeRange:        true,
+	TypeWatch:        true,
+	TypePut:          true,
+	TypeDelete:       true,
+	TypeTxn:          true,
+	TypeCompact:      true,
+	TypeLease:        true,
+	TypeAuthenticate: true,
+	TypeAuth:         true,
+	TypeMember:       true,
+	TypeMaintenance:  true,
+}
+
+// Levels of detail of the records.
+const (
+	// LevelMetadata records the keys of the requests without their values.
+	LevelMetadata = "metadata"
+	// LevelValues also records the values written by the requests.
+	LevelValues = "values"
+)
+
+// Policy selects the requests that are audited.
+type Policy struct {
+	// RequestTypes are the types of the audited requests. All the types but
+	// range are audited if empty.
+	RequestTypes []string `json:"request-types"`
+	// Prefixes limit the audited key requests to the ones on keys with
+	// one of the prefixes, if set. Requests without keys are not limited.
+	Prefixes []string `json:"prefixes"`
+	// Level is the detail of the records, metadata if empty.
+	Level string `json:"level"`
+	// SkipDenied disables the records of the requests denied by auth which
+	// are not audited otherwise.
+	SkipDenied bool `json:"skip-denied"`
+
+	types map[string]bool
+}
+
+// DefaultPolicy audits all the requests but ranges, without values.
+func DefaultPolicy() *Policy {
+	p := &Policy{}
+	if err := p.validate(); err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// LoadPolicy reads a policy from a yaml or json file.
+func LoadPolicy(path string) (*Policy, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policy{}
+	if err = yaml.UnmarshalStrict(b, p); err != nil {
+		return nil, fmt.Errorf("invalid audit policy %s: %v", path, err)
+	}
+	if err = p.validate(); err != nil {
+		return nil, fmt.Errorf("invalid audit policy %s: %v", path, err)
+	}
+	return p, nil
+}
+
+func (p *Policy) validate() error {
+	switch p.Level {
+	case "":
+		p.Level = LevelMetadata
+	case LevelMetadata, LevelValues:
+	default:
+		return fmt.Errorf("unknown level %q", p.Level)
+	}
+	p.types = make(map[string]bool)
+	for _, t := range p.RequestTypes {
+		if !knownTypes[t] {
+			return fmt.Errorf("unknown request type %q", t)
+		}
+		p.types[t] = true
+	}
+	if len(p.RequestTypes) == 0 {
+		for t := range knownTypes {
+			p.types[t] = t != TypeRange
+		}
+	}
+	return nil
+}
+
+// IncludesValues returns true if the records include the written values.
+func (p *Policy) IncludesValues() bool {
+	return p.Level == LevelValues
+}
+
+// Audits returns true if a request of the given type on the given key ranges
+// is audited. Requests which do not change the state of the cluster, but
+// ranges, are only audited if they are denied by auth.
+func (p *Policy) Audits(typ string, readOnly, denied bool, keys []KeyRange) bool {
+	audited := p.types[typ] && (!readOnly || typ == TypeRange)
+	if !audited && (!denied || p.SkipDenied) {
+		return false
+	}
+	if len(p.Prefixes) == 0 || len(keys) == 0 {
+		return true
+	}
+	for _, k := range keys {
+		for _, prefix := range p.Prefixes {
+			if overlapsPrefix([]byte(k.Key), []byte(k.RangeEnd), []byte(prefix)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlapsPrefix returns true if the key, or the range [key, end), has keys
+// with the prefix.
+func overlapsPrefix(key, end, prefix []byte) bool {
+	if bytes.HasPrefix(key, prefix) {
+		return true
+	}
+	if len(end) == 0 || bytes.Compare(key, prefix) > 0 {
+		return false
+	}
+	// the range starts before the prefix, so it has keys with the prefix if
+	// it ends after the prefix
+	return (len(end) == 1 && end[0] == 0) || bytes.Compare(end, prefix) > 0
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3audit
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	tests := []struct {
+		policy string
+		ok     bool
+	}{
+		{"", true},
+		{"request-types: [put, txn]\nprefixes: [/a/]\nlevel: values\nskip-denied: true\n", true},
+		{"request-types: [get]\n", false},
+		{"level: all\n", false},
+		{"unknown: true\n", false},
+	}
+	for i, tt := range tests {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		if err := ioutil.WriteFile(path, []byte(tt.policy), 0600); err != nil {
+			t.Fatal(err)
+		}
+		_, err := LoadPolicy(path)
+		if (err == nil) != tt.ok {
+			t.Errorf("#%d: expected ok %v, got %v", i, tt.ok, err)
+		}
+	}
+}
+
+func TestPolicyAudits(t *testing.T) {
+	p := &Policy{RequestTypes: []string{TypePut, TypeRange, TypeAuth}, Prefixes: []string{"/a/"}}
+	if err := p.validate(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		typ      string
+		readOnly bool
+		denied   bool
+		keys     []KeyRange
+		audited  bool
+	}{
+		{TypePut, false, false, []KeyRange{{Key: "/a/x"}}, true},
+		{TypePut, false, false, []KeyRange{{Key: "/b/x"}}, false},
+		{TypeRange, true, false, []KeyRange{{Key: "/", RangeEnd: "0"}}, true},
+		{TypeRange, true, false, []KeyRange{{Key: "/", RangeEnd: "/a/"}}, false},
+		{TypeRange, true, false, []KeyRange{{Key: "\x00", RangeEnd: "\x00"}}, true},
+		{TypeDelete, false, false, []KeyRange{{Key: "/a/x"}}, false},
+		{TypeDelete, false, true, []KeyRange{{Key: "/a/x"}}, true},
+		{TypeAuth, false, false, nil, true},
+		{TypeAuth, true, false, nil, false},
+		{TypeAuth, true, true, nil, true},
+	}
+	for i, tt := range tests {
+		if audited := p.Audits(tt.typ, tt.readOnly, tt.denied, tt.keys); audited != tt.audited {
+			t.Errorf("#%d: expected audited %v, got %v", i, tt.audited, audited)
+		}
+	}
+
+	p.SkipDenied = true
+	if p.Audits(TypeDelete, false, true, []KeyRange{{Key: "/a/x"}}) {
+		t.Error("expected the denied delete not to be audited")
+	}
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	p := DefaultPolicy()
+	if p.Audits(TypeRange, true, false, []KeyRange{{Key: "a"}}) {
+		t.Error("expected ranges not to be audited by default")
+	}
+	if !p.Audits(TypeTxn, false, false, []KeyRange{{Key: "a"}}) {
+		t.Error("expected txns to be audited by default")
+	}
+	if p.IncludesValues() {
+		t.Error("expected values not to be included by default")
+	}
+}
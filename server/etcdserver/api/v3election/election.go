@@ -41,7 +41,7 @@ func (es *electionServer) Campaign(ctx context.Context, req *epb.CampaignRequest
 		return nil, err
 	}
 	e := concurrency.NewElection(s, string(req.Name))
-	if err = e.Campaign(ctx, string(req.Value)); err != nil {
+	if err = e.Campaign(ctx, string(req.Value), concurrency.WithPriority(req.Priority)); err != nil {
 		return nil, err
 	}
 	return &epb.CampaignResponse{
@@ -120,6 +120,21 @@ func (es *electionServer) Resign(ctx context.Context, req *epb.ResignRequest) (*
 	return &epb.ResignResponse{Header: e.Header()}, nil
 }
 
+func (es *electionServer) Transfer(ctx context.Context, req *epb.TransferRequest) (*epb.TransferResponse, error) {
+	if req.Leader == nil {
+		return nil, ErrMissingLeaderKey
+	}
+	s, err := es.session(ctx, req.Leader.Lease)
+	if err != nil {
+		return nil, err
+	}
+	e := concurrency.ResumeElection(s, string(req.Leader.Name), string(req.Leader.Key), req.Leader.Rev)
+	if err := e.Transfer(ctx, string(req.Candidate)); err != nil {
+		return nil, err
+	}
+	return &epb.TransferResponse{Header: e.Header()}, nil
+}
+
 func (es *electionServer) session(ctx context.Context, lease int64) (*concurrency.Session, error) {
 	s, err := concurrency.NewSession(
 		es.c,
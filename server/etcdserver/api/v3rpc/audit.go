@@ -0,0 +1,356 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	watchMethod          = "/etcdserverpb.Watch/Watch"
+	leaseKeepAliveMethod = "/etcdserverpb.Lease/LeaseKeepAlive"
+)
+
+func newAuditUnaryInterceptor(s *etcdserver.EtcdServer, al *v3audit.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		typ, name, keys, readOnly := auditedRequest(req, al.Policy().IncludesValues())
+		if typ == "" || !al.Policy().Audits(typ, readOnly, true, keys) {
+			return handler(ctx, req)
+		}
+
+		// the identity is taken before the request, which may revoke it
+		base := newAuditRecord(s, ctx, info.FullMethod)
+		if base.User == "" {
+			if ar, ok := req.(*pb.AuthenticateRequest); ok {
+				base.User = ar.Name
+			}
+		}
+		resp, err := handler(ctx, req)
+
+		var rev int64
+		if h, ok := resp.(interface{ GetHeader() *pb.ResponseHeader }); ok && h.GetHeader() != nil {
+			rev = h.GetHeader().Revision
+		}
+		logAuditRecord(s, al, base, typ, name, keys, readOnly, err, rev)
+		return resp, err
+	}
+}
+
+// newAuditStreamInterceptor audits the streams of the watches, the lease
+// keep alives and the snapshots. A watch is audited for each of its
+// creations, the other streams once they end.
+func newAuditStreamInterceptor(s *etcdserver.EtcdServer, al *v3audit.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		switch info.FullMethod {
+		case watchMethod, leaseKeepAliveMethod, snapshotMethod:
+		default:
+			return handler(srv, ss)
+		}
+		as := &auditedStream{
+			ServerStream: ss,
+			s:            s,
+			al:           al,
+			base:         newAuditRecord(s, ss.Context(), info.FullMethod),
+		}
+		err := handler(srv, as)
+
+		switch info.FullMethod {
+		case leaseKeepAliveMethod:
+			as.mu.Lock()
+			name := as.name
+			as.mu.Unlock()
+			logAuditRecord(s, al, as.base, v3audit.TypeLease, name, nil, true, err, 0)
+		case snapshotMethod:
+			// a snapshot discloses the whole key space, so it is audited
+			// like a change
+			logAuditRecord(s, al, as.base, v3audit.TypeMaintenance, "", nil, false, err, 0)
+		}
+		return err
+	}
+}
+
+// auditedStream matches the watch creations received on a stream with the
+// responses sent on it, which are sent in the order of the creations.
+type auditedStream struct {
+	grpc.ServerStream
+	s    *etcdserver.EtcdServer
+	al   *v3audit.Logger
+	base v3audit.Record
+
+	mu sync.Mutex
+	// creates are the keys of the watch creations not responded yet.
+	creates [][]v3audit.KeyRange
+	// name is the lease of the first keep alive of the stream.
+	name string
+}
+
+func (as *auditedStream) RecvMsg(m interface{}) error {
+	err := as.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	switch r := m.(type) {
+	case *pb.WatchRequest:
+		if cr := r.GetCreateRequest(); cr != nil {
+			as.creates = append(as.creates, []v3audit.KeyRange{rangeKeys(cr.Key, cr.RangeEnd)})
+		}
+	case *pb.LeaseKeepAliveRequest:
+		if as.name == "" {
+			as.name = leaseName(r.ID)
+		}
+	}
+	return nil
+}
+
+func (as *auditedStream) SendMsg(m interface{}) error {
+	if wr, ok := m.(*pb.WatchResponse); ok && wr.Created {
+		as.mu.Lock()
+		var keys []v3audit.KeyRange
+		if len(as.creates) > 0 {
+			keys, as.creates = as.creates[0], as.creates[1:]
+		}
+		as.mu.Unlock()
+
+		var err error
+		if wr.Canceled {
+			err = cancelReasonError(wr.CancelReason)
+		}
+		var rev int64
+		if wr.Header != nil {
+			rev = wr.Header.Revision
+		}
+		logAuditRecord(as.s, as.al, as.base, v3audit.TypeWatch, "", keys, true, err, rev)
+	}
+	return as.ServerStream.SendMsg(m)
+}
+
+// newAuditRecord returns a record with the identity of the client of ctx.
+func newAuditRecord(s *etcdserver.EtcdServer, ctx context.Context, method string) v3audit.Record {
+	r := v3audit.Record{MemberID: s.ID().String(), Method: method}
+	if ai, err := s.AuthInfoFromCtx(ctx); err == nil && ai != nil {
+		r.User, r.Roles = ai.Username, ai.Roles
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.Remote = p.Addr.String()
+	}
+	return r
+}
+
+// logAuditRecord writes the record of a request if the policy audits it.
+func logAuditRecord(s *etcdserver.EtcdServer, al *v3audit.Logger, base v3audit.Record, typ, name string, keys []v3audit.KeyRange, readOnly bool, err error, rev int64) {
+	denied := isAuthDenial(err)
+	if !al.Policy().Audits(typ, readOnly, denied, keys) {
+		return
+	}
+
+	r := base
+	r.Time = time.Now()
+	r.Type, r.Name, r.Keys, r.Revision = typ, name, keys, rev
+	r.Result = v3audit.ResultSuccess
+	switch {
+	case denied:
+		r.Result, r.Error = v3audit.ResultDenied, rpctypes.ErrorDesc(err)
+	case err != nil:
+		r.Result, r.Error = v3audit.ResultFailure, rpctypes.ErrorDesc(err)
+	}
+	if lerr := al.Log(&r); lerr != nil {
+		s.Logger().Warn("failed to write audit record", zap.String("method", r.Method), zap.Error(lerr))
+	}
+}
+
+var authDenials = []error{
+	rpctypes.ErrGRPCPermissionDenied,
+	rpctypes.ErrGRPCUserEmpty,
+	rpctypes.ErrGRPCUserNotFound,
+	rpctypes.ErrGRPCAuthFailed,
+	rpctypes.ErrGRPCAuthLocked,
+	rpctypes.ErrGRPCInvalidAuthToken,
+}
+
+// isAuthDenial returns true if err denies a request, including the errors
+// of the auth store which the servers return unconverted.
+func isAuthDenial(err error) bool {
+	if grpcErr, ok := toGRPCErrorMap[err]; ok {
+		err = grpcErr
+	}
+	for _, d := range authDenials {
+		if err == d {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelReasonError returns the error of the cancel reason of a watch
+// creation.
+func cancelReasonError(reason string) error {
+	for _, d := range authDenials {
+		if reason == d.Error() {
+			return d
+		}
+	}
+	return fmt.Errorf("%s", reason)
+}
+
+// auditedRequest returns the audit type of a request, the name of the user,
+// role, member or lease it is about and its keys. readOnly is true if the
+// request does not change the state of the cluster. The type is empty if
+// the request is never audited.
+func auditedRequest(req interface{}, values bool) (typ, name string, keys []v3audit.KeyRange, readOnly bool) {
+	switch r := req.(type) {
+	case *pb.RangeRequest:
+		return v3audit.TypeRange, "", []v3audit.KeyRange{rangeKeys(r.Key, r.RangeEnd)}, true
+	case *pb.PutRequest:
+		return v3audit.TypePut, "", []v3audit.KeyRange{putKeys(r, values)}, false
+	case *pb.DeleteRangeRequest:
+		return v3audit.TypeDelete, "", []v3audit.KeyRange{rangeKeys(r.Key, r.RangeEnd)}, false
+	case *pb.TxnRequest:
+		return v3audit.TypeTxn, "", txnKeys(r, values), false
+	case *pb.CompactionRequest:
+		return v3audit.TypeCompact, "", nil, false
+
+	case *pb.LeaseGrantRequest:
+		return v3audit.TypeLease, leaseName(r.ID), nil, false
+	case *pb.LeaseRevokeRequest:
+		return v3audit.TypeLease, leaseName(r.ID), nil, false
+	case *pb.LeaseTimeToLiveRequest:
+		return v3audit.TypeLease, leaseName(r.ID), nil, true
+	case *pb.LeaseLeasesRequest:
+		return v3audit.TypeLease, "", nil, true
+
+	case *pb.AuthenticateRequest:
+		return v3audit.TypeAuthenticate, r.Name, nil, false
+	case *pb.AuthEnableRequest, *pb.AuthDisableRequest:
+		return v3audit.TypeAuth, "", nil, false
+	case *pb.AuthStatusRequest, *pb.AuthUserListRequest, *pb.AuthRoleListRequest:
+		return v3audit.TypeAuth, "", nil, true
+	case *pb.AuthUserAddRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthUserDeleteRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthUserChangePasswordRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthUserGrantRoleRequest:
+		return v3audit.TypeAuth, r.User, nil, false
+	case *pb.AuthUserRevokeRoleRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthUserUnlockRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthUserGetRequest:
+		return v3audit.TypeAuth, r.Name, nil, true
+	case *pb.AuthSessionListRequest:
+		return v3audit.TypeAuth, r.Name, nil, true
+	case *pb.AuthSessionRevokeRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthRoleAddRequest:
+		return v3audit.TypeAuth, r.Name, nil, false
+	case *pb.AuthRoleDeleteRequest:
+		return v3audit.TypeAuth, r.Role, nil, false
+	case *pb.AuthRoleGetRequest:
+		return v3audit.TypeAuth, r.Role, nil, true
+	case *pb.AuthRoleGrantPermissionRequest:
+		if r.Perm == nil {
+			return v3audit.TypeAuth, r.Name, nil, false
+		}
+		return v3audit.TypeAuth, r.Name, []v3audit.KeyRange{rangeKeys(r.Perm.Key, r.Perm.RangeEnd)}, false
+	case *pb.AuthRoleRevokePermissionRequest:
+		if r.OperationPerm != nil {
+			return v3audit.TypeAuth, r.Role, nil, false
+		}
+		return v3audit.TypeAuth, r.Role, []v3audit.KeyRange{rangeKeys(r.Key, r.RangeEnd)}, false
+
+	case *pb.MemberAddRequest:
+		return v3audit.TypeMember, "", nil, false
+	case *pb.MemberRemoveRequest:
+		return v3audit.TypeMember, memberName(r.ID), nil, false
+	case *pb.MemberUpdateRequest:
+		return v3audit.TypeMember, memberName(r.ID), nil, false
+	case *pb.MemberPromoteRequest:
+		return v3audit.TypeMember, memberName(r.ID), nil, false
+	case *pb.MemberListRequest:
+		return v3audit.TypeMember, "", nil, true
+
+	case *pb.AlarmRequest:
+		return v3audit.TypeMaintenance, memberName(r.MemberID), nil, r.Action == pb.AlarmRequest_GET
+	case *pb.MoveLeaderRequest:
+		return v3audit.TypeMaintenance, memberName(r.TargetID), nil, false
+	case *pb.DefragmentRequest, *pb.DowngradeRequest:
+		return v3audit.TypeMaintenance, "", nil, false
+	case *pb.StatusRequest, *pb.HashRequest, *pb.HashKVRequest:
+		return v3audit.TypeMaintenance, "", nil, true
+	}
+	return "", "", nil, false
+}
+
+func rangeKeys(key, end []byte) v3audit.KeyRange {
+	return v3audit.KeyRange{Key: string(key), RangeEnd: string(end)}
+}
+
+func putKeys(r *pb.PutRequest, values bool) v3audit.KeyRange {
+	k := v3audit.KeyRange{Key: string(r.Key)}
+	if values {
+		k.Value = string(r.Value)
+	}
+	return k
+}
+
+func txnKeys(r *pb.TxnRequest, values bool) (keys []v3audit.KeyRange) {
+	for _, c := range r.Compare {
+		keys = append(keys, rangeKeys(c.Key, c.RangeEnd))
+	}
+	for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				keys = append(keys, rangeKeys(tv.RequestRange.Key, tv.RequestRange.RangeEnd))
+			case *pb.RequestOp_RequestPut:
+				keys = append(keys, putKeys(tv.RequestPut, values))
+			case *pb.RequestOp_RequestDeleteRange:
+				keys = append(keys, rangeKeys(tv.RequestDeleteRange.Key, tv.RequestDeleteRange.RangeEnd))
+			case *pb.RequestOp_RequestTxn:
+				keys = append(keys, txnKeys(tv.RequestTxn, values)...)
+			}
+		}
+	}
+	return keys
+}
+
+func leaseName(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%016x", id)
+}
+
+func memberName(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%016x", id)
+}
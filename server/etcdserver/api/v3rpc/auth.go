@@ -157,6 +157,30 @@ func (as *AuthServer) UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRo
 	return resp, nil
 }
 
+func (as *AuthServer) UserUnlock(ctx context.Context, r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error) {
+	resp, err := as.authenticator.UserUnlock(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) SessionList(ctx context.Context, r *pb.AuthSessionListRequest) (*pb.AuthSessionListResponse, error) {
+	resp, err := as.authenticator.SessionList(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) SessionRevoke(ctx context.Context, r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error) {
+	resp, err := as.authenticator.SessionRevoke(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 func (as *AuthServer) UserChangePassword(ctx context.Context, r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
 	resp, err := as.authenticator.UserChangePassword(ctx, r)
 	if err != nil {
@@ -47,6 +47,9 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 		newUnaryInterceptor(s),
 		grpc_prometheus.UnaryServerInterceptor,
 	}
+	if s.Cfg.AuditLogger != nil {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, newAuditUnaryInterceptor(s, s.Cfg.AuditLogger))
+	}
 	if interceptor != nil {
 		chainUnaryInterceptors = append(chainUnaryInterceptors, interceptor)
 	}
@@ -55,6 +58,9 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 		newStreamInterceptor(s),
 		grpc_prometheus.StreamServerInterceptor,
 	}
+	if s.Cfg.AuditLogger != nil {
+		chainStreamInterceptors = append(chainStreamInterceptors, newAuditStreamInterceptor(s, s.Cfg.AuditLogger))
+	}
 
 	if s.Cfg.ExperimentalEnableDistributedTracing {
 		chainUnaryInterceptors = append(chainUnaryInterceptors, otelgrpc.UnaryServerInterceptor(s.Cfg.ExperimentalTracerOptions...))
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
@@ -85,7 +86,7 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
 	}
-	return &authMaintenanceServer{srv, s}
+	return &authMaintenanceServer{srv, s, s.Cfg.EnforceOperationPermissions}
 }
 
 func (ms *maintenanceServer) Defragment(ctx context.Context, sr *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
@@ -195,7 +196,16 @@ func (ms *maintenanceServer) Hash(ctx context.Context, r *pb.HashRequest) (*pb.H
 }
 
 func (ms *maintenanceServer) HashKV(ctx context.Context, r *pb.HashKVRequest) (*pb.HashKVResponse, error) {
-	h, rev, err := ms.hasher.HashByRev(r.Revision)
+	var (
+		h   mvcc.KeyValueHash
+		rev int64
+		err error
+	)
+	if len(r.Key) == 0 {
+		h, rev, err = ms.hasher.HashByRev(r.Revision)
+	} else {
+		h, rev, err = ms.hasher.HashByRevRange(r.Revision, r.Key, r.RangeEnd)
+	}
 	if err != nil {
 		return nil, togRPCError(err)
 	}
@@ -264,6 +274,9 @@ func (ms *maintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeReque
 type authMaintenanceServer struct {
 	*maintenanceServer
 	ag AuthGetter
+	// enforceOperationPermissions requires the operation permission to
+	// move the leader.
+	enforceOperationPermissions bool
 }
 
 func (ams *authMaintenanceServer) isAuthenticated(ctx context.Context) error {
@@ -275,8 +288,19 @@ func (ams *authMaintenanceServer) isAuthenticated(ctx context.Context) error {
 	return ams.ag.AuthStore().IsAdminPermitted(authInfo)
 }
 
+// isOperationPermitted checks whether the user is root or is permitted to
+// perform op.
+func (ams *authMaintenanceServer) isOperationPermitted(ctx context.Context, op authpb.OperationPermission_Type) error {
+	authInfo, err := ams.ag.AuthInfoFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ams.ag.AuthStore().IsOperationPermitted(authInfo, op)
+}
+
 func (ams *authMaintenanceServer) Defragment(ctx context.Context, sr *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
-	if err := ams.isAuthenticated(ctx); err != nil {
+	if err := ams.isOperationPermitted(ctx, authpb.DEFRAGMENT); err != nil {
 		return nil, err
 	}
 
@@ -284,7 +308,7 @@ func (ams *authMaintenanceServer) Defragment(ctx context.Context, sr *pb.Defragm
 }
 
 func (ams *authMaintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance_SnapshotServer) error {
-	if err := ams.isAuthenticated(srv.Context()); err != nil {
+	if err := ams.isOperationPermitted(srv.Context(), authpb.SNAPSHOT); err != nil {
 		return err
 	}
 
@@ -311,6 +335,11 @@ func (ams *authMaintenanceServer) Status(ctx context.Context, ar *pb.StatusReque
 }
 
 func (ams *authMaintenanceServer) MoveLeader(ctx context.Context, tr *pb.MoveLeaderRequest) (*pb.MoveLeaderResponse, error) {
+	if ams.enforceOperationPermissions {
+		if err := ams.isOperationPermitted(ctx, authpb.MOVE_LEADER); err != nil {
+			return nil, err
+		}
+	}
 	return ams.maintenanceServer.MoveLeader(ctx, tr)
 }
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -88,6 +89,9 @@ func (cs *ClusterServer) MemberUpdate(ctx context.Context, r *pb.MemberUpdateReq
 }
 
 func (cs *ClusterServer) MemberList(ctx context.Context, r *pb.MemberListRequest) (*pb.MemberListResponse, error) {
+	if err := cs.server.CheckEnforcedOperationPermission(ctx, authpb.MEMBER_LIST); err != nil {
+		return nil, togRPCError(err)
+	}
 	if r.Linearizable {
 		if err := cs.server.LinearizableReadNotify(ctx); err != nil {
 			return nil, togRPCError(err)
@@ -134,7 +134,7 @@ type serverWatchStream struct {
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// mu protects progress, prevKV, fragment, permFilter
 	mu sync.RWMutex
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
@@ -143,6 +143,8 @@ type serverWatchStream struct {
 	prevKV map[mvcc.WatchID]bool
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// record watch IDs that drop the events on the keys the user cannot read
+	permFilter map[mvcc.WatchID]*watchPermFilter
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -173,6 +175,8 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		prevKV:   make(map[mvcc.WatchID]bool),
 		fragment: make(map[mvcc.WatchID]bool),
 
+		permFilter: make(map[mvcc.WatchID]*watchPermFilter),
+
 		closec: make(chan struct{}),
 	}
 
@@ -234,9 +238,91 @@ func (sws *serverWatchStream) isWatchPermitted(wcr *pb.WatchCreateRequest) error
 		// if auth is enabled, IsRangePermitted() can cause an error
 		authInfo = &auth.AuthInfo{}
 	}
+	attrs := []auth.Attributes{{Verb: auth.VerbRead, Key: wcr.Key, RangeEnd: wcr.RangeEnd}}
+	if err = sws.ag.AuthStore().Authorize(sws.gRPCStream.Context(), authInfo, attrs); err != nil {
+		return err
+	}
 	return sws.ag.AuthStore().IsRangePermitted(authInfo, wcr.Key, wcr.RangeEnd)
 }
 
+// maxWatchPermFilterKeys bounds the number of keys whose permission
+// decisions are cached by a filtered watch.
+const maxWatchPermFilterKeys = 1024
+
+// watchPermFilter drops the events of a watch on the keys its user is not
+// permitted to read. The decisions of the roles of the user are cached until
+// the auth revision changes, then the permissions are evaluated again. The
+// decisions of the authorizer are cached by the auth store.
+type watchPermFilter struct {
+	ctx      context.Context
+	as       auth.AuthStore
+	authInfo auth.AuthInfo
+	// permitted caches the decisions for the auth revision of authInfo
+	permitted map[string]bool
+}
+
+func (sws *serverWatchStream) newWatchPermFilter() (*watchPermFilter, error) {
+	authInfo, err := sws.ag.AuthInfoFromCtx(sws.gRPCStream.Context())
+	if err != nil {
+		return nil, err
+	}
+	if authInfo == nil {
+		// the events are dropped if auth is enabled later
+		authInfo = &auth.AuthInfo{}
+	}
+	as := sws.ag.AuthStore()
+	if as.IsAuthEnabled() {
+		if authInfo.Revision == 0 {
+			return nil, auth.ErrUserEmpty
+		}
+		if authInfo.Revision < as.Revision() {
+			return nil, auth.ErrAuthOldRevision
+		}
+	}
+	return &watchPermFilter{ctx: sws.gRPCStream.Context(), as: as, authInfo: *authInfo, permitted: make(map[string]bool)}, nil
+}
+
+// isPermitted checks the permission of the user to read key, and whether
+// the decision may be cached until the auth revision changes.
+func (f *watchPermFilter) isPermitted(key []byte) (ok, cacheable bool) {
+	ai := f.authInfo
+	if err := f.as.Authorize(f.ctx, &ai, []auth.Attributes{{Verb: auth.VerbRead, Key: key}}); err != nil {
+		return false, false
+	}
+	if len(ai.Authorized) > 0 {
+		return true, false
+	}
+	return f.as.IsRangePermitted(&ai, key, nil) == nil, true
+}
+
+// filter returns the events on the keys the user is permitted to read.
+func (f *watchPermFilter) filter(evs []mvccpb.Event) []mvccpb.Event {
+	if !f.as.IsAuthEnabled() {
+		return evs
+	}
+	if rev := f.as.Revision(); rev != f.authInfo.Revision {
+		f.authInfo.Revision = rev
+		f.permitted = make(map[string]bool)
+	}
+	permitted := make([]mvccpb.Event, 0, len(evs))
+	for _, ev := range evs {
+		ok, cached := f.permitted[string(ev.Kv.Key)]
+		if !cached {
+			var cacheable bool
+			if ok, cacheable = f.isPermitted(ev.Kv.Key); cacheable {
+				if len(f.permitted) >= maxWatchPermFilterKeys {
+					f.permitted = make(map[string]bool)
+				}
+				f.permitted[string(ev.Kv.Key)] = ok
+			}
+		}
+		if ok {
+			permitted = append(permitted, ev)
+		}
+	}
+	return permitted
+}
+
 func (sws *serverWatchStream) recvLoop() error {
 	for {
 		req, err := sws.gRPCStream.Recv()
@@ -268,7 +354,15 @@ func (sws *serverWatchStream) recvLoop() error {
 				creq.RangeEnd = []byte{}
 			}
 
-			err := sws.isWatchPermitted(creq)
+			var (
+				permFilter *watchPermFilter
+				err        error
+			)
+			if creq.FilterUnpermitted {
+				permFilter, err = sws.newWatchPermFilter()
+			} else {
+				err = sws.isWatchPermitted(creq)
+			}
 			if err != nil {
 				var cancelReason string
 				switch err {
@@ -308,9 +402,12 @@ func (sws *serverWatchStream) recvLoop() error {
 			if rev == 0 {
 				rev = wsrev + 1
 			}
+			// the options of the watch are set before sendLoop takes sws.mu
+			// to send its first events, which may be past events on the keys
+			// the user cannot read
+			sws.mu.Lock()
 			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
 			if err == nil {
-				sws.mu.Lock()
 				if creq.ProgressNotify {
 					sws.progress[id] = true
 				}
@@ -320,10 +417,13 @@ func (sws *serverWatchStream) recvLoop() error {
 				if creq.Fragment {
 					sws.fragment[id] = true
 				}
-				sws.mu.Unlock()
+				if permFilter != nil {
+					sws.permFilter[id] = permFilter
+				}
 			} else {
 				id = clientv3.InvalidWatchID
 			}
+			sws.mu.Unlock()
 
 			wr := &pb.WatchResponse{
 				Header:   sws.newResponseHeader(wsrev),
@@ -360,6 +460,7 @@ func (sws *serverWatchStream) recvLoop() error {
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
 					delete(sws.fragment, mvcc.WatchID(id))
+					delete(sws.permFilter, mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
@@ -411,10 +512,19 @@ func (sws *serverWatchStream) sendLoop() {
 			// either return []*mvccpb.Event from the mvcc package
 			// or define protocol buffer with []mvccpb.Event.
 			evs := wresp.Events
-			events := make([]*mvccpb.Event, len(evs))
 			sws.mu.RLock()
 			needPrevKV := sws.prevKV[wresp.WatchID]
+			permFilter := sws.permFilter[wresp.WatchID]
 			sws.mu.RUnlock()
+			if permFilter != nil && len(evs) > 0 {
+				evs = permFilter.filter(evs)
+				mvcc.ReportEventReceived(len(wresp.Events) - len(evs))
+				if len(evs) == 0 && wresp.CompactRevision == 0 {
+					// the user cannot read any key of the revision
+					continue
+				}
+			}
+			events := make([]*mvccpb.Event, len(evs))
 			for i := range evs {
 				events[i] = &evs[i]
 				if needPrevKV && !IsCreateEvent(evs[i]) {
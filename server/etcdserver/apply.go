@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/coreos/go-semver/semver"
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -35,6 +36,8 @@ import (
 	"go.etcd.io/etcd/server/v3/mvcc"
 
 	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -88,6 +91,8 @@ type applierV3 interface {
 	UserGrantRole(ua *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error)
 	UserGet(ua *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error)
 	UserRevokeRole(ua *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
+	UserUnlock(ua *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error)
+	SessionRevoke(ua *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error)
 	RoleAdd(ua *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 	RoleGrantPermission(ua *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error)
 	RoleGet(ua *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error)
@@ -133,6 +138,10 @@ func (s *EtcdServer) newApplierV3() applierV3 {
 func (a *applierV3backend) Apply(r *pb.InternalRaftRequest, shouldApplyV3 membership.ShouldApplyV3) *applyResult {
 	op := "unknown"
 	ar := &applyResult{}
+	ctx, span := startChildSpan(extractTraceContext(r.Header), a.s.getTracer(), "etcdserver.apply",
+		trace.WithAttributes(attribute.String("member_id", a.s.ID().String())),
+	)
+	a.s.beHooks.setApplyingSpan(span.SpanContext())
 	defer func(start time.Time) {
 		success := ar.err == nil || ar.err == mvcc.ErrCompacted
 		applySec.WithLabelValues(v3Version, op, strconv.FormatBool(success)).Observe(time.Since(start).Seconds())
@@ -140,6 +149,10 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest, shouldApplyV3 member
 		if !success {
 			warnOfFailedRequest(a.s.Logger(), start, &pb.InternalRaftStringer{Request: r}, ar.resp, ar.err)
 		}
+		a.s.beHooks.setApplyingSpan(trace.SpanContext{})
+		span.SetAttributes(attribute.String("op", op))
+		traceApply(ctx, a.s.getTracer(), op, ar.trace, ar.err)
+		endSpan(span, ar.err)
 	}(time.Now())
 
 	switch {
@@ -219,6 +232,12 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest, shouldApplyV3 member
 	case r.AuthUserRevokeRole != nil:
 		op = "AuthUserRevokeRole"
 		ar.resp, ar.err = a.s.applyV3.UserRevokeRole(r.AuthUserRevokeRole)
+	case r.AuthUserUnlock != nil:
+		op = "AuthUserUnlock"
+		ar.resp, ar.err = a.s.applyV3.UserUnlock(r.AuthUserUnlock)
+	case r.AuthSessionRevoke != nil:
+		op = "AuthSessionRevoke"
+		ar.resp, ar.err = a.s.applyV3.SessionRevoke(r.AuthSessionRevoke)
 	case r.AuthRoleAdd != nil:
 		op = "AuthRoleAdd"
 		ar.resp, ar.err = a.s.applyV3.RoleAdd(r.AuthRoleAdd)
@@ -756,6 +775,8 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 			a.s.applyV3 = newApplierV3Corrupt(a)
 		case pb.AlarmType_NOSPACE:
 			a.s.applyV3 = newApplierV3Capped(a)
+		case pb.AlarmType_LATENCY:
+			// latency alarms only report degraded members; requests are still served
 		default:
 			lg.Panic("unimplemented alarm activation", zap.String("alarm", fmt.Sprintf("%+v", m)))
 		}
@@ -775,6 +796,8 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 			// TODO: check kv hash before deactivating CORRUPT?
 			lg.Warn("alarm disarmed", zap.String("alarm", m.Alarm.String()), zap.String("from", types.ID(m.MemberID).String()))
 			a.s.applyV3 = a.s.newApplierV3()
+		case pb.AlarmType_LATENCY:
+			lg.Warn("alarm disarmed", zap.String("alarm", m.Alarm.String()), zap.String("from", types.ID(m.MemberID).String()))
 		default:
 			lg.Warn("unimplemented alarm deactivation", zap.String("alarm", fmt.Sprintf("%+v", m)))
 		}
@@ -829,6 +852,17 @@ func (a *applierV3backend) AuthStatus() (*pb.AuthStatusResponse, error) {
 
 func (a *applierV3backend) Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error) {
 	ctx := context.WithValue(context.WithValue(a.s.ctx, auth.AuthenticateParamIndex{}, a.s.consistIndex.ConsistentIndex()), auth.AuthenticateParamSimpleTokenPrefix{}, r.SimpleToken)
+	session := &authpb.Session{
+		IssuedAt:      r.IssuedAt,
+		SourceAddress: r.SourceAddress,
+		MemberId:      r.MemberId,
+	}
+	// the lifetime is decided by the member that issued the token, so that
+	// all the members record the same expiration
+	if r.SessionTtl > 0 {
+		session.ExpiresAt = r.IssuedAt + r.SessionTtl
+	}
+	ctx = context.WithValue(ctx, auth.AuthenticateParamSession{}, session)
 	resp, err := a.s.AuthStore().Authenticate(ctx, r.Name, r.Password)
 	if resp != nil {
 		resp.Header = newHeader(a.s)
@@ -884,6 +918,22 @@ func (a *applierV3backend) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.
 	return resp, err
 }
 
+func (a *applierV3backend) UserUnlock(r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error) {
+	resp, err := a.s.AuthStore().UserUnlock(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
+func (a *applierV3backend) SessionRevoke(r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error) {
+	resp, err := a.s.AuthStore().SessionRevoke(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
 func (a *applierV3backend) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	resp, err := a.s.AuthStore().RoleAdd(r)
 	if resp != nil {
@@ -948,8 +998,9 @@ func (a *applierV3backend) ClusterMemberAttrSet(r *membershippb.ClusterMemberAtt
 	a.s.cluster.UpdateAttributes(
 		types.ID(r.Member_ID),
 		membership.Attributes{
-			Name:       r.MemberAttributes.Name,
-			ClientURLs: r.MemberAttributes.ClientUrls,
+			Name:         r.MemberAttributes.Name,
+			ClientURLs:   r.MemberAttributes.ClientUrls,
+			Capabilities: r.MemberAttributes.Capabilities,
 		},
 		shouldApplyV3,
 	)
@@ -50,17 +50,17 @@ func (aa *authApplierV3) Apply(r *pb.InternalRaftRequest, shouldApplyV3 membersh
 		// does not have header field
 		aa.authInfo.Username = r.Header.Username
 		aa.authInfo.Revision = r.Header.AuthRevision
+		aa.authInfo.Roles = r.Header.Roles
+		aa.authInfo.Authorized = r.Header.Authorized
 	}
 	if needAdminPermission(r) {
 		if err := aa.as.IsAdminPermitted(&aa.authInfo); err != nil {
-			aa.authInfo.Username = ""
-			aa.authInfo.Revision = 0
+			aa.authInfo = auth.AuthInfo{}
 			return &applyResult{err: err}
 		}
 	}
 	ret := aa.applierV3.Apply(r, shouldApplyV3)
-	aa.authInfo.Username = ""
-	aa.authInfo.Revision = 0
+	aa.authInfo = auth.AuthInfo{}
 	return ret
 }
 
@@ -162,6 +162,87 @@ func checkTxnAuth(as auth.AuthStore, ai *auth.AuthInfo, rt *pb.TxnRequest) error
 	return checkTxnReqsPermission(as, ai, rt.Failure)
 }
 
+// requestAttributes returns the key ranges accessed by r, as checked by
+// authApplierV3, for the authorizer.
+func (s *EtcdServer) requestAttributes(r *pb.InternalRaftRequest) []auth.Attributes {
+	switch {
+	case r.Range != nil:
+		return rangeAttributes(r.Range)
+	case r.Put != nil:
+		return s.putAttributes(r.Put)
+	case r.DeleteRange != nil:
+		return deleteRangeAttributes(r.DeleteRange)
+	case r.Txn != nil:
+		return s.txnAttributes(r.Txn)
+	case r.LeaseRevoke != nil:
+		return s.leaseAttributes(lease.LeaseID(r.LeaseRevoke.ID))
+	}
+	return nil
+}
+
+func rangeAttributes(r *pb.RangeRequest) []auth.Attributes {
+	return []auth.Attributes{{Verb: auth.VerbRead, Key: r.Key, RangeEnd: r.RangeEnd}}
+}
+
+func (s *EtcdServer) putAttributes(r *pb.PutRequest) []auth.Attributes {
+	attrs := []auth.Attributes{{Verb: auth.VerbWrite, Key: r.Key}}
+	if r.PrevKv {
+		attrs = append(attrs, auth.Attributes{Verb: auth.VerbRead, Key: r.Key})
+	}
+	return append(attrs, s.leaseAttributes(lease.LeaseID(r.Lease))...)
+}
+
+func deleteRangeAttributes(r *pb.DeleteRangeRequest) []auth.Attributes {
+	attrs := []auth.Attributes{{Verb: auth.VerbWrite, Key: r.Key, RangeEnd: r.RangeEnd}}
+	if r.PrevKv {
+		attrs = append(attrs, auth.Attributes{Verb: auth.VerbRead, Key: r.Key, RangeEnd: r.RangeEnd})
+	}
+	return attrs
+}
+
+func (s *EtcdServer) txnAttributes(rt *pb.TxnRequest) []auth.Attributes {
+	var attrs []auth.Attributes
+	for _, c := range rt.Compare {
+		attrs = append(attrs, auth.Attributes{Verb: auth.VerbRead, Key: c.Key, RangeEnd: c.RangeEnd})
+	}
+	for _, reqs := range [][]*pb.RequestOp{rt.Success, rt.Failure} {
+		for _, requ := range reqs {
+			switch tv := requ.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				if tv.RequestRange != nil {
+					attrs = append(attrs, rangeAttributes(tv.RequestRange)...)
+				}
+			case *pb.RequestOp_RequestPut:
+				if tv.RequestPut != nil {
+					attrs = append(attrs, s.putAttributes(tv.RequestPut)...)
+				}
+			case *pb.RequestOp_RequestDeleteRange:
+				if tv.RequestDeleteRange != nil {
+					attrs = append(attrs, deleteRangeAttributes(tv.RequestDeleteRange)...)
+				}
+			}
+		}
+	}
+	return attrs
+}
+
+// leaseAttributes returns the keys attached to a lease, which are written
+// when the lease is revoked.
+func (s *EtcdServer) leaseAttributes(id lease.LeaseID) []auth.Attributes {
+	if id == lease.NoLease {
+		return nil
+	}
+	l := s.lessor.Lookup(id)
+	if l == nil {
+		return nil
+	}
+	var attrs []auth.Attributes
+	for _, key := range l.Keys() {
+		attrs = append(attrs, auth.Attributes{Verb: auth.VerbWrite, Key: []byte(key)})
+	}
+	return attrs
+}
+
 func (aa *authApplierV3) Txn(ctx context.Context, rt *pb.TxnRequest) (*pb.TxnResponse, *traceutil.Trace, error) {
 	if err := checkTxnAuth(aa.as, &aa.authInfo, rt); err != nil {
 		return nil, nil, err
@@ -169,6 +250,18 @@ func (aa *authApplierV3) Txn(ctx context.Context, rt *pb.TxnRequest) (*pb.TxnRes
 	return aa.applierV3.Txn(ctx, rt)
 }
 
+func (aa *authApplierV3) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	resp, err := aa.applierV3.LeaseGrant(lc)
+	if err != nil || aa.authInfo.Username == "" {
+		return resp, err
+	}
+	// the owner may revoke the lease when operation permissions are enforced
+	if err = aa.lessor.SetOwner(lease.LeaseID(lc.ID), aa.authInfo.Username); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (aa *authApplierV3) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
 	if err := aa.checkLeasePuts(lease.LeaseID(lc.ID)); err != nil {
 		return nil, err
@@ -211,6 +304,19 @@ func (aa *authApplierV3) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetRespo
 	return aa.applierV3.UserGet(r)
 }
 
+// SessionRevoke lets the users revoke their own sessions, for example the
+// session of a leaked token.
+func (aa *authApplierV3) SessionRevoke(r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error) {
+	err := aa.as.IsAdminPermitted(&aa.authInfo)
+	if err != nil && r.Name != aa.authInfo.Username {
+		aa.authInfo.Username = ""
+		aa.authInfo.Revision = 0
+		return &pb.AuthSessionRevokeResponse{}, err
+	}
+
+	return aa.applierV3.SessionRevoke(r)
+}
+
 func (aa *authApplierV3) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error) {
 	err := aa.as.IsAdminPermitted(&aa.authInfo)
 	if err != nil && !aa.as.HasRole(aa.authInfo.Username, r.Role) {
@@ -240,6 +346,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthUserRevokeRole != nil:
 		return true
+	case r.AuthUserUnlock != nil:
+		return true
 	case r.AuthRoleAdd != nil:
 		return true
 	case r.AuthRoleGrantPermission != nil:
@@ -375,6 +375,11 @@ func (f *fakeHasher) HashByRev(rev int64) (hash mvcc.KeyValueHash, revision int6
 	return hashByRev.hash, hashByRev.revision, hashByRev.err
 }
 
+func (f *fakeHasher) HashByRevRange(rev int64, key, end []byte) (hash mvcc.KeyValueHash, revision int64, err error) {
+	f.actions = append(f.actions, fmt.Sprintf("HashByRevRange(%d, %q, %q)", rev, key, end))
+	return mvcc.KeyValueHash{}, 0, nil
+}
+
 func (f *fakeHasher) Store(hash mvcc.KeyValueHash) {
 	f.actions = append(f.actions, fmt.Sprintf("Store(%v)", hash))
 	f.hashes = append(f.hashes, hash)
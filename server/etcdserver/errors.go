@@ -40,6 +40,7 @@ var (
 	ErrKeyNotFound                   = errors.New("etcdserver: key not found")
 	ErrCorrupt                       = errors.New("etcdserver: corrupt cluster")
 	ErrBadLeaderTransferee           = errors.New("etcdserver: bad leader transferee")
+	ErrNotSupportedByMembers         = errors.New("etcdserver: request not supported by all the members")
 	ErrClusterVersionUnavailable     = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat   = errors.New("etcdserver: wrong downgrade target version format")
 	ErrInvalidDowngradeTargetVersion = errors.New("etcdserver: invalid downgrade target version")
@@ -0,0 +1,272 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+	"go.etcd.io/etcd/server/v3/wal"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/zap"
+)
+
+const (
+	walFsyncDurationMetric      = "etcd_disk_wal_fsync_duration_seconds"
+	backendCommitDurationMetric = "etcd_disk_backend_commit_duration_seconds"
+
+	// latencyAlarmMinSamples is the number of observations a window needs
+	// before its percentile may raise or clear the LATENCY alarm, so that a
+	// single slow or fast operation on an idle member does not change it.
+	latencyAlarmMinSamples = 10
+)
+
+// latencyThreshold is the latency allowed for the operations recorded by
+// a duration histogram.
+type latencyThreshold struct {
+	metric    string
+	histogram prometheus.Histogram
+	threshold time.Duration
+}
+
+// latencyMonitor computes latency percentiles of disk duration histograms
+// over the window between consecutive checks.
+type latencyMonitor struct {
+	percentile float64
+	thresholds []latencyThreshold
+
+	prev map[string]*dto.Histogram
+}
+
+// latencyViolation describes an operation whose latency exceeds its threshold.
+type latencyViolation struct {
+	metric    string
+	latency   time.Duration
+	threshold time.Duration
+}
+
+func newLatencyMonitor(percentile float64, thresholds []latencyThreshold) *latencyMonitor {
+	return &latencyMonitor{percentile: percentile, thresholds: thresholds}
+}
+
+// check returns the operations whose latency percentile exceeded their
+// thresholds since the previous check, and whether every threshold had
+// enough observations to be judged. The first check only records the
+// current histograms.
+func (lm *latencyMonitor) check() ([]latencyViolation, bool, error) {
+	cur := make(map[string]*dto.Histogram)
+	for _, lt := range lm.thresholds {
+		m := &dto.Metric{}
+		if err := lt.histogram.Write(m); err != nil {
+			return nil, false, err
+		}
+		cur[lt.metric] = m.GetHistogram()
+	}
+	prev := lm.prev
+	lm.prev = cur
+	if prev == nil {
+		return nil, false, nil
+	}
+
+	var vs []latencyViolation
+	judged := true
+	for _, lt := range lm.thresholds {
+		if lt.threshold <= 0 {
+			continue
+		}
+		q, n := histogramQuantile(lm.percentile, prev[lt.metric], cur[lt.metric])
+		if n < latencyAlarmMinSamples {
+			judged = false
+			continue
+		}
+		if latency := time.Duration(q * float64(time.Second)); latency > lt.threshold {
+			vs = append(vs, latencyViolation{metric: lt.metric, latency: latency, threshold: lt.threshold})
+		}
+	}
+	return vs, judged, nil
+}
+
+// histogramQuantile estimates the q-quantile of the observations recorded
+// by cur since prev, interpolating linearly within buckets as Prometheus'
+// histogram_quantile does. It also returns the number of observations.
+// Observations beyond the highest bucket are estimated at its upper bound.
+func histogramQuantile(q float64, prev, cur *dto.Histogram) (float64, uint64) {
+	bs := cur.GetBucket()
+	prevCounts := make(map[float64]uint64)
+	var prevCount uint64
+	if prev != nil {
+		for _, b := range prev.GetBucket() {
+			prevCounts[b.GetUpperBound()] = b.GetCumulativeCount()
+		}
+		prevCount = prev.GetSampleCount()
+	}
+	if cur.GetSampleCount() < prevCount {
+		// histogram was reset; use the current observations only
+		prevCounts, prevCount = map[float64]uint64{}, 0
+	}
+	total := cur.GetSampleCount() - prevCount
+	if total == 0 || len(bs) == 0 {
+		return 0, 0
+	}
+
+	rank := q * float64(total)
+	var lower float64
+	var below uint64
+	for _, b := range bs {
+		upper := b.GetUpperBound()
+		if math.IsInf(upper, 1) {
+			break
+		}
+		cum := b.GetCumulativeCount() - prevCounts[upper]
+		if float64(cum) >= rank {
+			inBucket := cum - below
+			if inBucket == 0 {
+				return upper, total
+			}
+			return lower + (upper-lower)*(rank-float64(below))/float64(inBucket), total
+		}
+		lower, below = upper, cum
+	}
+	return lower, total
+}
+
+// monitorDiskLatency raises the LATENCY alarm for the local member while
+// its disk latency percentiles exceed the configured thresholds, and
+// clears it once latency recovers over a window with enough observations.
+func (s *EtcdServer) monitorDiskLatency() {
+	t := s.Cfg.LatencyAlarmWindow
+	if t == 0 {
+		return
+	}
+
+	lg := s.Logger()
+	lg.Info(
+		"enabled disk latency alarm",
+		zap.String("local-member-id", s.ID().String()),
+		zap.Duration("window", t),
+		zap.Float64("percentile", s.Cfg.LatencyAlarmPercentile),
+		zap.Duration("wal-fsync-threshold", s.Cfg.LatencyAlarmWALFsyncThreshold),
+		zap.Duration("backend-commit-threshold", s.Cfg.LatencyAlarmBackendCommitThreshold),
+	)
+	lm := newLatencyMonitor(s.Cfg.LatencyAlarmPercentile, []latencyThreshold{
+		{metric: walFsyncDurationMetric, histogram: wal.FsyncDurations(), threshold: s.Cfg.LatencyAlarmWALFsyncThreshold},
+		{metric: backendCommitDurationMetric, histogram: backend.CommitDurations(), threshold: s.Cfg.LatencyAlarmBackendCommitThreshold},
+	})
+	if _, _, err := lm.check(); err != nil {
+		lg.Warn("failed to gather disk latency", zap.Error(err))
+	}
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-time.After(t):
+		}
+		vs, judged, err := lm.check()
+		if err != nil {
+			lg.Warn("failed to gather disk latency", zap.Error(err))
+			continue
+		}
+		for _, v := range vs {
+			lg.Warn(
+				"disk latency exceeds threshold",
+				zap.String("local-member-id", s.ID().String()),
+				zap.String("metric", v.metric),
+				zap.Float64("percentile", s.Cfg.LatencyAlarmPercentile),
+				zap.Duration("latency", v.latency),
+				zap.Duration("threshold", v.threshold),
+			)
+		}
+
+		raised := s.latencyAlarmRaised(s.ID())
+		action := pb.AlarmRequest_GET
+		switch {
+		case len(vs) > 0 && !raised:
+			action = pb.AlarmRequest_ACTIVATE
+		case len(vs) == 0 && judged && raised:
+			action = pb.AlarmRequest_DEACTIVATE
+		}
+		if action != pb.AlarmRequest_GET {
+			if err = s.latencyAlarm(action); err != nil {
+				lg.Warn(
+					"failed to update latency alarm",
+					zap.String("local-member-id", s.ID().String()),
+					zap.String("action", action.String()),
+					zap.Error(err),
+				)
+			}
+		}
+		if len(vs) > 0 && s.Cfg.LatencyAlarmLeaderTransfer && s.isLeader() {
+			if err := s.transferLeadershipFromSlowDisk(); err != nil {
+				lg.Warn("failed to transfer leadership away from member with slow disk", zap.Error(err))
+			}
+		}
+	}
+}
+
+// latencyAlarmRaised reports whether the LATENCY alarm is raised for the member.
+func (s *EtcdServer) latencyAlarmRaised(id types.ID) bool {
+	for _, a := range s.alarmStore.Get(pb.AlarmType_LATENCY) {
+		if types.ID(a.MemberID) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *EtcdServer) latencyAlarm(action pb.AlarmRequest_AlarmAction) error {
+	// the members without the apply extensions panic on the LATENCY alarm
+	if !s.isApplyExtensionsEnabled() {
+		return ErrNotSupportedByMembers
+	}
+	a := &pb.AlarmRequest{
+		MemberID: uint64(s.ID()),
+		Action:   action,
+		Alarm:    pb.AlarmType_LATENCY,
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+	_, err := s.raftRequest(ctx, pb.InternalRaftRequest{Alarm: a})
+	return err
+}
+
+// transferLeadershipFromSlowDisk transfers leadership to the longest
+// connected voting member whose LATENCY alarm is not raised.
+func (s *EtcdServer) transferLeadershipFromSlowDisk() error {
+	// the alarms of the members telling the slow disks apart cannot be
+	// raised
+	if !s.isApplyExtensionsEnabled() {
+		return ErrNotSupportedByMembers
+	}
+	var candidates []types.ID
+	for _, id := range s.cluster.VotingMemberIDs() {
+		if id != s.ID() && !s.latencyAlarmRaised(id) {
+			candidates = append(candidates, id)
+		}
+	}
+	transferee, ok := longestConnected(s.r.transport, candidates)
+	if !ok {
+		return fmt.Errorf("no healthy transferee among %d voting members", len(s.cluster.VotingMemberIDs()))
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+	return s.MoveLeader(ctx, s.Lead(), uint64(transferee))
+}
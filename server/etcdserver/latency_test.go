@@ -0,0 +1,100 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestLatencyMonitorCheck(t *testing.T) {
+	fsync := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "wal_fsync_duration_seconds",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+	commit := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "backend_commit_duration_seconds",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+
+	lm := newLatencyMonitor(0.9, []latencyThreshold{
+		{metric: walFsyncDurationMetric, histogram: fsync, threshold: 100 * time.Millisecond},
+		{metric: backendCommitDurationMetric, histogram: commit, threshold: 100 * time.Millisecond},
+	})
+	observe := func(h prometheus.Histogram, d time.Duration, n int) {
+		for i := 0; i < n; i++ {
+			h.Observe(d.Seconds())
+		}
+	}
+
+	// slow observations before the first check are not part of any window
+	observe(fsync, time.Second, 100)
+	if vs, _, err := lm.check(); err != nil || len(vs) != 0 {
+		t.Fatalf("expected no violations on first check, got %+v, %v", vs, err)
+	}
+
+	observe(fsync, 2*time.Millisecond, 100)
+	observe(commit, 2*time.Millisecond, 100)
+	if vs, judged, err := lm.check(); err != nil || len(vs) != 0 || !judged {
+		t.Fatalf("expected no violations for fast disk, got %+v, %v, %v", vs, judged, err)
+	}
+
+	observe(fsync, 2*time.Millisecond, 80)
+	observe(commit, 2*time.Millisecond, 80)
+	observe(commit, 500*time.Millisecond, 20)
+	vs, _, err := lm.check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vs) != 1 || vs[0].metric != backendCommitDurationMetric {
+		t.Fatalf("expected backend commit violation, got %+v", vs)
+	}
+	if vs[0].latency <= 256*time.Millisecond || vs[0].latency > 512*time.Millisecond {
+		t.Fatalf("expected latency within (256ms, 512ms], got %v", vs[0].latency)
+	}
+
+	// too few observations to judge the window, which neither raises
+	// nor clears the alarm
+	observe(fsync, time.Second, latencyAlarmMinSamples-1)
+	observe(commit, 2*time.Millisecond, 100)
+	if vs, judged, err := lm.check(); err != nil || len(vs) != 0 || judged {
+		t.Fatalf("expected no violations for sparse window, got %+v, %v, %v", vs, judged, err)
+	}
+}
+
+func TestHistogramQuantileOverflow(t *testing.T) {
+	h := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_duration_seconds",
+		Buckets: []float64{0.1, 0.2},
+	})
+	for i := 0; i < 10; i++ {
+		h.Observe(5)
+	}
+	m := &dto.Metric{}
+	if err := h.Write(m); err != nil {
+		t.Fatal(err)
+	}
+	q, n := histogramQuantile(0.99, nil, m.GetHistogram())
+	if q != 0.2 || n != 10 {
+		t.Fatalf("expected highest bound 0.2 over 10 samples, got %v over %d", q, n)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/pkg/v3/wait"
+	"go.etcd.io/etcd/raft/v3/confchange"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/raft/v3/tracker"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/etcdserver/api"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/mvcc"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Replayer applies committed raft entries to a backend as a member does,
+// through the same appliers but without raft or peers. It rebuilds the
+// backend of a member at a past raft index from an older backend and the
+// WAL.
+//
+// The v2 store is not kept: v2 requests only update the membership stored
+// in the backend. Lease expiry is not replayed either, since expired leases
+// are revoked by the entries proposed by the leader.
+type Replayer struct {
+	s         *EtcdServer
+	confState raftpb.ConfState
+}
+
+// NewReplayer opens the backend at path to apply entries after its
+// consistent index.
+func NewReplayer(lg *zap.Logger, path string) (*Replayer, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	s := &EtcdServer{
+		lgMu:               new(sync.RWMutex),
+		lg:                 lg,
+		w:                  wait.New(),
+		applyWait:          wait.NewTimeList(),
+		stopping:           make(chan struct{}),
+		firstCommitInTermC: make(chan struct{}),
+		tracer:             newTracer(nil),
+	}
+	s.Cfg.Logger = lg
+	// the default of members
+	s.Cfg.WarningApplyDuration = 100 * time.Millisecond
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	r := &Replayer{s: s}
+
+	ci := cindex.NewConsistentIndex(nil)
+	s.consistIndex = ci
+	s.beHooks = &backendHooks{lg: lg, indexer: ci, tracer: s.tracer}
+	bcfg := backend.DefaultBackendConfig()
+	bcfg.Path = path
+	bcfg.Logger = lg
+	bcfg.Hooks = s.beHooks
+	s.be = backend.New(bcfg)
+	ci.SetBackend(s.be)
+	cindex.CreateMetaBucket(s.be.BatchTx())
+
+	s.cluster = membership.NewCluster(lg)
+	s.cluster.SetBackend(s.be)
+	s.cluster.Recover(api.UpdateCapability)
+	// conf changes are validated against the membership of the v2 store,
+	// which is seeded with the membership of the backend
+	s.v2store = v2store.New(StoreClusterPrefix, StoreKeysPrefix)
+	s.cluster.SetStore(s.v2store)
+	for _, m := range s.cluster.Members() {
+		s.cluster.AddMember(m, membership.ApplyV2storeOnly)
+	}
+	_, removed := s.cluster.MembersFromBackend()
+	for id := range removed {
+		if _, err := s.v2store.Create(membership.RemovedMemberStoreKey(id), false, "", false, v2store.TTLOptionSet{ExpireTime: v2store.Permanent}); err != nil {
+			r.Close()
+			return nil, err
+		}
+	}
+	s.applyV2 = NewApplierV2(lg, s.v2store, s.cluster)
+
+	s.lessor = lease.NewLessor(lg, s.be, s.cluster, lease.LessorConfig{})
+	s.kv = mvcc.New(lg, s.be, s.lessor, mvcc.StoreConfig{})
+	// tokens are not needed to replay requests, authentications do not
+	// change the backend.
+	tp, err := auth.NewTokenProvider(lg, "", nil, 0)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	s.authStore = auth.NewAuthStore(lg, s.be, tp, bcrypt.DefaultCost)
+
+	s.applyV3Base = s.newApplierV3Backend()
+	s.applyV3Internal = s.newApplierV3Internal()
+	if err = s.restoreAlarms(); err != nil {
+		r.Close()
+		return nil, err
+	}
+
+	tx := s.be.ReadTx()
+	tx.Lock()
+	if cs := membership.UnsafeConfStateFromBackend(lg, tx); cs != nil {
+		r.confState = *cs
+	}
+	tx.Unlock()
+	return r, nil
+}
+
+// ConsistentIndex returns the index of the last entry applied to the
+// backend.
+func (r *Replayer) ConsistentIndex() uint64 {
+	return r.s.consistIndex.ConsistentIndex()
+}
+
+// Revision returns the current revision of the key-value store.
+func (r *Replayer) Revision() int64 {
+	return r.s.kv.Rev()
+}
+
+// Apply applies committed entries. Entries up to the consistent index are
+// skipped. The errors of the requests are their results, as for a member,
+// and are not returned.
+func (r *Replayer) Apply(ents ...raftpb.Entry) error {
+	for _, e := range ents {
+		if err := r.applyEntry(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Replayer) applyEntry(e raftpb.Entry) error {
+	if e.Index <= r.s.consistIndex.ConsistentIndex() {
+		return nil
+	}
+	r.s.consistIndex.SetConsistentApplyingIndex(e.Index, e.Term)
+	// the backend has no hook moving the consistent index on the writes of
+	// the entry, which would be checked to happen in the apply loop of a
+	// member, so it is moved once the entry is applied.
+	defer r.s.consistIndex.SetConsistentIndex(e.Index, e.Term)
+
+	switch e.Type {
+	case raftpb.EntryNormal:
+		return r.applyNormal(e)
+	case raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+		if err := cc.Unmarshal(e.Data); err != nil {
+			return fmt.Errorf("cannot decode conf change at index %d: %v", e.Index, err)
+		}
+		return r.applyConfChange(cc)
+	default:
+		return fmt.Errorf("unsupported entry type %v at index %d", e.Type, e.Index)
+	}
+}
+
+func (r *Replayer) applyNormal(e raftpb.Entry) error {
+	// empty entries are appended by new leaders
+	if len(e.Data) == 0 {
+		return nil
+	}
+	var raftReq pb.InternalRaftRequest
+	if !pbutil.MaybeUnmarshal(&raftReq, e.Data) {
+		var req pb.Request
+		if err := req.Unmarshal(e.Data); err != nil {
+			return fmt.Errorf("cannot decode request at index %d: %v", e.Index, err)
+		}
+		r.s.applyV2Request((*RequestV2)(&req), membership.ApplyBoth)
+		return nil
+	}
+	if raftReq.V2 != nil {
+		r.s.applyV2Request((*RequestV2)(raftReq.V2), membership.ApplyBoth)
+		return nil
+	}
+	if ar := r.s.applyV3.Apply(&raftReq, membership.ApplyBoth); ar != nil && ar.physc != nil {
+		// wait for compactions, so that they are all done in the backend
+		// when the replay ends
+		<-ar.physc
+	}
+	return nil
+}
+
+// applyConfChange applies a conf change to the membership and to the
+// configuration of raft persisted in the backend, as applyConfChange does.
+func (r *Replayer) applyConfChange(cc raftpb.ConfChange) error {
+	lg := r.s.Logger()
+	if err := r.s.cluster.ValidateConfigurationChange(cc); err != nil {
+		// rejected conf changes are applied as no-ops
+		lg.Info("skipping rejected configuration change", zap.Uint64("node-id", cc.NodeID), zap.Error(err))
+		return nil
+	}
+
+	chg := confchange.Changer{Tracker: tracker.MakeProgressTracker(1)}
+	cfg, prs, err := confchange.Restore(chg, r.confState)
+	if err != nil {
+		return err
+	}
+	chg.Tracker.Config, chg.Tracker.Progress = cfg, prs
+	if cfg, prs, err = chg.Simple(cc.AsV2().Changes...); err != nil {
+		return err
+	}
+	chg.Tracker.Config, chg.Tracker.Progress = cfg, prs
+	r.confState = chg.Tracker.ConfState()
+	r.s.beHooks.SetConfState(&r.confState)
+
+	switch cc.Type {
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+		confChangeContext := new(membership.ConfigChangeContext)
+		if err := json.Unmarshal(cc.Context, confChangeContext); err != nil {
+			return fmt.Errorf("cannot decode member of conf change: %v", err)
+		}
+		if confChangeContext.IsPromote {
+			r.s.cluster.PromoteMember(confChangeContext.Member.ID, membership.ApplyBoth)
+		} else {
+			r.s.cluster.AddMember(&confChangeContext.Member, membership.ApplyBoth)
+		}
+	case raftpb.ConfChangeRemoveNode:
+		r.s.cluster.RemoveMember(types.ID(cc.NodeID), membership.ApplyBoth)
+	case raftpb.ConfChangeUpdateNode:
+		m := new(membership.Member)
+		if err := json.Unmarshal(cc.Context, m); err != nil {
+			return fmt.Errorf("cannot decode member of conf change: %v", err)
+		}
+		r.s.cluster.UpdateRaftAttributes(m.ID, m.RaftAttributes, membership.ApplyBoth)
+	}
+	return nil
+}
+
+// Close commits the applied entries and closes the backend.
+func (r *Replayer) Close() {
+	s := r.s
+	s.cancel()
+	close(s.stopping)
+	if s.authStore != nil {
+		s.authStore.Close()
+	}
+	if s.kv != nil {
+		s.kv.Close()
+	}
+	if s.lessor != nil {
+		s.lessor.Stop()
+	}
+	s.be.ForceCommit()
+	s.be.Close()
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/mvcc"
+)
+
+func TestReplayer(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	path := filepath.Join(t.TempDir(), "db")
+
+	put := func(k, v string) []byte {
+		return pbutil.MustMarshal(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte(k), Value: []byte(v)}})
+	}
+	m := membership.NewMember("m1", types.MustNewURLs([]string{"http://127.0.0.1:2380"}), "", nil)
+	ctx, err := json.Marshal(&membership.ConfigChangeContext{Member: *m})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: uint64(m.ID), Context: ctx}
+	ents := []raftpb.Entry{
+		{Index: 1, Term: 1, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&cc)},
+		{Index: 2, Term: 2},
+		{Index: 3, Term: 2, Data: put("foo", "bar")},
+		{Index: 4, Term: 2, Data: put("foo", "baz")},
+	}
+
+	r, err := NewReplayer(lg, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range ents[:3] {
+		if err = r.Apply(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r.Close()
+
+	// entries up to the consistent index are skipped
+	r, err = NewReplayer(lg, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if ci := r.ConsistentIndex(); ci != 3 {
+		t.Fatalf("consistent index = %d, want 3", ci)
+	}
+	if cs := r.confState; len(cs.Voters) != 1 || cs.Voters[0] != uint64(m.ID) {
+		t.Fatalf("voters = %v, want [%s]", cs.Voters, m.ID)
+	}
+	if r.s.cluster.Member(m.ID) == nil {
+		t.Fatalf("member %s not found", m.ID)
+	}
+	for _, e := range ents {
+		if err = r.Apply(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ci := r.ConsistentIndex(); ci != 4 {
+		t.Fatalf("consistent index = %d, want 4", ci)
+	}
+	if rev := r.Revision(); rev != 3 {
+		t.Fatalf("revision = %d, want 3", rev)
+	}
+	rr, err := r.s.KV().Range(context.TODO(), []byte("foo"), nil, mvcc.RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.KVs) != 1 || string(rr.KVs[0].Value) != "baz" {
+		t.Fatalf("foo = %v, want baz", rr.KVs)
+	}
+}
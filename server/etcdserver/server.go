@@ -37,8 +37,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/wal/walpb"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
 	"go.etcd.io/etcd/api/v3/version"
@@ -297,6 +299,12 @@ type EtcdServer struct {
 
 	*AccessController
 	corruptionChecker CorruptionChecker
+
+	// tracer records spans of traced requests through raft and apply.
+	tracer trace.Tracer
+	// commitSpans holds the spans of local proposals waiting to be
+	// committed, keyed by request ID.
+	commitSpans sync.Map
 }
 
 type backendHooks struct {
@@ -309,6 +317,15 @@ type backendHooks struct {
 	// not initialized `confState` is meaningless.
 	confStateDirty bool
 	confStateLock  sync.Mutex
+
+	// tracer records backend commits persisting traced requests.
+	tracer trace.Tracer
+	// applying is the span of the request being applied; the spans of the
+	// requests written since the last commit become links of the next one.
+	traceLock    sync.Mutex
+	applying     trace.SpanContext
+	pendingSpans []trace.SpanContext
+	commitSpan   trace.Span
 }
 
 func (bh *backendHooks) OnPreCommitUnsafe(tx backend.BatchTx) {
@@ -320,6 +337,7 @@ func (bh *backendHooks) OnPreCommitUnsafe(tx backend.BatchTx) {
 		// save bh.confState
 		bh.confStateDirty = false
 	}
+	bh.startBackendCommitSpan()
 }
 
 func (bh *backendHooks) SetConfState(confState *raftpb.ConfState) {
@@ -382,7 +400,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	beExist := fileutil.Exist(bepath)
 
 	ci := cindex.NewConsistentIndex(nil)
-	beHooks := &backendHooks{lg: cfg.Logger, indexer: ci}
+	beHooks := &backendHooks{lg: cfg.Logger, indexer: ci, tracer: newTracer(cfg.ExperimentalTracerProvider)}
 	be := openBackend(cfg, beHooks)
 	ci.SetBackend(be)
 	cindex.CreateMetaBucket(be.BatchTx())
@@ -578,8 +596,12 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 				storage:     NewStorage(w, ss),
 			},
 		),
-		id:                 id,
-		attributes:         membership.Attributes{Name: cfg.Name, ClientURLs: cfg.ClientURLs.StringSlice()},
+		id: id,
+		attributes: membership.Attributes{
+			Name:         cfg.Name,
+			ClientURLs:   cfg.ClientURLs.StringSlice(),
+			Capabilities: []string{membership.ApplyExtensionsCapability},
+		},
 		cluster:            cl,
 		stats:              sstats,
 		lstats:             lstats,
@@ -589,6 +611,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		AccessController:   &AccessController{CORS: cfg.CORS, HostWhitelist: cfg.HostWhitelist},
 		consistIndex:       ci,
 		firstCommitInTermC: make(chan struct{}),
+		tracer:             newTracer(cfg.ExperimentalTracerProvider),
 	}
 	serverID.With(prometheus.Labels{"server_id": id.String()}).Set(1)
 
@@ -618,6 +641,12 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		return nil, err
 	}
 
+	tlsIdentity, err := auth.ParseTLSIdentity(cfg.ClientCertIdentity)
+	if err != nil {
+		cfg.Logger.Warn("failed to parse client certificate identity", zap.Error(err))
+		return nil, err
+	}
+
 	mvccStoreConfig := mvcc.StoreConfig{
 		CompactionBatchLimit:    cfg.CompactionBatchLimit,
 		CompactionSleepInterval: cfg.CompactionSleepInterval,
@@ -643,6 +672,18 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	srv.corruptionChecker = newCorruptionChecker(cfg.Logger, srv, srv.kv.HashStorage())
 
 	srv.authStore = auth.NewAuthStore(srv.Logger(), srv.be, tp, int(cfg.BcryptCost))
+	srv.authStore.SetLockoutPolicy(auth.LockoutPolicy{
+		Threshold:   cfg.AuthLockoutThreshold,
+		Duration:    cfg.AuthLockoutDuration,
+		MaxDuration: cfg.AuthLockoutMaxDuration,
+	})
+	srv.authStore.SetPasswordPolicy(auth.PasswordPolicy{
+		MinLength:           cfg.PasswordMinLength,
+		MinCharacterClasses: cfg.PasswordMinCharacterClasses,
+		History:             cfg.PasswordHistory,
+	})
+	srv.authStore.SetTLSIdentity(tlsIdentity)
+	srv.authStore.SetAuthorizer(cfg.Authorizer)
 
 	newSrv := srv // since srv == nil in defer if srv is returned as nil
 	defer func() {
@@ -827,6 +868,7 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.linearizableReadLoop)
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorCompactHash)
+	s.GoAttach(s.monitorDiskLatency)
 	s.GoAttach(s.monitorDowngrade)
 }
 
@@ -1622,7 +1664,7 @@ func (s *EtcdServer) LeaderStats() []byte {
 
 func (s *EtcdServer) StoreStats() []byte { return s.v2store.JsonStats() }
 
-func (s *EtcdServer) checkMembershipOperationPermission(ctx context.Context) error {
+func (s *EtcdServer) checkOperationPermission(ctx context.Context, op authpb.OperationPermission_Type) error {
 	if s.authStore == nil {
 		// In the context of ordinary etcd process, s.authStore will never be nil.
 		// This branch is for handling cases in server_test.go
@@ -1633,18 +1675,19 @@ func (s *EtcdServer) checkMembershipOperationPermission(ctx context.Context) err
 	// so TOCTOU problem can be caused potentially in a schedule like this:
 	// update membership with user A -> revoke root role of A -> apply membership change
 	// in the state machine layer
-	// However, both of membership change and role management requires the root privilege.
+	// However, both of membership change and role management requires the root privilege
+	// or the permission of the operation.
 	// So careful operation by admins can prevent the problem.
 	authInfo, err := s.AuthInfoFromCtx(ctx)
 	if err != nil {
 		return err
 	}
 
-	return s.AuthStore().IsAdminPermitted(authInfo)
+	return s.AuthStore().IsOperationPermitted(authInfo, op)
 }
 
 func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*membership.Member, error) {
-	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+	if err := s.checkOperationPermission(ctx, authpb.MEMBER_ADD); err != nil {
 		return nil, err
 	}
 
@@ -1703,7 +1746,7 @@ func (s *EtcdServer) mayAddMember(memb membership.Member) error {
 }
 
 func (s *EtcdServer) RemoveMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
-	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+	if err := s.checkOperationPermission(ctx, authpb.MEMBER_REMOVE); err != nil {
 		return nil, err
 	}
 
@@ -1767,7 +1810,7 @@ func (s *EtcdServer) PromoteMember(ctx context.Context, id uint64) ([]*membershi
 // local node is leader (therefore has enough information) but decided the learner node is not ready
 // to be promoted.
 func (s *EtcdServer) promoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
-	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+	if err := s.checkOperationPermission(ctx, authpb.MEMBER_UPDATE); err != nil {
 		return nil, err
 	}
 
@@ -1913,7 +1956,7 @@ func (s *EtcdServer) UpdateMember(ctx context.Context, memb membership.Member) (
 		return nil, merr
 	}
 
-	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+	if err := s.checkOperationPermission(ctx, authpb.MEMBER_UPDATE); err != nil {
 		return nil, err
 	}
 	cc := raftpb.ConfChange{
@@ -2063,8 +2106,9 @@ func (s *EtcdServer) publishV3(timeout time.Duration) {
 	req := &membershippb.ClusterMemberAttrSetRequest{
 		Member_ID: uint64(s.id),
 		MemberAttributes: &membershippb.Attributes{
-			Name:       s.attributes.Name,
-			ClientUrls: s.attributes.ClientURLs,
+			Name:         s.attributes.Name,
+			ClientUrls:   s.attributes.ClientURLs,
+			Capabilities: s.attributes.Capabilities,
 		},
 	}
 	lg := s.Logger()
@@ -2323,6 +2367,7 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 	if id == 0 {
 		id = raftReq.Header.ID
 	}
+	s.endCommitSpan(id, nil)
 
 	needResult := s.w.IsRegistered(id)
 	if needResult || !noSideEffect(&raftReq) {
@@ -2600,6 +2645,13 @@ func (s *EtcdServer) ClusterVersion() *semver.Version {
 	return s.cluster.Version()
 }
 
+// isApplyExtensionsEnabled returns true if all the members of the cluster
+// apply the raft requests and request fields added on top of the 3.5 apply,
+// as they advertise membership.ApplyExtensionsCapability.
+func (s *EtcdServer) isApplyExtensionsEnabled() bool {
+	return s.cluster != nil && s.cluster.IsCapabilityEnabled(membership.ApplyExtensionsCapability)
+}
+
 // monitorVersions checks the member's version every monitorVersionInterval.
 // It updates the cluster version if all members agrees on a higher one.
 // It prints out log if there is a member with a higher version than the
@@ -2906,6 +2958,7 @@ func (s *EtcdServer) getTxPostLockInsideApplyHook() func() {
 		if applyingIdx > s.consistIndex.UnsafeConsistentIndex() {
 			s.consistIndex.SetConsistentIndex(applyingIdx, applyingTerm)
 		}
+		s.beHooks.traceApplyingWrite()
 	}
 }
 
@@ -31,6 +31,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
 	"go.etcd.io/etcd/client/pkg/v3/fileutil"
@@ -2232,3 +2233,73 @@ func TestIsActive(t *testing.T) {
 		require.Equal(t, tc.expectActive, s.isActive())
 	}
 }
+
+func TestRequestsRequiringApplyExtensions(t *testing.T) {
+	reqs := map[string]func(s *EtcdServer) error{
+		"deny permission": func(s *EtcdServer) error {
+			_, err := s.RoleGrantPermission(context.TODO(), &pb.AuthRoleGrantPermissionRequest{
+				Name: "role",
+				Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("foo"), Deny: true},
+			})
+			return err
+		},
+		"templated permission": func(s *EtcdServer) error {
+			_, err := s.RoleGrantPermission(context.TODO(), &pb.AuthRoleGrantPermissionRequest{
+				Name: "role",
+				Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("/home/{user}/"), RangeEnd: []byte("/home/{user}0")},
+			})
+			return err
+		},
+		"user unlock": func(s *EtcdServer) error {
+			_, err := s.UserUnlock(context.TODO(), &pb.AuthUserUnlockRequest{Name: "user"})
+			return err
+		},
+		"session revoke": func(s *EtcdServer) error {
+			_, err := s.SessionRevoke(context.TODO(), &pb.AuthSessionRevokeRequest{Name: "user"})
+			return err
+		},
+		"latency alarm activation": func(s *EtcdServer) error {
+			_, err := s.Alarm(context.TODO(), &pb.AlarmRequest{Action: pb.AlarmRequest_ACTIVATE, Alarm: pb.AlarmType_LATENCY})
+			return err
+		},
+		"latency alarm deactivation": func(s *EtcdServer) error {
+			_, err := s.Alarm(context.TODO(), &pb.AlarmRequest{Action: pb.AlarmRequest_DEACTIVATE, Alarm: pb.AlarmType_LATENCY})
+			return err
+		},
+		"member latency alarm activation": func(s *EtcdServer) error {
+			return s.latencyAlarm(pb.AlarmRequest_ACTIVATE)
+		},
+		"member latency alarm deactivation": func(s *EtcdServer) error {
+			return s.latencyAlarm(pb.AlarmRequest_DEACTIVATE)
+		},
+		"leader transfer from slow disk": func(s *EtcdServer) error {
+			return s.transferLeadershipFromSlowDisk()
+		},
+	}
+	capable := membership.Attributes{Capabilities: []string{membership.ApplyExtensionsCapability}}
+	clusters := map[string][]*membership.Member{
+		"no member":          nil,
+		"3.5 member":         {{ID: 1}},
+		"one 3.5 member":     {{ID: 1, Attributes: capable}, {ID: 2}},
+		"unknown capability": {{ID: 1, Attributes: membership.Attributes{Capabilities: []string{"other"}}}},
+	}
+	for name, req := range reqs {
+		for cname, membs := range clusters {
+			s := &EtcdServer{lgMu: new(sync.RWMutex), lg: zaptest.NewLogger(t), cluster: newTestCluster(t, membs)}
+			require.Equal(t, ErrNotSupportedByMembers, req(s), "%s with %s", name, cname)
+		}
+	}
+}
+
+func TestUserChangePasswordPolicy(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	as := auth.NewAuthStore(lg, be, nil, 0)
+	as.SetPasswordPolicy(auth.PasswordPolicy{MinLength: 8})
+	s := &EtcdServer{lgMu: new(sync.RWMutex), lg: lg, authStore: as}
+
+	// a hashed password cannot be checked against the policy
+	_, err := s.UserChangePassword(context.TODO(), &pb.AuthUserChangePasswordRequest{Name: "user", HashedPassword: "$2a$10$aaaaaaaaaaaaaaaaaaaaaa"})
+	require.Equal(t, auth.ErrPasswordTooWeak, err)
+}
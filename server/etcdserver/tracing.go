@@ -0,0 +1,192 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const tracerName = "go.etcd.io/etcd/server/v3/etcdserver"
+
+// traceContextPropagator carries span contexts of requests through raft.
+var traceContextPropagator = propagation.TraceContext{}
+
+func newTracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = noop.NewTracerProvider()
+	}
+	return tp.Tracer(tracerName)
+}
+
+// getTracer returns the tracer of the server, which records nothing if
+// distributed tracing is disabled.
+func (s *EtcdServer) getTracer() trace.Tracer {
+	if s.tracer == nil {
+		return newTracer(nil)
+	}
+	return s.tracer
+}
+
+// startChildSpan starts a span as a child of the span in ctx. If ctx is not
+// traced, it returns a span that records nothing, so that only requests
+// traced by clients are followed through the server.
+func startChildSpan(ctx context.Context, tracer trace.Tracer, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if tracer == nil || !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return tracer.Start(ctx, name, opts...)
+}
+
+// injectTraceContext records the span context of ctx in the request header
+// so that every member applying the request continues its trace.
+func injectTraceContext(ctx context.Context, h *pb.RequestHeader) {
+	if !trace.SpanContextFromContext(ctx).IsSampled() {
+		return
+	}
+	carrier := propagation.MapCarrier{}
+	traceContextPropagator.Inject(ctx, carrier)
+	h.TraceContext = carrier
+}
+
+// extractTraceContext returns a context carrying the span context recorded
+// in the request header, if any.
+func extractTraceContext(h *pb.RequestHeader) context.Context {
+	ctx := context.Background()
+	if h == nil || len(h.TraceContext) == 0 {
+		return ctx
+	}
+	return traceContextPropagator.Extract(ctx, propagation.MapCarrier(h.TraceContext))
+}
+
+// endSpan ends the span, marking it as failed if err is not nil.
+func endSpan(span trace.Span, err error, opts ...trace.SpanEndOption) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(opts...)
+}
+
+// startCommitSpan starts the span waiting for the proposal of the given
+// request to be committed. The span is ended once the request is applied.
+func (s *EtcdServer) startCommitSpan(ctx context.Context, id uint64) {
+	_, span := startChildSpan(ctx, s.getTracer(), "raft.commit")
+	if span.IsRecording() {
+		s.commitSpans.Store(id, span)
+	}
+}
+
+// endCommitSpan ends the commit span of the given request, if any.
+func (s *EtcdServer) endCommitSpan(id uint64, err error) {
+	if span, ok := s.commitSpans.LoadAndDelete(id); ok {
+		endSpan(span.(trace.Span), err)
+	}
+}
+
+// traceApply records the applied mvcc operation as a child span of the
+// apply span, with the steps of its trace as events.
+func traceApply(ctx context.Context, tracer trace.Tracer, op string, t *traceutil.Trace, err error) {
+	if t == nil || t.IsEmpty() || !trace.SpanContextFromContext(ctx).IsSampled() {
+		return
+	}
+	_, span := startChildSpan(ctx, tracer, "mvcc.txn",
+		trace.WithTimestamp(t.GetStartTime()),
+		trace.WithAttributes(attribute.String("op", op)),
+	)
+	span.SetAttributes(traceAttributes(t.Fields())...)
+	t.VisitSteps(func(at time.Time, msg string, fields []traceutil.Field) {
+		span.AddEvent(msg, trace.WithTimestamp(at), trace.WithAttributes(traceAttributes(fields)...))
+	})
+	endSpan(span, err)
+}
+
+func traceAttributes(fields []traceutil.Field) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(fields))
+	for _, f := range fields {
+		attrs = append(attrs, attribute.String(f.Key, fmt.Sprint(f.Value)))
+	}
+	return attrs
+}
+
+// setApplyingSpan sets the span of the request being applied, whose writes
+// are persisted by the next backend commit.
+func (bh *backendHooks) setApplyingSpan(sc trace.SpanContext) {
+	if bh == nil {
+		return
+	}
+	bh.traceLock.Lock()
+	defer bh.traceLock.Unlock()
+	bh.applying = sc
+}
+
+// traceApplyingWrite records that the request being applied writes to the
+// pending backend transaction. It is called with the transaction locked.
+func (bh *backendHooks) traceApplyingWrite() {
+	if bh == nil {
+		return
+	}
+	bh.traceLock.Lock()
+	defer bh.traceLock.Unlock()
+	if !bh.applying.IsSampled() {
+		return
+	}
+	if n := len(bh.pendingSpans); n > 0 && bh.pendingSpans[n-1].Equal(bh.applying) {
+		return
+	}
+	bh.pendingSpans = append(bh.pendingSpans, bh.applying)
+}
+
+// startBackendCommitSpan starts the span of a backend commit persisting
+// traced requests. The span is a child of the oldest such request and links
+// to all of them.
+func (bh *backendHooks) startBackendCommitSpan() {
+	bh.traceLock.Lock()
+	defer bh.traceLock.Unlock()
+	if len(bh.pendingSpans) == 0 || bh.tracer == nil {
+		return
+	}
+	links := make([]trace.Link, 0, len(bh.pendingSpans))
+	for _, sc := range bh.pendingSpans {
+		links = append(links, trace.Link{SpanContext: sc})
+	}
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), bh.pendingSpans[0])
+	_, bh.commitSpan = bh.tracer.Start(ctx, "backend.commit",
+		trace.WithLinks(links...),
+		trace.WithAttributes(attribute.Int("requests", len(links))),
+	)
+	bh.pendingSpans = nil
+}
+
+// OnPostCommitUnsafe ends the span of the finished backend commit, if any.
+func (bh *backendHooks) OnPostCommitUnsafe(tx backend.BatchTx) {
+	bh.traceLock.Lock()
+	defer bh.traceLock.Unlock()
+	if bh.commitSpan != nil {
+		bh.commitSpan.End()
+		bh.commitSpan = nil
+	}
+}
@@ -19,9 +19,11 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/binary"
+	"net"
 	"strconv"
 	"time"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
@@ -33,8 +35,11 @@ import (
 	"go.etcd.io/etcd/server/v3/mvcc"
 
 	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/peer"
 )
 
 const (
@@ -87,6 +92,9 @@ type Authenticator interface {
 	UserGrantRole(ctx context.Context, r *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error)
 	UserGet(ctx context.Context, r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error)
 	UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
+	UserUnlock(ctx context.Context, r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error)
+	SessionList(ctx context.Context, r *pb.AuthSessionListRequest) (*pb.AuthSessionListResponse, error)
+	SessionRevoke(ctx context.Context, r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error)
 	RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 	RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error)
 	RoleGet(ctx context.Context, r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error)
@@ -125,6 +133,9 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 		}
 	}
 	chk := func(ai *auth.AuthInfo) error {
+		if err := s.authStore.Authorize(ctx, ai, rangeAttributes(r)); err != nil {
+			return err
+		}
 		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
 	}
 
@@ -170,6 +181,9 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		var resp *pb.TxnResponse
 		var err error
 		chk := func(ai *auth.AuthInfo) error {
+			if err := s.authStore.Authorize(ctx, ai, s.txnAttributes(r)); err != nil {
+				return err
+			}
 			return checkTxnAuth(s.authStore, ai, r)
 		}
 
@@ -222,6 +236,10 @@ func isTxnReadonly(r *pb.TxnRequest) bool {
 }
 
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	if err := s.CheckEnforcedOperationPermission(ctx, authpb.COMPACTION); err != nil {
+		return nil, err
+	}
+
 	startTime := time.Now()
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Compaction: r})
 	trace := traceutil.TODO()
@@ -263,6 +281,10 @@ func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.
 }
 
 func (s *EtcdServer) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	if err := s.CheckEnforcedOperationPermission(ctx, authpb.LEASE); err != nil {
+		return nil, err
+	}
+
 	// no id given? choose one
 	for r.ID == int64(lease.NoLease) {
 		// only use positive int64 id's
@@ -288,6 +310,10 @@ func (s *EtcdServer) waitAppliedIndex() error {
 }
 
 func (s *EtcdServer) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if err := s.checkLeaseRevokePermission(ctx, lease.LeaseID(r.ID)); err != nil {
+		return nil, err
+	}
+
 	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{LeaseRevoke: r})
 	if err != nil {
 		return nil, err
@@ -295,6 +321,30 @@ func (s *EtcdServer) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest)
 	return resp.(*pb.LeaseRevokeResponse), nil
 }
 
+// checkLeaseRevokePermission checks the permission of the user to revoke
+// the lease if the operation permissions are enforced. Users other than
+// root may only revoke the leases they granted.
+func (s *EtcdServer) checkLeaseRevokePermission(ctx context.Context, id lease.LeaseID) error {
+	if !s.Cfg.EnforceOperationPermissions || s.authStore == nil {
+		return nil
+	}
+	authInfo, err := s.AuthInfoFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	// IsAdminPermitted also checks whether auth is enabled
+	if err = s.AuthStore().IsAdminPermitted(authInfo); err == nil {
+		return nil
+	}
+	if err = s.AuthStore().IsOperationPermitted(authInfo, authpb.LEASE); err != nil {
+		return err
+	}
+	if l := s.lessor.Lookup(id); l != nil && l.Owner() != authInfo.Username {
+		return auth.ErrPermissionDenied
+	}
+	return nil
+}
+
 func (s *EtcdServer) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error) {
 	if s.isLeader() {
 		// If s.isLeader() returns true, but we fail to ensure the current
@@ -362,7 +412,15 @@ func (s *EtcdServer) checkLeaseTimeToLive(ctx context.Context, leaseID lease.Lea
 
 	l := s.lessor.Lookup(leaseID)
 	if l != nil {
-		for _, key := range l.Keys() {
+		keys := l.Keys()
+		attrs := make([]auth.Attributes, len(keys))
+		for i, key := range keys {
+			attrs[i] = auth.Attributes{Verb: auth.VerbRead, Key: []byte(key)}
+		}
+		if err := s.AuthStore().Authorize(ctx, authInfo, attrs); err != nil {
+			return 0, err
+		}
+		for _, key := range keys {
 			if err := s.AuthStore().IsRangePermitted(authInfo, []byte(key), []byte{}); err != nil {
 				return 0, err
 			}
@@ -489,6 +547,16 @@ func (s *EtcdServer) waitLeader(ctx context.Context) (*membership.Member, error)
 }
 
 func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmResponse, error) {
+	// the members without the apply extensions panic on the LATENCY alarm
+	if r.Alarm == pb.AlarmType_LATENCY && r.Action != pb.AlarmRequest_GET && !s.isApplyExtensionsEnabled() {
+		return nil, ErrNotSupportedByMembers
+	}
+	if r.Action == pb.AlarmRequest_DEACTIVATE {
+		if err := s.CheckEnforcedOperationPermission(ctx, authpb.ALARM_DISARM); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{Alarm: r})
 	if err != nil {
 		return nil, err
@@ -534,9 +602,11 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 		}
 	}()
 
+	addr := sourceAddr(ctx)
+
 	var resp proto.Message
 	for {
-		checkedRevision, err := s.AuthStore().CheckPassword(r.Name, r.Password)
+		checkedRevision, err := s.AuthStore().CheckPassword(r.Name, r.Password, addr)
 		if err != nil {
 			if err != auth.ErrAuthNotEnabled {
 				lg.Warn(
@@ -556,8 +626,12 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 		// internalReq doesn't need to have Password because the above s.AuthStore().CheckPassword() already did it.
 		// In addition, it will let a WAL entry not record password as a plain text.
 		internalReq := &pb.InternalAuthenticateRequest{
-			Name:        r.Name,
-			SimpleToken: st,
+			Name:          r.Name,
+			SimpleToken:   st,
+			IssuedAt:      time.Now().Unix(),
+			SourceAddress: addr,
+			MemberId:      uint64(s.ID()),
+			SessionTtl:    int64(s.AuthStore().SessionTTL() / time.Second),
 		}
 
 		resp, err = s.raftRequestOnce(ctx, pb.InternalRaftRequest{Authenticate: internalReq})
@@ -574,8 +648,25 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 	return resp.(*pb.AuthenticateResponse), nil
 }
 
+// sourceAddr returns the host of the peer address of a gRPC request, or
+// an empty string if it is unknown.
+func sourceAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
 func (s *EtcdServer) UserAdd(ctx context.Context, r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
 	if r.Options == nil || !r.Options.NoPassword {
+		if err := s.authStore.CheckPasswordPolicy(r.Name, r.Password); err != nil {
+			return nil, err
+		}
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(r.Password), s.authStore.BcryptCost())
 		if err != nil {
 			return nil, err
@@ -600,6 +691,11 @@ func (s *EtcdServer) UserDelete(ctx context.Context, r *pb.AuthUserDeleteRequest
 }
 
 func (s *EtcdServer) UserChangePassword(ctx context.Context, r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
+	// a hashed password given by the client is rejected if there is a
+	// password policy, as it cannot be checked
+	if err := s.authStore.CheckPasswordPolicy(r.Name, r.Password); err != nil {
+		return nil, err
+	}
 	if r.Password != "" {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(r.Password), s.authStore.BcryptCost())
 		if err != nil {
@@ -608,6 +704,7 @@ func (s *EtcdServer) UserChangePassword(ctx context.Context, r *pb.AuthUserChang
 		r.HashedPassword = base64.StdEncoding.EncodeToString(hashedPassword)
 		r.Password = ""
 	}
+	r.PasswordHistory = int64(s.Cfg.PasswordHistory)
 
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthUserChangePassword: r})
 	if err != nil {
@@ -648,6 +745,52 @@ func (s *EtcdServer) UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRol
 	return resp.(*pb.AuthUserRevokeRoleResponse), nil
 }
 
+func (s *EtcdServer) UserUnlock(ctx context.Context, r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error) {
+	// the members without the apply extensions do not know the request
+	if !s.isApplyExtensionsEnabled() {
+		return nil, ErrNotSupportedByMembers
+	}
+	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthUserUnlock: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AuthUserUnlockResponse), nil
+}
+
+// SessionList lists the sessions of a user from the local auth store, as the
+// request does not change it.
+func (s *EtcdServer) SessionList(ctx context.Context, r *pb.AuthSessionListRequest) (*pb.AuthSessionListResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+	authInfo, err := s.AuthInfoFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the users may list their own sessions
+	if err = s.AuthStore().IsAdminPermitted(authInfo); err != nil && (authInfo == nil || r.Name != authInfo.Username) {
+		return nil, err
+	}
+	resp, err := s.AuthStore().SessionList(r)
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = newHeader(s)
+	return resp, nil
+}
+
+func (s *EtcdServer) SessionRevoke(ctx context.Context, r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error) {
+	// the members without the apply extensions do not know the request
+	if !s.isApplyExtensionsEnabled() {
+		return nil, ErrNotSupportedByMembers
+	}
+	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthSessionRevoke: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AuthSessionRevokeResponse), nil
+}
+
 func (s *EtcdServer) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthRoleAdd: r})
 	if err != nil {
@@ -657,6 +800,11 @@ func (s *EtcdServer) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb
 }
 
 func (s *EtcdServer) RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
+	// the members without the apply extensions would apply a deny
+	// permission as a grant and would not expand the user placeholder
+	if r.Perm != nil && (r.Perm.Deny || auth.IsTemplatedPermission(r.Perm)) && !s.isApplyExtensionsEnabled() {
+		return nil, ErrNotSupportedByMembers
+	}
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthRoleGrantPermission: r})
 	if err != nil {
 		return nil, err
@@ -753,18 +901,44 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	}
 
 	r.Header = &pb.RequestHeader{
-		ID: s.reqIDGen.Next(),
+		ID:        s.reqIDGen.Next(),
+		Timestamp: time.Now().UnixNano(),
 	}
 
+	ctx, span := startChildSpan(ctx, s.getTracer(), "etcdserver.proposal",
+		trace.WithAttributes(attribute.Int64("request_id", int64(r.Header.ID))),
+	)
+	var err error
+	defer func() { endSpan(span, err) }()
+	injectTraceContext(ctx, r.Header)
+
 	// check authinfo if it is not InternalAuthenticateRequest
 	if r.Authenticate == nil {
-		authInfo, err := s.AuthInfoFromCtx(ctx)
+		var authInfo *auth.AuthInfo
+		authInfo, err = s.AuthInfoFromCtx(ctx)
 		if err != nil {
 			return nil, err
 		}
 		if authInfo != nil {
+			// the members without the apply extensions would ignore the
+			// roles granted by the auth token
+			if len(authInfo.Roles) > 0 && !s.isApplyExtensionsEnabled() {
+				err = ErrNotSupportedByMembers
+				return nil, err
+			}
+			// the authorizer is only consulted here, so that the members
+			// apply the request with the same decision
+			if err = s.authStore.Authorize(ctx, authInfo, s.requestAttributes(&r)); err != nil {
+				return nil, err
+			}
 			r.Header.Username = authInfo.Username
 			r.Header.AuthRevision = authInfo.Revision
+			r.Header.Roles = authInfo.Roles
+			// the members without the apply extensions would check the
+			// permissions of the key ranges allowed by the authorizer
+			if s.isApplyExtensionsEnabled() {
+				r.Header.Authorized = authInfo.Authorized
+			}
 		}
 	}
 
@@ -774,7 +948,8 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	}
 
 	if len(data) > int(s.Cfg.MaxRequestBytes) {
-		return nil, ErrRequestTooLarge
+		err = ErrRequestTooLarge
+		return nil, err
 	}
 
 	id := r.ID
@@ -787,9 +962,15 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	defer cancel()
 
 	start := time.Now()
+	// the commit span starts before proposing so that a fast apply
+	// always finds it
+	s.startCommitSpan(ctx, id)
+	_, pspan := startChildSpan(ctx, s.getTracer(), "raft.propose")
 	err = s.r.Propose(cctx, data)
+	endSpan(pspan, err)
 	if err != nil {
 		proposalsFailed.Inc()
+		s.endCommitSpan(id, err)
 		s.w.Trigger(id, nil) // GC wait
 		return nil, err
 	}
@@ -798,13 +979,21 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 
 	select {
 	case x := <-ch:
-		return x.(*applyResult), nil
+		ar := x.(*applyResult)
+		if ar != nil {
+			err = ar.err
+		}
+		return ar, nil
 	case <-cctx.Done():
 		proposalsFailed.Inc()
+		err = s.parseProposeCtxErr(cctx.Err(), start)
+		s.endCommitSpan(id, err)
 		s.w.Trigger(id, nil) // GC wait
-		return nil, s.parseProposeCtxErr(cctx.Err(), start)
+		return nil, err
 	case <-s.done:
-		return nil, ErrStopped
+		err = ErrStopped
+		s.endCommitSpan(id, err)
+		return nil, err
 	}
 }
 
@@ -1003,6 +1192,16 @@ func (s *EtcdServer) AuthInfoFromCtx(ctx context.Context) (*auth.AuthInfo, error
 	return authInfo, nil
 }
 
+// CheckEnforcedOperationPermission checks the permission of the user of ctx
+// to perform op if the operation permissions are enforced. Otherwise the
+// operation is open to every user.
+func (s *EtcdServer) CheckEnforcedOperationPermission(ctx context.Context, op authpb.OperationPermission_Type) error {
+	if !s.Cfg.EnforceOperationPermissions {
+		return nil
+	}
+	return s.checkOperationPermission(ctx, op)
+}
+
 func (s *EtcdServer) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
 	switch r.Action {
 	case pb.DowngradeRequest_VALIDATE:
@@ -102,6 +102,10 @@ type Lessor interface {
 	// the expiry of leases to less than the full TTL when possible.
 	Checkpoint(id LeaseID, remainingTTL int64) error
 
+	// SetOwner records the user who granted the lease with given ID.
+	// If the lease does not exist, an error will be returned.
+	SetOwner(id LeaseID, owner string) error
+
 	// Attach attaches given leaseItem to the lease with given LeaseID.
 	// If the lease does not exist, an error will be returned.
 	Attach(id LeaseID, items []LeaseItem) error
@@ -316,6 +320,7 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 
 	leaseTotalTTLs.Observe(float64(l.ttl))
 	leaseGranted.Inc()
+	leaseActive.Inc()
 
 	if le.isPrimary() {
 		item := &LeaseWithTime{id: l.ID, time: l.expiry}
@@ -363,6 +368,7 @@ func (le *lessor) Revoke(id LeaseID) error {
 	txn.End()
 
 	leaseRevoked.Inc()
+	leaseActive.Dec()
 	return nil
 }
 
@@ -384,6 +390,21 @@ func (le *lessor) Checkpoint(id LeaseID, remainingTTL int64) error {
 	return nil
 }
 
+func (le *lessor) SetOwner(id LeaseID, owner string) error {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	l := le.leaseMap[id]
+	if l == nil {
+		return ErrLeaseNotFound
+	}
+	l.mu.Lock()
+	l.owner = owner
+	l.mu.Unlock()
+	l.persistTo(le.b)
+	return nil
+}
+
 func (le *lessor) shouldPersistCheckpoints() bool {
 	cv := le.cluster.Version()
 	return le.checkpointPersist || (cv != nil && greaterOrEqual(*cv, v3_6))
@@ -825,8 +846,10 @@ func (le *lessor) initAndRecover() {
 			expiry:       forever,
 			revokec:      make(chan struct{}),
 			remainingTTL: lpb.RemainingTTL,
+			owner:        lpb.Owner,
 		}
 	}
+	leaseActive.Set(float64(len(le.leaseMap)))
 	le.leaseExpiredNotifier.Init()
 	heap.Init(&le.leaseCheckpointHeap)
 
@@ -842,9 +865,11 @@ type Lease struct {
 	// expiry is time when lease should expire. no expiration when expiry.IsZero() is true
 	expiry time.Time
 
-	// mu protects concurrent accesses to itemSet
+	// mu protects concurrent accesses to itemSet and owner
 	mu      sync.RWMutex
 	itemSet map[LeaseItem]struct{}
+	// owner is the user who granted the lease, empty if it is unknown
+	owner   string
 	revokec chan struct{}
 }
 
@@ -864,7 +889,7 @@ func (l *Lease) expired() bool {
 func (l *Lease) persistTo(b backend.Backend) {
 	key := int64ToBytes(int64(l.ID))
 
-	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL}
+	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL, Owner: l.Owner()}
 	val, err := lpb.Marshal()
 	if err != nil {
 		panic("failed to marshal lease proto item")
@@ -880,6 +905,14 @@ func (l *Lease) TTL() int64 {
 	return l.ttl
 }
 
+// Owner returns the user who granted the lease, or an empty string if
+// it is unknown.
+func (l *Lease) Owner() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.owner
+}
+
 // SetLeaseItem sets the given lease item, this func is thread-safe
 func (l *Lease) SetLeaseItem(item LeaseItem) {
 	l.mu.Lock()
@@ -987,6 +1020,8 @@ func (fl *FakeLessor) Revoke(id LeaseID) error { return nil }
 
 func (fl *FakeLessor) Checkpoint(id LeaseID, remainingTTL int64) error { return nil }
 
+func (fl *FakeLessor) SetOwner(id LeaseID, owner string) error { return nil }
+
 func (fl *FakeLessor) Attach(id LeaseID, items []LeaseItem) error { return nil }
 
 func (fl *FakeLessor) GetLease(item LeaseItem) LeaseID            { return 0 }
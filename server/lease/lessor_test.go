@@ -409,6 +409,32 @@ func TestLessorRecover(t *testing.T) {
 	}
 }
 
+func TestLessorSetOwner(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterV3_6(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	if _, err := le.Grant(1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := le.SetOwner(1, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := le.SetOwner(2, "foo"); err != ErrLeaseNotFound {
+		t.Fatalf("expected %v, got %v", ErrLeaseNotFound, err)
+	}
+
+	// the owner is recovered with the lease
+	nle := newLessor(lg, be, clusterV3_6(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	if l := nle.Lookup(1); l == nil || l.Owner() != "foo" {
+		t.Fatalf("expected the lease to be owned by foo, got %v", l)
+	}
+}
+
 func TestLessorExpire(t *testing.T) {
 	lg := zap.NewNop()
 	dir, be := NewTestBackend(t)
@@ -33,6 +33,13 @@ var (
 		Help:      "The total number of revoked leases.",
 	})
 
+	leaseActive = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "lease",
+		Name:      "active",
+		Help:      "The number of leases of the member.",
+	})
+
 	leaseRenewed = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd_debugging",
 		Subsystem: "lease",
@@ -54,6 +61,7 @@ var (
 func init() {
 	prometheus.MustRegister(leaseGranted)
 	prometheus.MustRegister(leaseRevoked)
+	prometheus.MustRegister(leaseActive)
 	prometheus.MustRegister(leaseRenewed)
 	prometheus.MustRegister(leaseTotalTTLs)
 }
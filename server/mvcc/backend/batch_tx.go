@@ -379,6 +379,10 @@ func (t *batchTxBuffered) unsafeCommit(stop bool) {
 	t.batchTx.commit(stop)
 	t.pendingDeleteOperations = 0
 
+	if h, ok := t.backend.hooks.(PostCommitHooks); ok {
+		h.OnPostCommitUnsafe(t)
+	}
+
 	if !stop {
 		t.backend.readTx.tx = t.backend.begin(false)
 	}
@@ -23,6 +23,14 @@ type Hooks interface {
 	OnPreCommitUnsafe(tx BatchTx)
 }
 
+// PostCommitHooks may be implemented by Hooks to also execute logic after
+// Commit of transactions.
+type PostCommitHooks interface {
+	// OnPostCommitUnsafe is executed after Commit of transactions.
+	// The given transaction is still locked.
+	OnPostCommitUnsafe(tx BatchTx)
+}
+
 type hooks struct {
 	onPreCommitUnsafe HookFunc
 }
@@ -92,6 +92,31 @@ func TestBackendAutoCommitBatchIntervalHook(t *testing.T) {
 	waitUntil(ctx, t, func() bool { return getCommitsKey(t, be) == ">ccc" })
 }
 
+type postCommitHooks struct {
+	backend.Hooks
+	commits int
+}
+
+func (h *postCommitHooks) OnPostCommitUnsafe(tx backend.BatchTx) { h.commits++ }
+
+func TestBackendPostCommitHook(t *testing.T) {
+	cfg := backend.DefaultBackendConfig()
+	h := &postCommitHooks{Hooks: backend.NewHooks(func(tx backend.BatchTx) {})}
+	cfg.Hooks = h
+	be, _ := betesting.NewTmpBackendFromCfg(t, cfg)
+	defer betesting.Close(t, be)
+
+	tx := be.BatchTx()
+	prepareBuckenAndKey(tx)
+	tx.Commit()
+	tx.Commit()
+
+	tx.Lock()
+	commits := h.commits
+	tx.Unlock()
+	assert.Equal(t, 2, commits, "expected post commit hook on each explicit commit")
+}
+
 func waitUntil(ctx context.Context, t testing.TB, f func() bool) {
 	for !f() {
 		select {
@@ -92,6 +92,10 @@ var (
 	})
 )
 
+// CommitDurations returns the histogram of the commit durations of the
+// backends of the process.
+func CommitDurations() prometheus.Histogram { return commitSec }
+
 func init() {
 	prometheus.MustRegister(commitSec)
 	prometheus.MustRegister(rebalanceSec)
@@ -35,6 +35,9 @@ var (
 	authUsersBucketName = []byte("authUsers")
 	authRolesBucketName = []byte("authRoles")
 
+	authSessionsBucketName           = []byte("authSessions")
+	authSessionRevocationsBucketName = []byte("authSessionRevocations")
+
 	testBucketName = []byte("test")
 )
 
@@ -52,6 +55,9 @@ var (
 	AuthUsers = backend.Bucket(bucket{id: 21, name: authUsersBucketName, safeRangeBucket: false})
 	AuthRoles = backend.Bucket(bucket{id: 22, name: authRolesBucketName, safeRangeBucket: false})
 
+	AuthSessions           = backend.Bucket(bucket{id: 23, name: authSessionsBucketName, safeRangeBucket: false})
+	AuthSessionRevocations = backend.Bucket(bucket{id: 24, name: authSessionRevocationsBucketName, safeRangeBucket: false})
+
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 )
 
@@ -15,11 +15,13 @@
 package mvcc
 
 import (
+	"bytes"
 	"hash"
 	"hash/crc32"
 	"sort"
 	"sync"
 
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/server/v3/mvcc/backend"
 	"go.etcd.io/etcd/server/v3/mvcc/buckets"
 	"go.uber.org/zap"
@@ -38,11 +40,37 @@ func unsafeHashByRev(tx backend.ReadTx, compactRevision, revision int64, keep ma
 	return h.Hash(), err
 }
 
+// unsafeHashRevisions hashes the revisions revs, sorted, of the keys in
+// [key, end). They are hashed as unsafeHashByRev hashes them, so the hash
+// of all the keys is the hash of the key bucket.
+func unsafeHashRevisions(tx backend.ReadTx, compactRevision, rev int64, keep map[revision]struct{}, key, end []byte, revs []revision) (KeyValueHash, error) {
+	h := newKVHasher(compactRevision, rev, keep)
+	h.key, h.end = key, end
+	min, max := newRevBytes(), newRevBytes()
+	for _, r := range revs {
+		if r.main > rev {
+			continue
+		}
+		// the range also covers the tombstone of the revision
+		revToBytes(r, min)
+		revToBytes(revision{main: r.main, sub: r.sub + 1}, max)
+		ks, vs := tx.UnsafeRange(buckets.Key, min, max, 0)
+		for i := range ks {
+			h.WriteKeyValue(ks[i], vs[i])
+		}
+	}
+	return h.Hash(), nil
+}
+
 type kvHasher struct {
 	hash            hash.Hash32
 	compactRevision int64
 	revision        int64
 	keep            map[revision]struct{}
+
+	// key and end restrict the hash to the revisions of the keys in
+	// [key, end), if key is not nil.
+	key, end []byte
 }
 
 func newKVHasher(compactRev, rev int64, keep map[revision]struct{}) kvHasher {
@@ -84,10 +112,35 @@ func (h *kvHasher) WriteKeyValue(k, v []byte) {
 		return
 	}
 
+	if h.key != nil && !h.inRange(v) {
+		return
+	}
+
 	h.hash.Write(k)
 	h.hash.Write(v)
 }
 
+// inRange returns whether the key of the encoded key-value v is in the
+// range of the hasher. Revisions that cannot be decoded are out of any
+// range.
+func (h *kvHasher) inRange(v []byte) bool {
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(v); err != nil {
+		return false
+	}
+	if bytes.Compare(kv.Key, h.key) < 0 {
+		return false
+	}
+	switch {
+	case len(h.end) == 0:
+		return bytes.Equal(kv.Key, h.key)
+	case len(h.end) == 1 && h.end[0] == 0:
+		return true
+	default:
+		return bytes.Compare(kv.Key, h.end) < 0
+	}
+}
+
 func (h *kvHasher) Hash() KeyValueHash {
 	return KeyValueHash{Hash: h.hash.Sum32(), CompactRevision: h.compactRevision, Revision: h.revision}
 }
@@ -105,6 +158,11 @@ type HashStorage interface {
 	// HashByRev computes the hash of all MVCC revisions up to a given revision.
 	HashByRev(rev int64) (hash KeyValueHash, currentRev int64, err error)
 
+	// HashByRevRange computes the hash of the MVCC revisions of the keys in
+	// the range [key, end) up to a given revision. An empty end stands for
+	// key only and "\x00" for all keys from key.
+	HashByRevRange(rev int64, key, end []byte) (hash KeyValueHash, currentRev int64, err error)
+
 	// Store adds hash value in local cache, allowing it can be returned by HashByRev.
 	Store(valueHash KeyValueHash)
 
@@ -147,6 +205,10 @@ func (s *hashStorage) HashByRev(rev int64) (KeyValueHash, int64, error) {
 	return s.store.hashByRev(rev)
 }
 
+func (s *hashStorage) HashByRevRange(rev int64, key, end []byte) (KeyValueHash, int64, error) {
+	return s.store.hashByRevRange(rev, key, end)
+}
+
 func (s *hashStorage) Store(hash KeyValueHash) {
 	s.lg.Info("storing new hash",
 		zap.Uint32("hash", hash.Hash),
@@ -127,6 +127,48 @@ func testHashByRev(t *testing.T, s *store, rev int64) KeyValueHash {
 	return hash
 }
 
+func TestHashByRevRange(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+
+	for _, k := range []string{"a", "b", "c"} {
+		s.Put([]byte(k), []byte("v"), 0)
+	}
+	hashRange := func(key, end string) uint32 {
+		var e []byte
+		if end != "" {
+			e = []byte(end)
+		}
+		h, _, err := s.hashByRevRange(0, []byte(key), e)
+		assert.NoError(t, err)
+		return h.Hash
+	}
+	all, only, from, single := hashRange("\x00", "\x00"), hashRange("a", "c"), hashRange("c", "\x00"), hashRange("b", "")
+
+	// the hash of all keys is the hash of the whole key bucket
+	h, _, err := s.hashByRev(0)
+	assert.NoError(t, err)
+	assert.Equal(t, h.Hash, all)
+
+	s.Put([]byte("b"), []byte("v2"), 0)
+	assert.NotEqual(t, all, hashRange("\x00", "\x00"))
+	assert.NotEqual(t, only, hashRange("a", "c"))
+	assert.NotEqual(t, single, hashRange("b", ""))
+	assert.Equal(t, from, hashRange("c", "\x00"))
+	assert.Equal(t, hashRange("a", ""), hashRange("a", "b"))
+
+	// the revisions of the ranges are the ones of the key bucket after a
+	// compaction too
+	s.DeleteRange([]byte("a"), nil)
+	s.Put([]byte("c"), []byte("v2"), 0)
+	done, err := s.Compact(traceutil.TODO(), s.Rev())
+	assert.NoError(t, err)
+	<-done
+	h, _, err = s.hashByRev(0)
+	assert.NoError(t, err)
+	assert.Equal(t, h.Hash, hashRange("\x00", "\x00"))
+}
+
 // TestCompactionHash
 // TODO: Change this to fuzz test
 func TestCompactionHash(t *testing.T) {
@@ -172,6 +172,10 @@ func (s *store) hash() (hash uint32, revision int64, err error) {
 }
 
 func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err error) {
+	return s.hashByRevRange(rev, nil, nil)
+}
+
+func (s *store) hashByRevRange(rev int64, key, end []byte) (hash KeyValueHash, currentRev int64, err error) {
 	var compactRev int64
 	start := time.Now()
 
@@ -191,16 +195,38 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 		rev = currentRev
 	}
 	keep := s.kvindex.Keep(rev)
+	// the revisions of a range are looked up with the index rather than by
+	// scanning the whole key bucket
+	var revs []revision
+	if key != nil {
+		revs = s.kvindex.RangeSince(key, indexRangeEnd(end), 0)
+	}
 
 	tx := s.b.ReadTx()
 	tx.RLock()
 	defer tx.RUnlock()
 	s.mu.RUnlock()
-	hash, err = unsafeHashByRev(tx, compactRev, rev, keep)
+	if key != nil {
+		hash, err = unsafeHashRevisions(tx, compactRev, rev, keep, key, end, revs)
+	} else {
+		hash, err = unsafeHashByRev(tx, compactRev, rev, keep)
+	}
 	hashRevSec.Observe(time.Since(start).Seconds())
 	return hash, currentRev, err
 }
 
+// indexRangeEnd converts the end of a request range to the end of an index
+// range, where nil is a single key and an empty end has no upper bound.
+func indexRangeEnd(end []byte) []byte {
+	switch {
+	case len(end) == 0:
+		return nil
+	case len(end) == 1 && end[0] == 0:
+		return []byte{}
+	}
+	return end
+}
+
 func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
 	s.revMu.Lock()
 	if rev <= s.compactMainRev {
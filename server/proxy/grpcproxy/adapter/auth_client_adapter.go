@@ -95,3 +95,15 @@ func (s *as2ac) UserRevokeRole(ctx context.Context, in *pb.AuthUserRevokeRoleReq
 func (s *as2ac) UserChangePassword(ctx context.Context, in *pb.AuthUserChangePasswordRequest, opts ...grpc.CallOption) (*pb.AuthUserChangePasswordResponse, error) {
 	return s.as.UserChangePassword(ctx, in)
 }
+
+func (s *as2ac) UserUnlock(ctx context.Context, in *pb.AuthUserUnlockRequest, opts ...grpc.CallOption) (*pb.AuthUserUnlockResponse, error) {
+	return s.as.UserUnlock(ctx, in)
+}
+
+func (s *as2ac) SessionList(ctx context.Context, in *pb.AuthSessionListRequest, opts ...grpc.CallOption) (*pb.AuthSessionListResponse, error) {
+	return s.as.SessionList(ctx, in)
+}
+
+func (s *as2ac) SessionRevoke(ctx context.Context, in *pb.AuthSessionRevokeRequest, opts ...grpc.CallOption) (*pb.AuthSessionRevokeResponse, error) {
+	return s.as.SessionRevoke(ctx, in)
+}
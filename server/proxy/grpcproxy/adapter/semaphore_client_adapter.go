@@ -0,0 +1,41 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+
+	"google.golang.org/grpc"
+)
+
+type ss2ssc struct{ ss v3semaphorepb.SemaphoreServer }
+
+func SemaphoreServerToSemaphoreClient(ss v3semaphorepb.SemaphoreServer) v3semaphorepb.SemaphoreClient {
+	return &ss2ssc{ss}
+}
+
+func (s *ss2ssc) Acquire(ctx context.Context, r *v3semaphorepb.AcquireRequest, opts ...grpc.CallOption) (*v3semaphorepb.AcquireResponse, error) {
+	return s.ss.Acquire(ctx, r)
+}
+
+func (s *ss2ssc) TryAcquire(ctx context.Context, r *v3semaphorepb.AcquireRequest, opts ...grpc.CallOption) (*v3semaphorepb.AcquireResponse, error) {
+	return s.ss.TryAcquire(ctx, r)
+}
+
+func (s *ss2ssc) Release(ctx context.Context, r *v3semaphorepb.ReleaseRequest, opts ...grpc.CallOption) (*v3semaphorepb.ReleaseResponse, error) {
+	return s.ss.Release(ctx, r)
+}
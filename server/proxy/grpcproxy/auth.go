@@ -113,3 +113,18 @@ func (ap *AuthProxy) UserChangePassword(ctx context.Context, r *pb.AuthUserChang
 	conn := ap.client.ActiveConnection()
 	return pb.NewAuthClient(conn).UserChangePassword(ctx, r)
 }
+
+func (ap *AuthProxy) UserUnlock(ctx context.Context, r *pb.AuthUserUnlockRequest) (*pb.AuthUserUnlockResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).UserUnlock(ctx, r)
+}
+
+func (ap *AuthProxy) SessionList(ctx context.Context, r *pb.AuthSessionListRequest) (*pb.AuthSessionListResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).SessionList(ctx, r)
+}
+
+func (ap *AuthProxy) SessionRevoke(ctx context.Context, r *pb.AuthSessionRevokeRequest) (*pb.AuthSessionRevokeResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).SessionRevoke(ctx, r)
+}
@@ -63,3 +63,7 @@ func (ep *electionProxy) Observe(req *v3electionpb.LeaderRequest, s v3electionpb
 func (ep *electionProxy) Resign(ctx context.Context, req *v3electionpb.ResignRequest) (*v3electionpb.ResignResponse, error) {
 	return v3electionpb.NewElectionClient(ep.client.ActiveConnection()).Resign(ctx, req)
 }
+
+func (ep *electionProxy) Transfer(ctx context.Context, req *v3electionpb.TransferRequest) (*v3electionpb.TransferResponse, error) {
+	return v3electionpb.NewElectionClient(ep.client.ActiveConnection()).Transfer(ctx, req)
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+)
+
+type semaphoreProxy struct {
+	client *clientv3.Client
+}
+
+func NewSemaphoreProxy(client *clientv3.Client) v3semaphorepb.SemaphoreServer {
+	return &semaphoreProxy{client: client}
+}
+
+func (sp *semaphoreProxy) Acquire(ctx context.Context, req *v3semaphorepb.AcquireRequest) (*v3semaphorepb.AcquireResponse, error) {
+	return v3semaphorepb.NewSemaphoreClient(sp.client.ActiveConnection()).Acquire(ctx, req)
+}
+
+func (sp *semaphoreProxy) TryAcquire(ctx context.Context, req *v3semaphorepb.AcquireRequest) (*v3semaphorepb.AcquireResponse, error) {
+	return v3semaphorepb.NewSemaphoreClient(sp.client.ActiveConnection()).TryAcquire(ctx, req)
+}
+
+func (sp *semaphoreProxy) Release(ctx context.Context, req *v3semaphorepb.ReleaseRequest) (*v3semaphorepb.ReleaseResponse, error) {
+	return v3semaphorepb.NewSemaphoreClient(sp.client.ActiveConnection()).Release(ctx, req)
+}
@@ -45,6 +45,10 @@ var (
 	})
 )
 
+// FsyncDurations returns the histogram of the fsync durations of the WALs
+// of the process.
+func FsyncDurations() prometheus.Histogram { return walFsyncSec }
+
 func init() {
 	prometheus.MustRegister(walFsyncSec)
 	prometheus.MustRegister(walWriteSec)
@@ -0,0 +1,98 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3Apply(t *testing.T) { testCtl(t, applyTest) }
+
+const applyManifest = `
+managed-prefix: /config/
+keys:
+- key: /config/a
+  value: "1"
+- key: /config/b
+  value: "2"
+roles:
+- name: reader
+  permissions:
+  - type: read
+    key: /config/
+    prefix: true
+users:
+- name: alice
+  password: secret
+  roles: [reader]
+`
+
+func applyTest(cx ctlCtx) {
+	if err := ctlV3Put(cx, "/config/a", "0", ""); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Put(cx, "/config/stale", "x", ""); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Role(cx, []string{"add", "old"}, "Role old created"); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	fpath := filepath.Join(cx.t.TempDir(), "manifest.yaml")
+	if err := os.WriteFile(fpath, []byte(applyManifest), 0600); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	changes := []string{
+		"+ role reader",
+		`+ permission reader READ ["/config/", "/config0")`,
+		"+ user alice",
+		"+ user-role alice reader",
+		"- role old",
+		"~ key /config/a",
+		"- key /config/stale",
+		"+ key /config/b",
+	}
+	cmdArgs := append(cx.PrefixArgs(), "apply", "-f", fpath, "--prune", "--dry-run")
+	if err := e2e.SpawnWithExpects(cmdArgs, cx.envMap, append(changes, "Dry run, 8 changes not applied")...); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Get(cx, []string{"/config/a"}, kv{"/config/a", "0"}); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cmdArgs = append(cx.PrefixArgs(), "apply", "-f", fpath, "--prune")
+	if err := e2e.SpawnWithExpects(cmdArgs, cx.envMap, append(changes, "Applied 8 changes")...); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Get(cx, []string{"/config/", "--prefix"}, kv{"/config/a", "1"}, kv{"/config/b", "2"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3User(cx, []string{"get", "alice"}, "Roles: reader", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Role(cx, []string{"get", "reader"}, "Role reader"); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// applying the manifest again changes nothing
+	if err := e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "No changes"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
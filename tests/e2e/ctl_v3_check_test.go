@@ -0,0 +1,105 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+// +build !cluster_proxy
+
+package e2e
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/datadir"
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3CheckConsistency(t *testing.T) {
+	testCtl(t, checkConsistencyTest, withQuorum(), withCfg(*e2e.NewConfigNoTLS()))
+}
+
+func checkConsistencyTest(cx ctlCtx) {
+	for i := 0; i < 10; i++ {
+		if err := ctlV3Put(cx, fmt.Sprintf("foo%d", i), "bar", ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+
+	// the check compares the lowest revision of the members, so wait for all
+	// of them to apply the keys
+	cmdArgs := append(cx.PrefixArgs(), "check", "consistency")
+	require.Eventually(cx.t, func() bool {
+		if err := e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "revision 11: consistent"); err != nil {
+			cx.t.Logf("members not consistent yet: %v", err)
+			return false
+		}
+		return true
+	}, 10*time.Second, 200*time.Millisecond)
+
+	// corrupt the value of foo3 on the first member
+	if err := cx.epc.Procs[0].Stop(); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := corruptKeyValue(datadir.ToBackendFileName(cx.epc.Procs[0].Config().DataDirPath), "foo3"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := cx.epc.Procs[0].Start(); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cmdArgs = append(cx.PrefixArgs(), "check", "consistency", "--prefix", "foo")
+	if err := e2e.SpawnWithExpects(cmdArgs, cx.envMap, "revision 11: 1 mismatches", `key "foo3"`, "create_revision 5, mod_revision 5, version 1"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+// corruptKeyValue changes the values of the revisions of key in the backend.
+func corruptKeyValue(fpath, key string) error {
+	db, err := bolt.Open(fpath, 0600, &bolt.Options{})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("key"))
+		revs, vals := [][]byte{}, [][]byte{}
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return err
+			}
+			if string(kv.Key) != key {
+				continue
+			}
+			kv.Value = append(kv.Value, '!')
+			nv, err := kv.Marshal()
+			if err != nil {
+				return err
+			}
+			revs, vals = append(revs, k), append(vals, nv)
+		}
+		for i := range revs {
+			if err := b.Put(revs[i], vals[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
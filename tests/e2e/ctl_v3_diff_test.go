@@ -0,0 +1,89 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3Diff(t *testing.T)         { testCtl(t, diffTest) }
+func TestCtlV3DiffSnapshot(t *testing.T) { testCtl(t, diffSnapshotTest) }
+
+func diffTest(cx ctlCtx) {
+	// set up another cluster to compare with
+	destcfg := e2e.NewConfigAutoTLS()
+	destcfg.ClusterSize = 1
+	destcfg.BasePort = 10000
+	destctx := ctlCtx{
+		t:           cx.t,
+		cfg:         *destcfg,
+		dialTimeout: 7 * time.Second,
+	}
+
+	destepc, err := e2e.NewEtcdProcessCluster(cx.t, &destctx.cfg)
+	if err != nil {
+		cx.t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	destctx.epc = destepc
+
+	defer func() {
+		if err = destctx.epc.Close(); err != nil {
+			cx.t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	for _, kv := range []kv{{"key1", "val1"}, {"key2", "val2"}, {"other", "val"}} {
+		if err = ctlV3Put(cx, kv.key, kv.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+	for _, kv := range []kv{{"key2", "val2-changed"}, {"key3", "val3"}} {
+		if err = ctlV3Put(destctx, kv.key, kv.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+
+	cmdArgs := append(cx.PrefixArgs(), "diff", "--prefix", "key", "--ignore-revisions", fmt.Sprintf("localhost:%d", destcfg.BasePort))
+	if err = e2e.SpawnWithExpects(cmdArgs, cx.envMap, "- key1", "~ key2 [value]", "+ key3"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+func diffSnapshotTest(cx ctlCtx) {
+	for _, kv := range []kv{{"key1", "val1"}, {"key2", "val2"}} {
+		if err := ctlV3Put(cx, kv.key, kv.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+	fpath := filepath.Join(cx.t.TempDir(), "snapshot")
+	if err := ctlV3SnapshotSave(cx, fpath); err != nil {
+		cx.t.Fatal(err)
+	}
+	for _, kv := range []kv{{"key1", "val1-changed"}, {"key3", "val3"}} {
+		if err := ctlV3Put(cx, kv.key, kv.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+
+	cmdArgs := append(cx.PrefixArgs(), "diff", "--snapshot", fpath)
+	if err := e2e.SpawnWithExpects(cmdArgs, cx.envMap, "~ key1 [value mod_revision version]", "- key3"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
@@ -28,6 +28,7 @@ import (
 func TestCtlV3EndpointHealth(t *testing.T) { testCtl(t, endpointHealthTest, withQuorum()) }
 func TestCtlV3EndpointStatus(t *testing.T) { testCtl(t, endpointStatusTest, withQuorum()) }
 func TestCtlV3EndpointHashKV(t *testing.T) { testCtl(t, endpointHashKVTest, withQuorum()) }
+func TestCtlV3EndpointTop(t *testing.T)    { testCtl(t, endpointTopTest, withQuorum()) }
 
 func endpointHealthTest(cx ctlCtx) {
 	if err := ctlV3EndpointHealth(cx); err != nil {
@@ -91,3 +92,23 @@ func ctlV3EndpointHashKV(cx ctlCtx) error {
 	}
 	return e2e.SpawnWithExpects(cmdArgs, cx.envMap, ss...)
 }
+
+func endpointTopTest(cx ctlCtx) {
+	if _, err := ctlV3LeaseGrant(cx, 300); err != nil {
+		cx.t.Fatal(err)
+	}
+	cmdArgs := append(cx.PrefixArgs(), "endpoint", "top", "--iterations", "2", "--interval", "100ms")
+	lines := []string{"ENDPOINT"}
+	for _, ep := range cx.epc.EndpointsV3() {
+		lines = append(lines, ep)
+	}
+	if err := e2e.SpawnWithExpects(cmdArgs, cx.envMap, lines...); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// the leases are read from the metrics of each member
+	cmdArgs = append(cx.PrefixArgs(), "endpoint", "top", "--iterations", "1", "-w", "json")
+	if err := e2e.SpawnWithExpects(cmdArgs, cx.envMap, `"leader_changes":0,"watch_streams":0,"watchers":0,"leases":1`); err != nil {
+		cx.t.Fatal(err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3ExportImport(t *testing.T)         { testCtl(t, exportImportTest) }
+func TestCtlV3ExportImportProtobuf(t *testing.T) { testCtl(t, exportImportProtobufTest) }
+
+func exportImportTest(cx ctlCtx) {
+	// set up another cluster to import into
+	destcfg := e2e.NewConfigAutoTLS()
+	destcfg.ClusterSize = 1
+	destcfg.BasePort = 10000
+	destctx := ctlCtx{
+		t:           cx.t,
+		cfg:         *destcfg,
+		dialTimeout: 7 * time.Second,
+	}
+
+	destepc, err := e2e.NewEtcdProcessCluster(cx.t, &destctx.cfg)
+	if err != nil {
+		cx.t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	destctx.epc = destepc
+
+	defer func() {
+		if err = destctx.epc.Close(); err != nil {
+			cx.t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	// the root role and user of the destination are kept
+	for _, c := range []ctlCtx{cx, destctx} {
+		if err = ctlV3Role(c, []string{"add", "root"}, "Role root created"); err != nil {
+			cx.t.Fatal(err)
+		}
+		if err = ctlV3User(c, []string{"add", "root", "--interactive=false"}, "User root created", []string{"pass"}); err != nil {
+			cx.t.Fatal(err)
+		}
+		if err = ctlV3User(c, []string{"grant-role", "root", "root"}, "Role root is granted to user root", nil); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+	if err = ctlV3Role(cx, []string{"add", "r1"}, "Role r1 created"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3RoleGrantPermission(cx, "r1", grantingPerm{true, true, "o_", "", true}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3User(cx, []string{"add", "u1", "--interactive=false"}, "User u1 created", []string{"pass"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3User(cx, []string{"grant-role", "u1", "r1"}, "Role r1 is granted to user u1", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+	leaseID, err := ctlV3LeaseGrant(cx, 300)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Put(cx, "o_key1", "val1", leaseID); err != nil {
+		cx.t.Fatal(err)
+	}
+	for _, kv := range []kv{{"o_key2", "val2"}, {"other", "val"}} {
+		if err = ctlV3Put(cx, kv.key, kv.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+
+	fpath := filepath.Join(cx.t.TempDir(), "export")
+	cmdArgs := append(cx.PrefixArgs(), "export", "--prefix", "o_", "--with-auth", fpath)
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "Exported 2 keys, 1 leases, 2 roles and 2 users"); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cmdArgs = append(destctx.PrefixArgs(), "import", "--dest-prefix", "d_", fpath)
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, destctx.envMap, "Imported 2 keys, 1 leases, 1 roles and 1 users, skipped 2"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Get(destctx, []string{"d_", "--prefix"}, kv{"d_key1", "val1"}, kv{"d_key2", "val2"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Role(destctx, []string{"get", "r1"}, "(prefix d_)"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3User(destctx, []string{"get", "u1"}, "Roles: r1", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+	cmdArgs = append(destctx.PrefixArgs(), "lease", "list")
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, destctx.envMap, "found 1 leases"); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// importing again conflicts with the imported keys
+	cmdArgs = append(destctx.PrefixArgs(), "import", "--dest-prefix", "d_", fpath)
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, destctx.envMap, `key "d_key1" already exists`); err != nil {
+		cx.t.Fatal(err)
+	}
+	cmdArgs = append(destctx.PrefixArgs(), "import", "--dest-prefix", "d_", "--on-conflict", "skip", fpath)
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, destctx.envMap, "Imported 0 keys, 1 leases, 0 roles and 0 users, skipped 6"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+func exportImportProtobufTest(cx ctlCtx) {
+	for _, kv := range []kv{{"o_key1", "val1"}, {"o_key2", "val2"}} {
+		if err := ctlV3Put(cx, kv.key, kv.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+
+	fpath := filepath.Join(cx.t.TempDir(), "export")
+	cmdArgs := append(cx.PrefixArgs(), "export", "--prefix", "o_", "--format", "protobuf", fpath)
+	if err := e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "Exported 2 keys, 0 leases, 0 roles and 0 users"); err != nil {
+		cx.t.Fatal(err)
+	}
+	cmdArgs = append(cx.PrefixArgs(), "import", "--format", "protobuf", "--dest-prefix", "n_", fpath)
+	if err := e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "Imported 2 keys, 0 leases, 0 roles and 0 users, skipped 0"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Get(cx, []string{"n_", "--prefix"}, kv{"n_key1", "val1"}, kv{"n_key2", "val2"}); err != nil {
+		cx.t.Fatal(err)
+	}
+}
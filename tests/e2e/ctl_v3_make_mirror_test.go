@@ -15,16 +15,23 @@
 package e2e
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/tests/v3/framework/e2e"
 )
 
 func TestCtlV3MakeMirror(t *testing.T)                 { testCtl(t, makeMirrorTest) }
 func TestCtlV3MakeMirrorModifyDestPrefix(t *testing.T) { testCtl(t, makeMirrorModifyDestPrefixTest) }
 func TestCtlV3MakeMirrorNoDestPrefix(t *testing.T)     { testCtl(t, makeMirrorNoDestPrefixTest) }
+func TestCtlV3MakeMirrorCheckpoint(t *testing.T)       { testCtl(t, makeMirrorCheckpointTest) }
+func TestCtlV3MakeMirrorLeases(t *testing.T)           { testCtl(t, makeMirrorLeasesTest) }
+func TestCtlV3MakeMirrorForeignLease(t *testing.T)     { testCtl(t, makeMirrorForeignLeaseTest) }
 
 func makeMirrorTest(cx ctlCtx) {
 	var (
@@ -60,31 +67,12 @@ func makeMirrorNoDestPrefixTest(cx ctlCtx) {
 }
 
 func testMirrorCommand(cx ctlCtx, flags []string, sourcekvs []kv, destkvs []kvExec, srcprefix, destprefix string) {
-	// set up another cluster to mirror with
-	mirrorcfg := e2e.NewConfigAutoTLS()
-	mirrorcfg.ClusterSize = 1
-	mirrorcfg.BasePort = 10000
-	mirrorctx := ctlCtx{
-		t:           cx.t,
-		cfg:         *mirrorcfg,
-		dialTimeout: 7 * time.Second,
-	}
-
-	mirrorepc, err := e2e.NewEtcdProcessCluster(cx.t, &mirrorctx.cfg)
-	if err != nil {
-		cx.t.Fatalf("could not start etcd process cluster (%v)", err)
-	}
-	mirrorctx.epc = mirrorepc
-
-	defer func() {
-		if err = mirrorctx.epc.Close(); err != nil {
-			cx.t.Fatalf("error closing etcd processes (%v)", err)
-		}
-	}()
+	mirrorctx := newMirrorCluster(cx)
+	defer closeMirrorCluster(mirrorctx)
 
 	cmdArgs := append(cx.PrefixArgs(), "make-mirror")
 	cmdArgs = append(cmdArgs, flags...)
-	cmdArgs = append(cmdArgs, fmt.Sprintf("localhost:%d", mirrorcfg.BasePort))
+	cmdArgs = append(cmdArgs, fmt.Sprintf("localhost:%d", mirrorctx.cfg.BasePort))
 	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
 	if err != nil {
 		cx.t.Fatal(err)
@@ -109,3 +97,138 @@ func testMirrorCommand(cx ctlCtx, flags []string, sourcekvs []kv, destkvs []kvEx
 		cx.t.Fatal(err)
 	}
 }
+
+func makeMirrorCheckpointTest(cx ctlCtx) {
+	mirrorctx := newMirrorCluster(cx)
+	defer closeMirrorCluster(mirrorctx)
+
+	cmdArgs := append(cx.PrefixArgs(), "make-mirror", "--prefix", "key", "--checkpoint-key", "mirror-checkpoint", fmt.Sprintf("localhost:%d", mirrorctx.cfg.BasePort))
+	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Put(cx, "key1", "val1", ""); err != nil {
+		cx.t.Fatal(err)
+	}
+	// key1 is mirrored at revision 2
+	if err = ctlV3Watch(mirrorctx, []string{"mirror-checkpoint", "--rev", "1"}, kvExec{key: "mirror-checkpoint", val: "2"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = proc.Stop(); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// a resumed mirror only mirrors the updates since the checkpoint, so
+	// it does not restore a key deleted in the destination
+	if err = ctlV3Del(mirrorctx, []string{"key1"}, 1); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Put(cx, "key2", "val2", ""); err != nil {
+		cx.t.Fatal(err)
+	}
+	proc, err = e2e.SpawnCmd(cmdArgs, cx.envMap)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	defer proc.Stop()
+	if err = ctlV3Watch(mirrorctx, []string{"key2", "--rev", "1"}, kvExec{key: "key2", val: "val2"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Get(mirrorctx, []string{"key", "--prefix"}, kv{"key2", "val2"}); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+func makeMirrorLeasesTest(cx ctlCtx) {
+	mirrorctx := newMirrorCluster(cx)
+	defer closeMirrorCluster(mirrorctx)
+
+	cmdArgs := append(cx.PrefixArgs(), "make-mirror", "--prefix", "key", "--mirror-leases", fmt.Sprintf("localhost:%d", mirrorctx.cfg.BasePort))
+	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	defer proc.Stop()
+
+	leaseID, err := ctlV3LeaseGrant(cx, 300)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Put(cx, "key1", "val1", leaseID); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Watch(mirrorctx, []string{"key1", "--rev", "1"}, kvExec{key: "key1", val: "val1"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	cmdArgs = append(mirrorctx.PrefixArgs(), "lease", "timetolive", leaseID, "--keys")
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, mirrorctx.envMap, fmt.Sprintf("lease %s granted with TTL(300s)", leaseID)); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+// makeMirrorForeignLeaseTest ensures a lease granted in the destination by
+// someone else is not adopted by the mirror.
+func makeMirrorForeignLeaseTest(cx ctlCtx) {
+	mirrorctx := newMirrorCluster(cx)
+	defer closeMirrorCluster(mirrorctx)
+
+	leaseID, err := ctlV3LeaseGrant(cx, 300)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	id, err := strconv.ParseInt(leaseID, 16, 64)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	dc := newClient(cx.t, mirrorctx.epc.EndpointsV3(), mirrorctx.cfg.ClientTLS, mirrorctx.cfg.IsClientAutoTLS)
+	if _, err = clientv3.RetryLeaseClient(dc).LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{ID: id, TTL: 600}); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cmdArgs := append(cx.PrefixArgs(), "make-mirror", "--prefix", "key", "--mirror-leases", fmt.Sprintf("localhost:%d", mirrorctx.cfg.BasePort))
+	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	defer proc.Stop()
+
+	if err = ctlV3Put(cx, "key1", "val1", leaseID); err != nil {
+		cx.t.Fatal(err)
+	}
+	if _, err = proc.Expect("already exists in the destination"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Watch(mirrorctx, []string{"key1", "--rev", "1"}, kvExec{key: "key1", val: "val1"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	// the key is mirrored without the lease, which keeps its own TTL
+	cmdArgs = append(mirrorctx.PrefixArgs(), "lease", "timetolive", leaseID, "--keys")
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, mirrorctx.envMap, "attached keys([])"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+// newMirrorCluster starts another cluster to mirror to.
+func newMirrorCluster(cx ctlCtx) ctlCtx {
+	mirrorcfg := e2e.NewConfigAutoTLS()
+	mirrorcfg.ClusterSize = 1
+	mirrorcfg.BasePort = 10000
+	mirrorctx := ctlCtx{
+		t:           cx.t,
+		cfg:         *mirrorcfg,
+		dialTimeout: 7 * time.Second,
+	}
+
+	mirrorepc, err := e2e.NewEtcdProcessCluster(cx.t, &mirrorctx.cfg)
+	if err != nil {
+		cx.t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	mirrorctx.epc = mirrorepc
+	return mirrorctx
+}
+
+func closeMirrorCluster(mirrorctx ctlCtx) {
+	if err := mirrorctx.epc.Close(); err != nil {
+		mirrorctx.t.Fatalf("error closing etcd processes (%v)", err)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/expect"
 	"go.etcd.io/etcd/tests/v3/framework/e2e"
+	"go.uber.org/zap"
 )
 
 func TestCtlV3Snapshot(t *testing.T)        { testCtl(t, snapshotTest) }
@@ -422,6 +423,100 @@ func TestRestoreCompactionRevBump(t *testing.T) {
 
 }
 
+func TestRestoreSubtree(t *testing.T) {
+	e2e.BeforeTest(t)
+
+	epc, err := e2e.NewEtcdProcessCluster(t, &e2e.EtcdProcessClusterConfig{
+		ClusterSize:  1,
+		InitialToken: "new",
+		KeepDataDir:  true,
+	})
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if errC := epc.Close(); errC != nil {
+			t.Fatalf("error closing etcd processes (%v)", errC)
+		}
+	}()
+
+	ctx := context.Background()
+	ctl := newClient(t, epc.EndpointsV3(), epc.Cfg.ClientTLS, epc.Cfg.IsClientAutoTLS)
+	leaseA, err := ctl.Grant(ctx, 3600)
+	require.NoError(t, err)
+	leaseB, err := ctl.Grant(ctx, 3600)
+	require.NoError(t, err)
+	_, err = ctl.Put(ctx, "/tenants/a/1", "a1")
+	require.NoError(t, err)
+	_, err = ctl.Put(ctx, "/tenants/b/1", "b1")
+	require.NoError(t, err)
+	_, err = ctl.Put(ctx, "/tenants/a/2", "a2", clientv3.WithLease(leaseA.ID))
+	require.NoError(t, err)
+	_, err = ctl.Put(ctx, "/tenants/b/2", "b2", clientv3.WithLease(leaseB.ID))
+	require.NoError(t, err)
+	_, err = ctl.RoleAdd(ctx, "tenant-a")
+	require.NoError(t, err)
+	_, err = ctl.RoleGrantPermission(ctx, "tenant-a", "/tenants/a/", clientv3.GetPrefixRangeEnd("/tenants/a/"), clientv3.PermissionType(clientv3.PermReadWrite))
+	require.NoError(t, err)
+
+	fpath := filepath.Join(t.TempDir(), "test.snapshot")
+	prefixArgs := []string{e2e.CtlBinPath, "--endpoints", strings.Join(epc.EndpointsV3(), ",")}
+	require.NoError(t, e2e.SpawnWithExpect(append(prefixArgs, "snapshot", "save", fpath), fmt.Sprintf("Snapshot saved at %s", fpath)))
+
+	t.Log("Stopping the original server...")
+	require.NoError(t, epc.Stop())
+
+	newDataDir := filepath.Join(t.TempDir(), "test.data")
+	t.Log("etcdutl restoring the subtree of tenant a...")
+	err = e2e.SpawnWithExpect([]string{
+		e2e.UtlBinPath,
+		"snapshot",
+		"restore", fpath,
+		"--name", epc.Procs[0].Config().Name,
+		"--initial-cluster", epc.Procs[0].Config().InitialCluster,
+		"--initial-cluster-token", epc.Procs[0].Config().InitialToken,
+		"--initial-advertise-peer-urls", epc.Procs[0].Config().Purl.String(),
+		"--include-prefix", "/tenants/a/",
+		"--rewrite-prefix-from", "/tenants/a/",
+		"--rewrite-prefix-to", "/restored/",
+		"--data-dir", newDataDir,
+	}, "added member")
+	require.NoError(t, err)
+
+	epc.Procs[0].Config().DataDirPath = newDataDir
+	for i := range epc.Procs[0].Config().Args {
+		if epc.Procs[0].Config().Args[i] == "--data-dir" {
+			epc.Procs[0].Config().Args[i+1] = newDataDir
+		}
+	}
+	require.NoError(t, epc.Restart())
+
+	t.Log("Ensuring the restored member has only the rewritten subtree...")
+	resp, err := ctl.Get(ctx, "", clientv3.WithFromKey())
+	require.NoError(t, err)
+	var got []kv
+	for _, ev := range resp.Kvs {
+		got = append(got, kv{string(ev.Key), string(ev.Value)})
+	}
+	require.Equal(t, []kv{{"/restored/1", "a1"}, {"/restored/2", "a2"}}, got)
+	require.Equal(t, int64(leaseA.ID), resp.Kvs[1].Lease)
+
+	lresp, err := ctl.Leases(ctx)
+	require.NoError(t, err)
+	require.Len(t, lresp.Leases, 1)
+	require.Equal(t, leaseA.ID, lresp.Leases[0].ID)
+
+	rresp, err := ctl.RoleGet(ctx, "tenant-a")
+	require.NoError(t, err)
+	require.Len(t, rresp.Perm, 1)
+	require.Equal(t, "/restored/", string(rresp.Perm[0].Key))
+	require.Equal(t, clientv3.GetPrefixRangeEnd("/restored/"), string(rresp.Perm[0].RangeEnd))
+
+	// the member accepts writes after the restored revisions
+	_, err = ctl.Put(ctx, "/restored/3", "a3")
+	require.NoError(t, err)
+}
+
 func hasKVs(t *testing.T, ctl *clientv3.Client, kvs []kv, currentRev int, baseRev int) {
 	for i := range kvs {
 		v, err := ctl.Get(context.Background(), kvs[i].key)
@@ -456,3 +551,75 @@ func keyValuesFromWatchChan(wch clientv3.WatchChan, wantedLen int, timeout time.
 		}
 	}
 }
+
+func TestWALReplay(t *testing.T) {
+	e2e.BeforeTest(t)
+
+	epc, err := e2e.NewEtcdProcessCluster(t, &e2e.EtcdProcessClusterConfig{
+		ClusterSize:  1,
+		InitialToken: "new",
+		KeepDataDir:  true,
+	})
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if errC := epc.Close(); errC != nil {
+			t.Fatalf("error closing etcd processes (%v)", errC)
+		}
+	}()
+
+	ctx := context.Background()
+	ctl := newClient(t, epc.EndpointsV3(), epc.Cfg.ClientTLS, epc.Cfg.IsClientAutoTLS)
+	_, err = ctl.Put(ctx, "a", "1")
+	require.NoError(t, err)
+
+	fpath := filepath.Join(t.TempDir(), "test.snapshot")
+	prefixArgs := []string{e2e.CtlBinPath, "--endpoints", strings.Join(epc.EndpointsV3(), ",")}
+	require.NoError(t, e2e.SpawnWithExpect(append(prefixArgs, "snapshot", "save", fpath), fmt.Sprintf("Snapshot saved at %s", fpath)))
+
+	_, err = ctl.Put(ctx, "a", "2")
+	require.NoError(t, err)
+	sresp, err := ctl.Status(ctx, epc.EndpointsV3()[0])
+	require.NoError(t, err)
+	index := sresp.RaftIndex
+	// the second put was proposed before it was acknowledged
+	until := time.Now()
+	_, err = ctl.Put(ctx, "b", "1")
+	require.NoError(t, err)
+
+	t.Log("Stopping the server...")
+	require.NoError(t, epc.Stop())
+
+	t.Log("etcdutl replaying the WAL up to the second put...")
+	out := filepath.Join(t.TempDir(), "replayed.db")
+	err = e2e.SpawnWithExpects([]string{
+		e2e.UtlBinPath,
+		"wal", "replay",
+		"--data-dir", epc.Procs[0].Config().DataDirPath,
+		"--snapshot", fpath,
+		"--output", out,
+		"--index", fmt.Sprint(index),
+		"--print-entries",
+	}, nil,
+		`"put":{"key":"YQ==","value":"Mg=="}`,
+		fmt.Sprintf("to %d, revision 3, into %s", index, out),
+	)
+	require.NoError(t, err)
+
+	st, err := snapshot.NewV3(zap.NewNop()).Status(out)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), st.Revision)
+
+	t.Log("etcdutl replaying the WAL up to the time of the second put...")
+	out = filepath.Join(t.TempDir(), "replayed.db")
+	err = e2e.SpawnWithExpect([]string{
+		e2e.UtlBinPath,
+		"wal", "replay",
+		"--data-dir", epc.Procs[0].Config().DataDirPath,
+		"--snapshot", fpath,
+		"--output", out,
+		"--time", until.Format(time.RFC3339Nano),
+	}, fmt.Sprintf("to %d, revision 3, into %s", index, out))
+	require.NoError(t, err)
+}
@@ -193,6 +193,7 @@ var (
 			expectedRespSubStrings: []string{
 				`[+]serializable_read ok`,
 				`[+]data_corruption ok`,
+				`[+]disk_latency ok`,
 			},
 		},
 	}
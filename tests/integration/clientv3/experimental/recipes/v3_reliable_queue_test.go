@@ -0,0 +1,147 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipes_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	recipe "go.etcd.io/etcd/client/v3/experimental/recipes"
+	"go.etcd.io/etcd/tests/v3/integration"
+)
+
+// TestReliableQueueAck confirms the queue is FIFO and that acknowledged
+// items are removed.
+func TestReliableQueueAck(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	s, err := concurrency.NewSession(clus.RandClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Orphan()
+	q := recipe.NewReliableQueue(s, "testq", 0, 0)
+	for i := 0; i < 3; i++ {
+		if err = q.Enqueue(context.TODO(), fmt.Sprintf("%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		it, err := q.Dequeue(context.TODO())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if it.Value != fmt.Sprintf("%d", i) || it.Attempts != 1 {
+			t.Fatalf("expected value %d on first attempt, got %q on attempt %d", i, it.Value, it.Attempts)
+		}
+		if err = q.Ack(context.TODO(), it); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if it, err := q.Peek(context.TODO()); err != nil || it != nil {
+		t.Fatalf("expected empty queue, got %+v (%v)", it, err)
+	}
+}
+
+// TestReliableQueueRedelivery confirms that items are redelivered when the
+// consumer's lease expires, when the visibility timeout passes, and when
+// they are nacked, and that they are dead-lettered after too many attempts.
+func TestReliableQueueRedelivery(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.RandClient()
+
+	s1, err := concurrency.NewSession(cli, concurrency.WithTTL(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s2.Orphan()
+
+	q1 := recipe.NewReliableQueue(s1, "testq", 0, 4)
+	if err = q1.Enqueue(context.TODO(), "job"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = q1.Dequeue(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	// consumer crashes; item comes back once its lease expires
+	s1.Orphan()
+	q2 := recipe.NewReliableQueue(s2, "testq", 500*time.Millisecond, 4)
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	it, err := q2.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if it.Attempts != 2 {
+		t.Fatalf("expected attempt 2 after lease expiry, got %d", it.Attempts)
+	}
+
+	// visibility timeout passes without an ack
+	if it, err = q2.Dequeue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if it.Attempts != 3 {
+		t.Fatalf("expected attempt 3 after visibility timeout, got %d", it.Attempts)
+	}
+	if err = q2.Ack(context.TODO(), it); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = q2.Enqueue(context.TODO(), "poison"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 4; i++ {
+		if it, err = q2.Dequeue(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if it.Attempts != i {
+			t.Fatalf("expected attempt %d, got %d", i, it.Attempts)
+		}
+		if err = q2.Nack(context.TODO(), it); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the next delivery dead-letters the item instead, so it is not peeked
+	if pit, perr := q2.Peek(context.TODO()); perr != nil || pit != nil {
+		t.Fatalf("expected no item to peek, got %+v, %v", pit, perr)
+	}
+	dctx, dcancel := context.WithTimeout(context.TODO(), time.Second)
+	defer dcancel()
+	if _, err = q2.Dequeue(dctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	resp, err := cli.Get(context.TODO(), "testq/dead/", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Key) != "testq/dead/"+it.Key[len("testq/items/"):] {
+		t.Fatalf("expected dead-lettered item %q, got %+v", it.Key, resp.Kvs)
+	}
+}
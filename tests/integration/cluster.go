@@ -41,23 +41,28 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/grpc_testing"
 	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2http"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3election"
 	epb "go.etcd.io/etcd/server/v3/etcdserver/api/v3election/v3electionpb"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3lock"
 	lockpb "go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore"
+	semaphorepb "go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
 	"go.etcd.io/etcd/server/v3/verify"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
 
 	"github.com/soheilhy/cmux"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
@@ -172,6 +177,17 @@ type ClusterConfig struct {
 
 	WatchProgressNotifyInterval time.Duration
 	CorruptCheckTime            time.Duration
+
+	LatencyAlarmWindow            time.Duration
+	LatencyAlarmWALFsyncThreshold time.Duration
+
+	TracerProvider trace.TracerProvider
+
+	AuditLogger *v3audit.Logger
+
+	EnforceOperationPermissions bool
+
+	Authorizer auth.Authorizer
 }
 
 type cluster struct {
@@ -337,6 +353,13 @@ func (c *cluster) mustNewMember(t testutil.TB, memberNumber int64) *member {
 			leaseCheckpointInterval:     c.cfg.LeaseCheckpointInterval,
 			WatchProgressNotifyInterval: c.cfg.WatchProgressNotifyInterval,
 			CorruptCheckTime:            c.cfg.CorruptCheckTime,
+
+			latencyAlarmWindow:            c.cfg.LatencyAlarmWindow,
+			latencyAlarmWALFsyncThreshold: c.cfg.LatencyAlarmWALFsyncThreshold,
+			tracerProvider:                c.cfg.TracerProvider,
+			auditLogger:                   c.cfg.AuditLogger,
+			enforceOperationPermissions:   c.cfg.EnforceOperationPermissions,
+			authorizer:                    c.cfg.Authorizer,
 		})
 	m.DiscoveryURL = c.cfg.DiscoveryURL
 	if c.cfg.UseGRPC {
@@ -646,6 +669,13 @@ type memberConfig struct {
 	leaseCheckpointPersist      bool
 	WatchProgressNotifyInterval time.Duration
 	CorruptCheckTime            time.Duration
+
+	latencyAlarmWindow            time.Duration
+	latencyAlarmWALFsyncThreshold time.Duration
+	tracerProvider                trace.TracerProvider
+	auditLogger                   *v3audit.Logger
+	enforceOperationPermissions   bool
+	authorizer                    auth.Authorizer
 }
 
 // mustNewMember return an inited member with the given name. If peerTLS is
@@ -754,6 +784,13 @@ func mustNewMember(t testutil.TB, mcfg memberConfig) *member {
 	if mcfg.CorruptCheckTime > time.Duration(0) {
 		m.CorruptCheckTime = mcfg.CorruptCheckTime
 	}
+	m.LatencyAlarmWindow = mcfg.latencyAlarmWindow
+	m.LatencyAlarmPercentile = embed.DefaultLatencyAlarmPercentile
+	m.LatencyAlarmWALFsyncThreshold = mcfg.latencyAlarmWALFsyncThreshold
+	m.ExperimentalTracerProvider = mcfg.tracerProvider
+	m.AuditLogger = mcfg.auditLogger
+	m.EnforceOperationPermissions = mcfg.enforceOperationPermissions
+	m.Authorizer = mcfg.authorizer
 	m.WarningApplyDuration = embed.DefaultWarningApplyDuration
 
 	m.V2Deprecation = config.V2_DEPR_DEFAULT
@@ -974,6 +1011,7 @@ func (m *member) Launch() error {
 		m.serverClient = v3client.New(m.s)
 		lockpb.RegisterLockServer(m.grpcServer, v3lock.NewLockServer(m.serverClient))
 		epb.RegisterElectionServer(m.grpcServer, v3election.NewElectionServer(m.serverClient))
+		semaphorepb.RegisterSemaphoreServer(m.grpcServer, v3semaphore.NewSemaphoreServer(m.serverClient))
 		go m.grpcServer.Serve(m.grpcListener)
 	}
 
@@ -1540,6 +1578,8 @@ type grpcAPI struct {
 	Lock lockpb.LockClient
 	// Election is the election API for the client's connection.
 	Election epb.ElectionClient
+	// Semaphore is the semaphore API for the client's connection.
+	Semaphore semaphorepb.SemaphoreClient
 }
 
 // GetLearnerMembers returns the list of learner members in cluster using MemberList API.
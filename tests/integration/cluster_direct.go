@@ -22,6 +22,7 @@ import (
 	"go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3election/v3electionpb"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
 	"go.uber.org/zap"
 )
 
@@ -37,6 +38,7 @@ func toGRPC(c *clientv3.Client) grpcAPI {
 		pb.NewAuthClient(c.ActiveConnection()),
 		v3lockpb.NewLockClient(c.ActiveConnection()),
 		v3electionpb.NewElectionClient(c.ActiveConnection()),
+		v3semaphorepb.NewSemaphoreClient(c.ActiveConnection()),
 	}
 }
 
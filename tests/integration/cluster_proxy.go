@@ -74,6 +74,7 @@ func toGRPC(c *clientv3.Client) grpcAPI {
 	authp := grpcproxy.NewAuthProxy(c)
 	lockp := grpcproxy.NewLockProxy(c)
 	electp := grpcproxy.NewElectionProxy(c)
+	semp := grpcproxy.NewSemaphoreProxy(c)
 
 	grpc := grpcAPI{
 		adapter.ClusterServerToClusterClient(clp),
@@ -84,6 +85,7 @@ func toGRPC(c *clientv3.Client) grpcAPI {
 		adapter.AuthServerToAuthClient(authp),
 		adapter.LockServerToLockClient(lockp),
 		adapter.ElectionServerToElectionClient(electp),
+		adapter.SemaphoreServerToSemaphoreClient(semp),
 	}
 	proxies[c] = grpcClientProxy{ctx: ctx, ctxCancel: ctxCancel, grpc: grpc, wdonec: wpch, kvdonec: kvpch, lpdonec: lpch}
 	return grpc
@@ -163,6 +163,40 @@ func TestCorruptedBackupFileCheck(t *testing.T) {
 	}
 }
 
+// TestSnapshotV3Analyze ensures that the keyspace of a snapshot file is
+// aggregated by prefix and ranked by size and revisions.
+func TestSnapshotV3Analyze(t *testing.T) {
+	integration.BeforeTest(t)
+	kvs := []kv{{"/a/x", "1"}, {"/a/x", "22"}, {"/a/y", "333"}, {"/b/z", "4"}}
+	dbPath := createSnapshotFile(t, kvs)
+
+	a, err := snapshot.Analyze(dbPath, snapshot.AnalyzeConfig{PrefixDepth: 1, PrefixDelimiter: "/", Top: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Keys != 3 || a.Revisions != 4 {
+		t.Fatalf("expected 3 keys over 4 revisions, got %d keys over %d revisions", a.Keys, a.Revisions)
+	}
+	if len(a.Prefixes) != 1 || a.Prefixes[0].Prefix != "/a/" || a.Prefixes[0].Keys != 2 || a.Prefixes[0].Revisions != 3 {
+		t.Fatalf("expected prefix /a/ with 2 keys over 3 revisions, got %+v", a.Prefixes)
+	}
+	if a.Prefixes[0].LiveBytes != int64(len("/a/x22/a/y333")) {
+		t.Fatalf("expected live size %d, got %d", len("/a/x22/a/y333"), a.Prefixes[0].LiveBytes)
+	}
+	if len(a.LargestValues) != 1 || a.LargestValues[0].Key != "/a/y" || a.LargestValues[0].ValueSize != 3 {
+		t.Fatalf("expected largest value /a/y, got %+v", a.LargestValues)
+	}
+	if len(a.MostRevisions) != 1 || a.MostRevisions[0].Key != "/a/x" || a.MostRevisions[0].Revisions != 2 {
+		t.Fatalf("expected /a/x with most revisions, got %+v", a.MostRevisions)
+	}
+	if len(a.Leases) != 1 || a.Leases[0].ID != 0 || a.Leases[0].Keys != 3 {
+		t.Fatalf("expected 3 keys without lease, got %+v", a.Leases)
+	}
+	if a.Pages.TotalPages == 0 || a.Pages.FreePages+a.Pages.PendingPages >= int(a.Pages.TotalPages) {
+		t.Fatalf("unexpected page usage %+v", a.Pages)
+	}
+}
+
 type kv struct {
 	k, v string
 }
@@ -20,14 +20,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 	traceservice "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	"google.golang.org/grpc"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
@@ -115,6 +118,58 @@ func TestTracing(t *testing.T) {
 	}
 }
 
+// TestTracingRaftRequest ensures that a traced request is followed through
+// proposal, commit, apply and backend commit on every member.
+func TestTracingRaftRequest(t *testing.T) {
+	BeforeTest(t)
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample()), sdktrace.WithSpanProcessor(sr))
+	defer tp.Shutdown(context.TODO())
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3, TracerProvider: tp})
+	defer clus.Terminate(t)
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "put")
+	_, err := clus.Members[0].s.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")})
+	span.End()
+	require.NoError(t, err)
+
+	want := map[string]int{
+		"put":                 1,
+		"etcdserver.proposal": 1,
+		"raft.propose":        1,
+		"raft.commit":         1,
+		"etcdserver.apply":    3,
+		"mvcc.txn":            3,
+		"backend.commit":      3,
+	}
+	traceID := span.SpanContext().TraceID()
+	count := func() map[string]int {
+		got := make(map[string]int)
+		for _, s := range sr.Ended() {
+			if s.SpanContext().TraceID() == traceID {
+				got[s.Name()]++
+			}
+		}
+		return got
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if assert.ObjectsAreEqual(want, count()) {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, want, count())
+
+	for _, s := range sr.Ended() {
+		if s.Name() == "mvcc.txn" {
+			require.NotEmpty(t, s.Events(), "expected trace steps recorded as span events")
+		}
+	}
+}
+
 func containsNodeListSpan(req *traceservice.ExportTraceServiceRequest) bool {
 	for _, resourceSpans := range req.GetResourceSpans() {
 		for _, attr := range resourceSpans.GetResource().GetAttributes() {
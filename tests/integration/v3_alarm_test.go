@@ -172,6 +172,60 @@ func TestV3AlarmDeactivate(t *testing.T) {
 	}
 }
 
+// TestV3LatencyAlarm ensures a member raises the latency alarm while its
+// disk latency exceeds the threshold and clears it once latency recovers.
+func TestV3LatencyAlarm(t *testing.T) {
+	BeforeTest(t)
+
+	clus := NewClusterV3(t, &ClusterConfig{
+		Size:                          1,
+		LatencyAlarmWindow:            200 * time.Millisecond,
+		LatencyAlarmWALFsyncThreshold: time.Nanosecond,
+	})
+	defer clus.Terminate(t)
+	kvc := toGRPC(clus.RandClient()).KV
+	mt := toGRPC(clus.RandClient()).Maintenance
+	id := uint64(clus.Members[0].s.ID())
+
+	hasAlarm := func() bool {
+		resp, err := mt.Alarm(context.TODO(), &pb.AlarmRequest{Action: pb.AlarmRequest_GET, Alarm: pb.AlarmType_LATENCY})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range resp.Alarms {
+			if a.MemberID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	// every fsync exceeds the threshold while the member is written to
+	deadline := time.Now().Add(10 * time.Second)
+	for !hasAlarm() {
+		if time.Now().After(deadline) {
+			t.Fatal("expected latency alarm to be raised")
+		}
+		for i := 0; i < 20; i++ {
+			if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// latency alarms do not restrict requests
+	if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the idle windows do not have enough operations to clear the alarm
+	for i := 0; i < 5; i++ {
+		time.Sleep(200 * time.Millisecond)
+		if !hasAlarm() {
+			t.Fatal("expected latency alarm to be kept while the member is idle")
+		}
+	}
+}
+
 func TestV3CorruptAlarm(t *testing.T) {
 	BeforeTest(t)
 	clus := NewClusterV3(t, &ClusterConfig{Size: 3, UseBridge: true})
@@ -0,0 +1,178 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
+)
+
+type auditBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *auditBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *auditBuffer) Close() error { return nil }
+
+func (b *auditBuffer) records(t *testing.T) (rs []v3audit.Record) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sc := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for sc.Scan() {
+		var r v3audit.Record
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			t.Fatalf("invalid audit record %q: %v", sc.Text(), err)
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// TestV3AuditLog ensures that the requests selected by the audit policy are
+// recorded with their user, keys, result and revision.
+func TestV3AuditLog(t *testing.T) {
+	BeforeTest(t)
+
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := ioutil.WriteFile(policyFile, []byte("request-types: [put]\nprefixes: [/audited/]\nlevel: values\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := v3audit.LoadPolicy(policyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &auditBuffer{}
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, AuditLogger: v3audit.NewLoggerWithWriter(buf, policy)})
+	defer clus.Terminate(t)
+
+	users := []user{{name: "alice", password: "alice-123", role: "alice", key: "/audited/alice/", end: "/audited/alice0"}}
+	authSetupUsers(t, toGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	alice, err := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "alice", Password: "alice-123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alice.Close()
+
+	presp, err := alice.Put(context.TODO(), "/audited/alice/a", "v")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ranges and the keys outside of the prefixes are not audited
+	if _, err = alice.Get(context.TODO(), "/audited/alice/a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = alice.Put(context.TODO(), "/other/a", "v"); err == nil {
+		t.Fatal("expected the put to be denied")
+	}
+	// denials are audited
+	if _, err = alice.Put(context.TODO(), "/audited/bob/a", "v"); err == nil {
+		t.Fatal("expected the put to be denied")
+	}
+
+	rs := buf.records(t)
+	if len(rs) != 2 {
+		t.Fatalf("expected 2 records, got %+v", rs)
+	}
+	if r := rs[0]; r.User != "alice" || r.Type != v3audit.TypePut || r.Result != v3audit.ResultSuccess ||
+		r.Revision != presp.Header.Revision || r.Remote == "" || len(r.Keys) != 1 ||
+		r.Keys[0] != (v3audit.KeyRange{Key: "/audited/alice/a", Value: "v"}) {
+		t.Errorf("unexpected record of the put: %+v", r)
+	}
+	if r := rs[1]; r.User != "alice" || r.Result != v3audit.ResultDenied || r.Error == "" ||
+		len(r.Keys) != 1 || r.Keys[0].Key != "/audited/bob/a" {
+		t.Errorf("unexpected record of the denied put: %+v", r)
+	}
+}
+
+// TestV3AuditLogStreams ensures that the denied watch creations and the
+// snapshots are recorded.
+func TestV3AuditLogStreams(t *testing.T) {
+	BeforeTest(t)
+
+	buf := &auditBuffer{}
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, AuditLogger: v3audit.NewLoggerWithWriter(buf, v3audit.DefaultPolicy())})
+	defer clus.Terminate(t)
+
+	users := []user{{name: "alice", password: "alice-123", role: "alice", key: "/alice/", end: "/alice0"}}
+	authSetupUsers(t, toGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	alice, err := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "alice", Password: "alice-123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alice.Close()
+	root, err := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer root.Close()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	// the permitted watch is read only, it is not audited
+	alice.Watch(ctx, "/alice/", clientv3.WithPrefix())
+	wresp := <-alice.Watch(ctx, "/bob/", clientv3.WithPrefix())
+	if !wresp.Canceled {
+		t.Fatal("expected the watch to be denied")
+	}
+
+	for _, c := range []*clientv3.Client{alice, root} {
+		rc, serr := c.Snapshot(context.TODO())
+		if serr != nil {
+			t.Fatal(serr)
+		}
+		ioutil.ReadAll(rc)
+		rc.Close()
+	}
+
+	var rs []v3audit.Record
+	for _, r := range buf.records(t) {
+		if r.Type == v3audit.TypeWatch || r.Method == "/etcdserverpb.Maintenance/Snapshot" {
+			rs = append(rs, r)
+		}
+	}
+	if len(rs) != 3 {
+		t.Fatalf("expected 3 records, got %+v", rs)
+	}
+	if r := rs[0]; r.User != "alice" || r.Result != v3audit.ResultDenied || len(r.Keys) != 1 ||
+		r.Keys[0] != (v3audit.KeyRange{Key: "/bob/", RangeEnd: "/bob0"}) {
+		t.Errorf("unexpected record of the denied watch: %+v", r)
+	}
+	if r := rs[1]; r.User != "alice" || r.Type != v3audit.TypeMaintenance || r.Result != v3audit.ResultDenied {
+		t.Errorf("unexpected record of the denied snapshot: %+v", r)
+	}
+	if r := rs[2]; r.User != "root" || r.Type != v3audit.TypeMaintenance || r.Result != v3audit.ResultSuccess {
+		t.Errorf("unexpected record of the snapshot: %+v", r)
+	}
+}
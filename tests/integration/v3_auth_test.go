@@ -17,6 +17,8 @@ package integration
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -26,6 +28,9 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/auth"
+
+	"google.golang.org/grpc/metadata"
 )
 
 // TestV3AuthEmptyUserGet ensures that a get with an empty user will return an empty user error.
@@ -552,6 +557,106 @@ func TestV3AuthWatchErrorAndWatchId0(t *testing.T) {
 	<-watchEndCh
 }
 
+// TestV3AuthWatchFilterUnpermitted ensures that a filtered watch on a range
+// the user cannot read entirely only receives the events on the permitted
+// keys, and that the permissions follow the changes of the roles.
+func TestV3AuthWatchFilterUnpermitted(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "a/",
+			end:      "a0",
+		},
+	}
+	authSetupUsers(t, toGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+	c, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "user1", Password: "user1-123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer c.Close()
+
+	wresp := <-c.Watch(ctx, "", clientv3.WithPrefix())
+	if !eqErrGRPC(wresp.Err(), rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, wresp.Err())
+	}
+
+	wch := c.Watch(ctx, "", clientv3.WithPrefix(), clientv3.WithFilterUnpermitted(), clientv3.WithCreatedNotify())
+	if wresp = <-wch; !wresp.Created || wresp.Err() != nil {
+		t.Fatalf("expected the watch to be created, got %+v", wresp)
+	}
+	nextKey := func() string {
+		select {
+		case wresp = <-wch:
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+		if len(wresp.Events) != 1 {
+			t.Fatalf("expected 1 event, got %+v", wresp)
+		}
+		return string(wresp.Events[0].Kv.Key)
+	}
+
+	for _, key := range []string{"a/1", "b/1", "a/2"} {
+		if _, err := rootc.Put(ctx, key, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, key := range []string{"a/1", "a/2"} {
+		if k := nextKey(); k != key {
+			t.Fatalf("expected an event on %q, got %q", key, k)
+		}
+	}
+
+	if _, err := rootc.RoleGrantPermission(ctx, "role1", "b/", "b0", clientv3.PermissionType(clientv3.PermReadWrite)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rootc.RoleRevokePermission(ctx, "role1", "a/", "a0"); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a/3", "b/2"} {
+		if _, err := rootc.Put(ctx, key, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if k := nextKey(); k != "b/2" {
+		t.Fatalf("expected an event on %q, got %q", "b/2", k)
+	}
+
+	// the events of the past revisions are filtered from the first response
+	for i := 0; i < 10; i++ {
+		wctx, wcancel := context.WithCancel(ctx)
+		var keys []string
+		for wresp := range c.Watch(wctx, "", clientv3.WithPrefix(), clientv3.WithRev(1), clientv3.WithFilterUnpermitted()) {
+			for _, ev := range wresp.Events {
+				keys = append(keys, string(ev.Kv.Key))
+			}
+			if len(keys) >= 2 {
+				break
+			}
+		}
+		wcancel()
+		if !reflect.DeepEqual(keys, []string{"b/1", "b/2"}) {
+			t.Fatalf("#%d: expected events on [b/1 b/2], got %v", i, keys)
+		}
+	}
+}
+
 func TestV3AuthWithLeaseTimeToLive(t *testing.T) {
 	BeforeTest(t)
 	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
@@ -634,3 +739,277 @@ func TestV3AuthWithLeaseTimeToLive(t *testing.T) {
 		t.Fatal("timetolive from user2 should be failed with permission denied")
 	}
 }
+
+// TestV3AuthOperationPermissions ensures that the operation permissions
+// granted to a role let its users perform the operations without root.
+func TestV3AuthOperationPermissions(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, EnforceOperationPermissions: true})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "backup",
+			password: "backup-123",
+			role:     "backup",
+		},
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+		},
+	}
+	authSetupUsers(t, toGRPC(clus.Client(0)).Auth, users)
+	for _, op := range []authpb.OperationPermission_Type{authpb.SNAPSHOT, authpb.LEASE} {
+		perm := &authpb.OperationPermission{Operation: op}
+		if _, err := toGRPC(clus.Client(0)).Auth.RoleGrantPermission(context.TODO(), &pb.AuthRoleGrantPermissionRequest{Name: "backup", OperationPerm: perm}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+	backupc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "backup", Password: "backup-123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer backupc.Close()
+	userc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "user1", Password: "user1-123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer userc.Close()
+
+	snapshot := func(c *clientv3.Client) error {
+		sc, err := toGRPC(c).Maintenance.Snapshot(context.TODO(), &pb.SnapshotRequest{})
+		if err != nil {
+			return err
+		}
+		_, err = sc.Recv()
+		return err
+	}
+	if err := snapshot(backupc); err != nil {
+		t.Fatalf("expected backup to take a snapshot, got %v", err)
+	}
+	if err := snapshot(userc); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, err)
+	}
+
+	// listing the members is enforced
+	if _, err := userc.MemberList(context.TODO()); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, err)
+	}
+	if _, err := rootc.MemberList(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the users with the lease operation only revoke their own leases
+	if _, err := userc.Grant(context.TODO(), 90); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, err)
+	}
+	rootLease, err := rootc.Grant(context.TODO(), 90)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backupLease, err := backupc.Grant(context.TODO(), 90)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = backupc.Revoke(context.TODO(), rootLease.ID); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, err)
+	}
+	if _, err = backupc.Revoke(context.TODO(), backupLease.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rootc.Revoke(context.TODO(), rootLease.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestV3AuthSessionRevoke ensures that the revoked sessions of a user are
+// rejected, and that the other sessions of the user stay valid.
+func TestV3AuthSessionRevoke(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "foo",
+		},
+	}
+	authSetupUsers(t, toGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+
+	var tokens []string
+	for i := 0; i < 2; i++ {
+		resp, err := toGRPC(clus.Client(0)).Auth.Authenticate(context.TODO(), &pb.AuthenticateRequest{Name: "user1", Password: "user1-123"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, resp.Token)
+	}
+	get := func(token string) error {
+		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(rpctypes.TokenFieldNameGRPC, token))
+		_, err := toGRPC(clus.Client(0)).KV.Range(ctx, &pb.RangeRequest{Key: []byte("foo")})
+		return err
+	}
+
+	lresp, err := rootc.SessionList(context.TODO(), "user1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lresp.Sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(lresp.Sessions))
+	}
+
+	if _, err = rootc.SessionRevoke(context.TODO(), "user1", lresp.Sessions[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = get(tokens[0]); !eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCInvalidAuthToken, err)
+	}
+	if err = get(tokens[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = rootc.SessionRevokeAll(context.TODO(), "user1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = get(tokens[1]); !eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCInvalidAuthToken, err)
+	}
+}
+
+type authorizerFunc func(ctx context.Context, attrs auth.Attributes) (auth.Decision, time.Duration, error)
+
+func (f authorizerFunc) Authorize(ctx context.Context, attrs auth.Attributes) (auth.Decision, time.Duration, error) {
+	return f(ctx, attrs)
+}
+
+// TestV3AuthAuthorizer ensures that the decisions of the authorizer take
+// precedence over the permissions of the roles, which decide when the
+// authorizer has no opinion.
+func TestV3AuthAuthorizer(t *testing.T) {
+	BeforeTest(t)
+	authorizer := authorizerFunc(func(_ context.Context, attrs auth.Attributes) (auth.Decision, time.Duration, error) {
+		switch {
+		case strings.HasPrefix(string(attrs.Key), "ext/"):
+			return auth.DecisionAllow, time.Minute, nil
+		case string(attrs.Key) == "rbac/denied":
+			return auth.DecisionDeny, time.Minute, nil
+		}
+		return auth.DecisionNoOpinion, 0, nil
+	})
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3, Authorizer: authorizer})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "rbac/",
+			end:      "rbac0",
+		},
+	}
+	authSetupUsers(t, toGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+	c, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(1).Endpoints(), Username: "user1", Password: "user1-123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer c.Close()
+
+	tests := []struct {
+		key    string
+		denied bool
+	}{
+		{"ext/a", false},
+		{"rbac/a", false},
+		{"rbac/denied", true},
+		{"other", true},
+	}
+	for i, tt := range tests {
+		_, perr := c.Put(context.TODO(), tt.key, "v")
+		_, gerr := c.Get(context.TODO(), tt.key)
+		for _, err := range []error{perr, gerr} {
+			if tt.denied && (err == nil || !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied)) {
+				t.Errorf("#%d: expected %v on %q, got %v", i, rpctypes.ErrGRPCPermissionDenied, tt.key, err)
+			}
+			if !tt.denied && err != nil {
+				t.Errorf("#%d: unexpected error %v on %q", i, err, tt.key)
+			}
+		}
+	}
+
+	// the authorizer and the roles decide on each key range of a request
+	if _, err := c.Txn(context.TODO()).Then(clientv3.OpPut("ext/b", "v"), clientv3.OpPut("rbac/b", "v")).Commit(); err != nil {
+		t.Fatal(err)
+	}
+	_, err := c.Txn(context.TODO()).Then(clientv3.OpPut("ext/c", "v"), clientv3.OpPut("other", "v")).Commit()
+	if err == nil || !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, err)
+	}
+
+	// the keys attached to a lease are checked against the roles unless the
+	// authorizer allows them
+	lresp, err := c.Grant(context.TODO(), 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Put(context.TODO(), "ext/l", "v", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rootc.Put(context.TODO(), "other", "v", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Put(context.TODO(), "ext/m", "v", clientv3.WithLease(lresp.ID))
+	if err == nil || !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPermissionDenied, err)
+	}
+	if _, err = rootc.Revoke(context.TODO(), lresp.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// root is not left to the authorizer
+	if _, err = rootc.Put(context.TODO(), "rbac/denied", "v"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the members applied the requests allowed by the authorizer alike
+	for i := range clus.Members {
+		mc, cerr := NewClient(t, clientv3.Config{Endpoints: clus.Client(i).Endpoints(), Username: "root", Password: "123"})
+		if cerr != nil {
+			t.Fatal(cerr)
+		}
+		resp, err := mc.Get(context.TODO(), "ext/", clientv3.WithPrefix(), clientv3.WithSerializable())
+		mc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) != 2 {
+			t.Fatalf("expected 2 keys on member %d, got %d", i, len(resp.Kvs))
+		}
+	}
+}
@@ -315,3 +315,153 @@ func TestElectionObserveCompacted(t *testing.T) {
 		t.Fatalf(`expected leader value "abc", got %q`, string(v.Kvs[0].Value))
 	}
 }
+
+// TestElectionTransfer checks that a leader can hand leadership to a waiting
+// campaigner that is not next in line.
+func TestElectionTransfer(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.RandClient()
+
+	var ss []*concurrency.Session
+	for i := 0; i < 3; i++ {
+		s, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Orphan()
+		ss = append(ss, s)
+	}
+
+	e1 := concurrency.NewElection(ss[0], "test-elect")
+	if err := e1.Campaign(context.TODO(), "abc"); err != nil {
+		t.Fatal(err)
+	}
+	e2, e3 := concurrency.NewElection(ss[1], "test-elect"), concurrency.NewElection(ss[2], "test-elect")
+	errc2, errc3 := make(chan error, 1), make(chan error, 1)
+	go func() { errc2 <- e2.Campaign(context.TODO(), "def") }()
+	waitElectionCandidates(t, cli, "test-elect/", 2)
+	go func() { errc3 <- e3.Campaign(context.TODO(), "ghi") }()
+	waitElectionCandidates(t, cli, "test-elect/", 3)
+
+	if err := e1.Transfer(context.TODO(), fmt.Sprintf("test-elect/%x", ss[2].Lease())); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errc3:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("candidate not elected after transfer")
+	}
+	select {
+	case err := <-errc2:
+		t.Fatalf("older campaigner elected after transfer (%v)", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	resp, err := e3.Leader(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Kvs[0].Value) != "ghi" || string(resp.Kvs[0].Key) != e3.Key() {
+		t.Fatalf("expected leader %q=%q, got %q=%q", e3.Key(), "ghi", resp.Kvs[0].Key, resp.Kvs[0].Value)
+	}
+	if err = e1.Proclaim(context.TODO(), "xyz"); err != concurrency.ErrElectionNotLeader {
+		t.Fatalf("expected %v, got %v", concurrency.ErrElectionNotLeader, err)
+	}
+	if err = e3.Proclaim(context.TODO(), "jkl"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = e3.Resign(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errc2:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("campaigner not elected after resign")
+	}
+}
+
+// TestElectionPriority checks that an elected campaigner hands leadership to
+// a waiting campaigner with a higher priority.
+func TestElectionPriority(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.RandClient()
+
+	var ss []*concurrency.Session
+	for i := 0; i < 3; i++ {
+		s, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Orphan()
+		ss = append(ss, s)
+	}
+
+	e1 := concurrency.NewElection(ss[0], "test-elect")
+	if err := e1.Campaign(context.TODO(), "abc"); err != nil {
+		t.Fatal(err)
+	}
+	e2, e3 := concurrency.NewElection(ss[1], "test-elect"), concurrency.NewElection(ss[2], "test-elect")
+	errc2, errc3 := make(chan error, 1), make(chan error, 1)
+	go func() { errc2 <- e2.Campaign(context.TODO(), "def") }()
+	waitElectionCandidates(t, cli, "test-elect/", 2)
+	go func() { errc3 <- e3.Campaign(context.TODO(), "ghi", concurrency.WithPriority(5)) }()
+	waitElectionCandidates(t, cli, "test-elect/", 3)
+
+	if err := e1.Resign(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errc3:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case err := <-errc2:
+		t.Fatalf("lower priority campaigner elected (%v)", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no campaigner elected after resign")
+	}
+
+	if err := e3.Resign(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errc2:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("campaigner not elected after resign")
+	}
+	resp, err := e2.Leader(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Kvs[0].Value) != "def" {
+		t.Fatalf("expected leader value %q, got %q", "def", resp.Kvs[0].Value)
+	}
+}
+
+func waitElectionCandidates(t *testing.T, cli *clientv3.Client, pfx string, n int64) {
+	for i := 0; i < 50; i++ {
+		resp, err := cli.Get(context.TODO(), pfx, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Count == n {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("expected %d candidates on %q", n, pfx)
+}
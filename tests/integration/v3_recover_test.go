@@ -0,0 +1,131 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/etcdutl/v3/etcdutl"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestRecoverMember ensures that a member recovered with itself as the only
+// survivor restarts as a single member cluster with the data of the lost one.
+func TestRecoverMember(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3, SnapshotCount: 10})
+	defer clus.Terminate(t)
+
+	kvc := toGRPC(clus.RandClient()).KV
+	for i := 0; i < 20; i++ {
+		req := &pb.PutRequest{Key: []byte("foo"), Value: []byte(fmt.Sprintf("bar%d", i))}
+		if _, err := kvc.Put(context.TODO(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitAppliedIndexes(t, clus.Members)
+
+	m := clus.Members[0]
+	id := m.ID()
+	for _, mm := range clus.Members {
+		mm.Stop(t)
+	}
+
+	lg := zaptest.NewLogger(t)
+	r, err := etcdutl.RecoverMember(lg, m.DataDir, "", []string{m.Name}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.State.MemberID != id || len(r.State.Members) != 3 || r.State.SnapshotIndex == 0 {
+		t.Fatalf("unexpected state %+v", r.State)
+	}
+	if r.Plan == nil || len(r.Plan.Survivors) != 1 || len(r.Plan.Removals) != 2 || r.Plan.Index != r.State.CommitIndex+2 {
+		t.Fatalf("unexpected plan %+v", r.Plan)
+	}
+
+	// a dry run leaves the member as is
+	if r, err = etcdutl.RecoverMember(lg, m.DataDir, "", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.State.Members) != 3 {
+		t.Fatalf("expected 3 members after dry run, got %d", len(r.State.Members))
+	}
+	if r, err = etcdutl.RecoverMember(lg, m.DataDir, "", []string{m.Name}, false); err != nil {
+		t.Fatal(err)
+	}
+	// the previous WAL and backend are kept aside
+	for _, p := range []string{r.Plan.WALBackupDir, r.Plan.BackendBackupPath} {
+		if _, err = os.Stat(p); err != nil {
+			t.Fatalf("expected backup %q, got %v", p, err)
+		}
+	}
+	if r, err = etcdutl.RecoverMember(lg, m.DataDir, "", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.State.Members) != 1 || r.State.Members[0].ID != id || len(r.State.RemovedMembers) != 2 {
+		t.Fatalf("expected only member %s after recovery, got %+v", id, r.State)
+	}
+
+	if err = m.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	clus.waitLeader(t, []*member{m})
+
+	cli, err := NewClientV3(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.RequestTimeout)
+	defer cancel()
+	resp, err := cli.Get(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "bar19" {
+		t.Fatalf("expected foo=bar19, got %+v", resp.Kvs)
+	}
+	if _, err = cli.Put(ctx, "foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+	mresp, err := cli.MemberList(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mresp.Members) != 1 || mresp.Members[0].ID != uint64(id) {
+		t.Fatalf("expected member %s only, got %+v", id, mresp.Members)
+	}
+}
+
+func waitAppliedIndexes(t *testing.T, ms []*member) {
+	for i := 0; i < 50; i++ {
+		applied := ms[0].s.AppliedIndex()
+		same := true
+		for _, m := range ms[1:] {
+			same = same && m.s.AppliedIndex() == applied
+		}
+		if same {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("members did not apply the same index")
+}
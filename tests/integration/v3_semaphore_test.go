@@ -0,0 +1,135 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// TestSemaphoreCapacity checks that holders are admitted up to the capacity
+// and that waiters are admitted in order as weight is released.
+func TestSemaphoreCapacity(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.RandClient()
+
+	sems := make([]*concurrency.Semaphore, 4)
+	for i := range sems {
+		s, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Orphan()
+		sems[i] = concurrency.NewSemaphore(s, "test-sem", 3)
+	}
+
+	if err := sems[0].Acquire(context.TODO(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := sems[1].Acquire(context.TODO(), 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := sems[2].TryAcquire(context.TODO(), 1); err != concurrency.ErrSemaphoreFull {
+		t.Fatalf("expected %v, got %v", concurrency.ErrSemaphoreFull, err)
+	}
+
+	// a heavy waiter blocks lighter waiters that come after it
+	heavyc, lightc := make(chan error, 1), make(chan error, 1)
+	go func() { heavyc <- sems[2].Acquire(context.TODO(), 2) }()
+	waitSemaphoreEntries(t, cli, "test-sem/", 3)
+	go func() { lightc <- sems[3].Acquire(context.TODO(), 1) }()
+	waitSemaphoreEntries(t, cli, "test-sem/", 4)
+
+	if err := sems[0].Release(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-heavyc:
+		t.Fatalf("waiter acquired without enough capacity (%v)", err)
+	case err := <-lightc:
+		t.Fatalf("waiter overtook an older waiter (%v)", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := sems[1].Release(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []chan error{heavyc, lightc} {
+		select {
+		case err := <-ch:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("waiter did not acquire after release")
+		}
+	}
+}
+
+// TestSemaphoreSessionExpired checks that the weight held by a session is
+// released once its lease expires.
+func TestSemaphoreSessionExpired(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.RandClient()
+
+	s1, err := concurrency.NewSession(cli, concurrency.WithTTL(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s2.Orphan()
+
+	if err = concurrency.NewSemaphore(s1, "test-sem", 1).Acquire(context.TODO(), 1); err != nil {
+		t.Fatal(err)
+	}
+	donec := make(chan error, 1)
+	go func() { donec <- concurrency.NewSemaphore(s2, "test-sem", 1).Acquire(context.TODO(), 1) }()
+
+	// stop refreshing the holder's lease so it expires
+	s1.Orphan()
+	select {
+	case err = <-donec:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("waiter did not acquire after holder's lease expired")
+	}
+}
+
+func waitSemaphoreEntries(t *testing.T, cli *clientv3.Client, pfx string, n int64) {
+	for i := 0; i < 50; i++ {
+		resp, err := cli.Get(context.TODO(), pfx, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Count == n {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("expected %d semaphore entries on %q", n, pfx)
+}
@@ -174,3 +174,108 @@ func TestV3ElectionObserve(t *testing.T) {
 
 	<-leader2c
 }
+
+// TestV3ElectionTransfer checks that Transfer hands leadership to the
+// named campaigner and revokes the caller's leadership.
+func TestV3ElectionTransfer(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lease1, err1 := toGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	lease2, err2 := toGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+
+	lc := toGRPC(clus.Client(0)).Election
+	l1, lerr1 := lc.Campaign(context.TODO(), &epb.CampaignRequest{Name: []byte("foo"), Lease: lease1.ID, Value: []byte("abc")})
+	if lerr1 != nil {
+		t.Fatal(lerr1)
+	}
+
+	campaignc := make(chan struct{})
+	go func() {
+		defer close(campaignc)
+		req2 := &epb.CampaignRequest{Name: []byte("foo"), Lease: lease2.ID, Value: []byte("def")}
+		if _, lerr2 := lc.Campaign(context.TODO(), req2); lerr2 != nil {
+			t.Error(lerr2)
+		}
+	}()
+
+	select {
+	case <-time.After(200 * time.Millisecond):
+	case <-campaignc:
+		t.Fatalf("got leadership before transfer")
+	}
+
+	candidate := []byte(fmt.Sprintf("foo/%x", lease2.ID))
+	if _, terr := lc.Transfer(context.TODO(), &epb.TransferRequest{Leader: l1.Leader, Candidate: candidate}); terr != nil {
+		t.Fatal(terr)
+	}
+
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatalf("candidate unelected after transfer")
+	case <-campaignc:
+	}
+
+	lval, lverr := lc.Leader(context.TODO(), &epb.LeaderRequest{Name: []byte("foo")})
+	if lverr != nil {
+		t.Fatal(lverr)
+	}
+	if string(lval.Kv.Value) != "def" || lval.Kv.Lease != lease2.ID {
+		t.Fatalf("got election value %q with lease %x, expected %q with lease %x", lval.Kv.Value, lval.Kv.Lease, "def", lease2.ID)
+	}
+	if _, perr := lc.Proclaim(context.TODO(), &epb.ProclaimRequest{Leader: l1.Leader, Value: []byte("xyz")}); perr == nil {
+		t.Fatalf("expected proclaim to fail after transfer")
+	}
+}
+
+// TestV3ElectionTransferOtherElection checks that Transfer rejects a
+// candidate of another election whose name starts with the election name.
+func TestV3ElectionTransferOtherElection(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lease1, err1 := toGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	lease2, err2 := toGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+
+	lc := toGRPC(clus.Client(0)).Election
+	l1, lerr1 := lc.Campaign(context.TODO(), &epb.CampaignRequest{Name: []byte("foo"), Lease: lease1.ID, Value: []byte("abc")})
+	if lerr1 != nil {
+		t.Fatal(lerr1)
+	}
+	if _, lerr2 := lc.Campaign(context.TODO(), &epb.CampaignRequest{Name: []byte("foobar"), Lease: lease2.ID, Value: []byte("def")}); lerr2 != nil {
+		t.Fatal(lerr2)
+	}
+
+	candidate := []byte(fmt.Sprintf("foobar/%x", lease2.ID))
+	if _, terr := lc.Transfer(context.TODO(), &epb.TransferRequest{Leader: l1.Leader, Candidate: candidate}); terr == nil {
+		t.Fatalf("expected transfer to a candidate of another election to fail")
+	}
+
+	for _, tt := range []struct {
+		name  string
+		value string
+		lease int64
+	}{{"foo", "abc", lease1.ID}, {"foobar", "def", lease2.ID}} {
+		lval, lverr := lc.Leader(context.TODO(), &epb.LeaderRequest{Name: []byte(tt.name)})
+		if lverr != nil {
+			t.Fatal(lverr)
+		}
+		if string(lval.Kv.Value) != tt.value || lval.Kv.Lease != tt.lease {
+			t.Fatalf("got %s value %q with lease %x, expected %q with lease %x", tt.name, lval.Kv.Value, lval.Kv.Lease, tt.value, tt.lease)
+		}
+	}
+}
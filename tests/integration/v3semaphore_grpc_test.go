@@ -0,0 +1,80 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	semaphorepb "go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+)
+
+// TestV3SemaphoreAcquireWaiter tests that a client will wait for capacity,
+// then acquire it once enough weight is released.
+func TestV3SemaphoreAcquireWaiter(t *testing.T) {
+	BeforeTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	var leases []int64
+	for i := 0; i < 3; i++ {
+		lresp, err := toGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+		if err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, lresp.ID)
+	}
+
+	sc := toGRPC(clus.Client(0)).Semaphore
+	req := func(lease, weight int64) *semaphorepb.AcquireRequest {
+		return &semaphorepb.AcquireRequest{Name: []byte("foo"), Lease: lease, Capacity: 2, Weight: weight}
+	}
+	a1, aerr1 := sc.Acquire(context.TODO(), req(leases[0], 1))
+	if aerr1 != nil {
+		t.Fatal(aerr1)
+	}
+	if _, aerr2 := sc.Acquire(context.TODO(), req(leases[1], 1)); aerr2 != nil {
+		t.Fatal(aerr2)
+	}
+	if _, terr := sc.TryAcquire(context.TODO(), req(leases[2], 1)); terr == nil {
+		t.Fatal("expected TryAcquire to fail on a full semaphore")
+	}
+
+	acquirec := make(chan struct{})
+	go func() {
+		defer close(acquirec)
+		if _, aerr3 := sc.Acquire(context.TODO(), req(leases[2], 1)); aerr3 != nil {
+			t.Error(aerr3)
+		}
+	}()
+
+	select {
+	case <-time.After(200 * time.Millisecond):
+	case <-acquirec:
+		t.Fatalf("acquired before release")
+	}
+
+	if _, rerr := sc.Release(context.TODO(), &semaphorepb.ReleaseRequest{Key: a1.Key}); rerr != nil {
+		t.Fatal(rerr)
+	}
+
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatalf("waiter did not acquire after release")
+	case <-acquirec:
+	}
+}